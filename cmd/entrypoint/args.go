@@ -16,6 +16,11 @@ limitations under the License.
 
 package main
 
+import (
+	"fmt"
+	"strings"
+)
+
 func extractArgs(initialArgs []string) ([]string, []string) {
 	commandArgs := []string{}
 	args := initialArgs
@@ -36,3 +41,30 @@ func extractArgs(initialArgs []string) ([]string, []string) {
 	}
 	return args, commandArgs
 }
+
+// parseWorkspaceSnapshots parses the comma-separated list of <dir>=<uri> of the workspaces
+// snapshotted by the step into a map from the directories to the URIs.
+func parseWorkspaceSnapshots(value string) (map[string]string, error) {
+	return parseDirPairs(value, "workspace snapshot", "<uri>")
+}
+
+// parseWorkspaceCaches parses the comma-separated list of <dir>=<state file> of the cache
+// workspaces saved by the step into a map from the directories to the state files.
+func parseWorkspaceCaches(value string) (map[string]string, error) {
+	return parseDirPairs(value, "workspace cache", "<state file>")
+}
+
+func parseDirPairs(value, kind, valueName string) (map[string]string, error) {
+	pairs := map[string]string{}
+	if value == "" {
+		return pairs, nil
+	}
+	for _, s := range strings.Split(value, ",") {
+		dir, v, found := strings.Cut(s, "=")
+		if !found || dir == "" || v == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected <dir>=%s", kind, s, valueName)
+		}
+		pairs[dir] = v
+	}
+	return pairs, nil
+}
@@ -65,3 +65,38 @@ func TestExtractArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWorkspaceSnapshots(t *testing.T) {
+	got, err := parseWorkspaceSnapshots("/tekton/workspace-transfer/source=s3://results/workspaces/foo/pr-build/source.tar.gz,/tekton/workspace-transfer/cache=s3://results/workspaces/foo/pr-build/cache.tar.gz")
+	if err != nil {
+		t.Fatalf("parseWorkspaceSnapshots() returned unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"/tekton/workspace-transfer/source": "s3://results/workspaces/foo/pr-build/source.tar.gz",
+		"/tekton/workspace-transfer/cache":  "s3://results/workspaces/foo/pr-build/cache.tar.gz",
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("parseWorkspaceSnapshots() diff %s", d)
+	}
+
+	if got, err := parseWorkspaceSnapshots(""); err != nil || len(got) != 0 {
+		t.Errorf("parseWorkspaceSnapshots(\"\") = %v, %v", got, err)
+	}
+	if _, err := parseWorkspaceSnapshots("/tekton/workspace-transfer/source"); err == nil {
+		t.Errorf("parseWorkspaceSnapshots() expected an error for a snapshot without URI")
+	}
+}
+
+func TestParseWorkspaceCaches(t *testing.T) {
+	got, err := parseWorkspaceCaches("/tekton/workspace-cache/gomod=/tekton/workspace-cache-state/gomod")
+	if err != nil {
+		t.Fatalf("parseWorkspaceCaches() returned unexpected error: %v", err)
+	}
+	want := map[string]string{"/tekton/workspace-cache/gomod": "/tekton/workspace-cache-state/gomod"}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("parseWorkspaceCaches() diff %s", d)
+	}
+	if _, err := parseWorkspaceCaches("=/tekton/workspace-cache-state/gomod"); err == nil {
+		t.Errorf("parseWorkspaceCaches() expected an error for a cache without directory")
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"time"
 
 	"github.com/tektoncd/pipeline/cmd/entrypoint/subcommands"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1/types"
 	"github.com/tektoncd/pipeline/pkg/credentials/dockercreds"
 	"github.com/tektoncd/pipeline/pkg/credentials/gitcreds"
@@ -54,9 +56,17 @@ var (
 	debugBeforeStep     = flag.Bool("debug_before_step", false, "If specified, wait for a debugger to attach before executing the step")
 	onError             = flag.String("on_error", "", "Set to \"continue\" to ignore an error and continue when a container terminates with a non-zero exit code."+
 		" Set to \"stopAndFail\" to declare a failure with a step error and stop executing the rest of the steps.")
+	retries                    = flag.Int("retries", 0, "If specified, number of times the command is run again when it exits with a non-zero exit code")
+	retryDelay                 = flag.Duration("retry_delay", time.Duration(0), "If specified, delay to wait after a failed attempt before running the command again")
 	stepMetadataDir            = flag.String("step_metadata_dir", "", "If specified, create directory to store the step metadata e.g. /tekton/steps/<step-name>/")
 	resultExtractionMethod     = flag.String("result_from", entrypoint.ResultExtractionMethodTerminationMessage, "The method using which to extract results from tasks. Default is using the termination message.")
 	compressTerminationMessage = flag.Bool("compress_termination_message", false, "If true, compress termination messages with flate to fit more results in the 4KB Kubernetes limit.")
+	resultsStorageEndpoint     = flag.String("results_storage_endpoint", "", "If result_from is object-storage, URL of the S3-compatible API of the bucket to upload results to")
+	resultsStorageBucket       = flag.String("results_storage_bucket", "", "If result_from is object-storage, name of the bucket to upload results to")
+	resultsStorageRegion       = flag.String("results_storage_region", "", "If result_from is object-storage, region of the bucket to upload results to")
+	workspaceSnapshots         = flag.String("workspace_snapshots", "", "If specified, comma-separated list of <dir>=<uri> of the workspaces to upload to the results bucket once the step completes")
+	workspaceCaches            = flag.String("workspace_caches", "", "If specified, comma-separated list of <dir>=<state file> of the cache workspaces to save to the results bucket once the step completes")
+	snapshotWaitFiles          = flag.String("snapshot_wait_file", "", "If specified, comma-separated list of the post files of the steps to wait for before uploading the workspace snapshots and caches")
 )
 
 const (
@@ -128,6 +138,25 @@ func main() {
 
 	spireWorkloadAPI := initializeSpireAPI()
 
+	var resultUploader entrypoint.ResultUploader
+	var snapshotter entrypoint.WorkspaceSnapshotter
+	snapshots, err := parseWorkspaceSnapshots(*workspaceSnapshots)
+	if err != nil {
+		log.Fatal(err)
+	}
+	caches, err := parseWorkspaceCaches(*workspaceCaches)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *resultExtractionMethod == entrypoint.ResultExtractionMethodObjectStorage || len(snapshots) > 0 || len(caches) > 0 {
+		bucket, err := objectstorageresults.NewBucketFromCredentialsDir(*resultsStorageEndpoint, *resultsStorageBucket, *resultsStorageRegion, objectstorageresults.CredentialsDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		resultUploader = bucket
+		snapshotter = workspacetransfer.Snapshotter{Bucket: bucket, ScratchDir: *stepMetadataDir}
+	}
+
 	e := entrypoint.Entrypointer{
 		Command:         append(cmd, commandArgs...),
 		WaitFiles:       strings.Split(*waitFiles, ","),
@@ -147,10 +176,17 @@ func main() {
 		BreakpointOnFailure:        *breakpointOnFailure,
 		DebugBeforeStep:            *debugBeforeStep,
 		OnError:                    *onError,
+		Retries:                    *retries,
+		RetryDelay:                 *retryDelay,
 		StepMetadataDir:            *stepMetadataDir,
 		SpireWorkloadAPI:           spireWorkloadAPI,
 		ResultExtractionMethod:     *resultExtractionMethod,
+		ResultUploader:             resultUploader,
 		CompressTerminationMessage: *compressTerminationMessage,
+		WorkspaceSnapshots:         snapshots,
+		WorkspaceCaches:            caches,
+		SnapshotWaitFiles:          strings.Split(*snapshotWaitFiles, ","),
+		WorkspaceSnapshotter:       snapshotter,
 	}
 
 	// Copy any creds injected by the controller into the $HOME directory of the current
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subcommands
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/test/diff"
+)
+
+func TestExtractImageVolume(t *testing.T) {
+	root := t.TempDir()
+	for path, content := range map[string]string{
+		"usr/bin/go":         "go",
+		"usr/lib/go/VERSION": "go1.24",
+		"proc/self/status":   "mounted",
+		"etc/hosts":          "mounted",
+	} {
+		if err := os.MkdirAll(filepath.Join(root, filepath.Dir(path)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, path), []byte(content), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink("usr/bin", filepath.Join(root, "bin")); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(root, "tekton", "image-volume")
+	if err := os.MkdirAll(dst, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	mountInfo := filepath.Join(t.TempDir(), "mountinfo")
+	if err := os.WriteFile(mountInfo, []byte(
+		"22 1 0:21 / / rw - overlay overlay rw\n"+
+			"23 22 0:22 / "+filepath.Join(root, "proc")+" rw - proc proc rw\n"+
+			"24 22 8:1 /hosts "+filepath.Join(root, "etc", "hosts")+" rw - ext4 /dev/sda1 rw\n"+
+			"25 22 8:1 /ws "+dst+" rw - ext4 /dev/sda1 rw\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	oldMountInfoPath := mountInfoPath
+	mountInfoPath = mountInfo
+	defer func() { mountInfoPath = oldMountInfoPath }()
+
+	if err := extractImageVolume(root, dst); err != nil {
+		t.Fatalf("extractImageVolume() = %v", err)
+	}
+
+	var got []string
+	if err := filepath.WalkDir(dst, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || p == dst {
+			return err
+		}
+		rel, _ := filepath.Rel(dst, p)
+		got = append(got, rel)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(got)
+	want := []string{"bin", "etc", "tekton", "usr", "usr/bin", "usr/bin/go", "usr/lib", "usr/lib/go", "usr/lib/go/VERSION"}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("extracted files %s", diff.PrintWantGot(d))
+	}
+	if link, err := os.Readlink(filepath.Join(dst, "bin")); err != nil || link != "usr/bin" {
+		t.Errorf("bin should link to usr/bin, got %q, %v", link, err)
+	}
+	if content, err := os.ReadFile(filepath.Join(dst, "usr/lib/go/VERSION")); err != nil || string(content) != "go1.24" {
+		t.Errorf("usr/lib/go/VERSION = %q, %v", content, err)
+	}
+}
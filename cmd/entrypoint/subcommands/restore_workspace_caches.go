@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subcommands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/workspacecache"
+	"github.com/tektoncd/pipeline/pkg/result"
+	"github.com/tektoncd/pipeline/pkg/termination"
+)
+
+// RestoreWorkspaceCachesCommand is the command name for restoring the workspaces bound with cache.
+const RestoreWorkspaceCachesCommand = "restore-workspace-caches"
+
+// The directories the cache workspaces are mounted in and their state is written to, and the
+// termination message path of the init container. Included as global variables to allow
+// overriding for tests.
+var (
+	workspaceCacheMountDir = workspacecache.MountDir
+	workspaceCacheStateDir = workspacecache.StateDir
+	terminationMessagePath = "/dev/termination-log"
+)
+
+// restoreWorkspaceCaches restores the cache workspaces, each given as its JSON encoding, from the
+// archives of the namespace in the bucket served at the endpoint. The URIs the workspaces are saved
+// to by the last Step are written to the state directory, and how they were restored to the
+// termination message.
+func restoreWorkspaceCaches(endpoint, bucket, region, namespace string, caches []string) error {
+	b, err := objectstorageresults.NewBucketFromCredentialsDir(endpoint, bucket, region, credentialsDir)
+	if err != nil {
+		return err
+	}
+	var results []result.RunResult
+	for _, raw := range caches {
+		var c workspacecache.Cache
+		if err := json.Unmarshal([]byte(raw), &c); err != nil {
+			return fmt.Errorf("invalid workspace cache %q: %w", raw, err)
+		}
+		status, uri, err := workspacecache.Restore(context.Background(), b, namespace, workspaceCacheMountDir, c)
+		if err != nil {
+			return fmt.Errorf("failed to restore the cache of workspace %q: %w", c.Name, err)
+		}
+		if uri != "" {
+			if err := os.WriteFile(filepath.Join(workspaceCacheStateDir, c.Name), []byte(uri), 0o644); err != nil {
+				return err
+			}
+		}
+		r, err := workspacecache.StatusResult(status)
+		if err != nil {
+			return err
+		}
+		results = append(results, r)
+	}
+	return termination.WriteMessage(terminationMessagePath, results)
+}
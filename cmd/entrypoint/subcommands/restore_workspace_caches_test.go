@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subcommands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults/objectstoragetest"
+	"github.com/tektoncd/pipeline/internal/workspacecache"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/termination"
+	"github.com/tektoncd/pipeline/test/diff"
+	"go.uber.org/zap"
+)
+
+func TestRestoreWorkspaceCaches(t *testing.T) {
+	server := objectstoragetest.NewServer(t)
+	credentialsDir = t.TempDir()
+	workspaceCacheMountDir = t.TempDir()
+	workspaceCacheStateDir = t.TempDir()
+	terminationMessagePath = filepath.Join(t.TempDir(), "termination")
+	defer func() {
+		credentialsDir = objectstorageresults.CredentialsDir
+		workspaceCacheMountDir = workspacecache.MountDir
+		workspaceCacheStateDir = workspacecache.StateDir
+		terminationMessagePath = "/dev/termination-log"
+	}()
+	for key, value := range map[string]string{
+		objectstorageresults.AccessKeyIDKey:     objectstoragetest.AccessKeyID,
+		objectstorageresults.SecretAccessKeyKey: objectstoragetest.SecretAccessKey,
+	} {
+		if err := os.WriteFile(filepath.Join(credentialsDir, key), []byte(value), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, dir := range []string{"gomod", "npm"} {
+		if err := os.Mkdir(filepath.Join(workspaceCacheMountDir, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The gomod cache was saved by an earlier TaskRun, the npm cache was not.
+	b := server.Bucket()
+	src := t.TempDir()
+	if err := os.WriteFile(filepath.Join(src, "cache.txt"), []byte("gomod"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := workspacetransfer.Snapshot(context.Background(), b, src, "s3://results/caches/foo/go-mod.tar.gz", t.TempDir()); err != nil {
+		t.Fatalf("Snapshot() returned unexpected error: %v", err)
+	}
+
+	args := []string{RestoreWorkspaceCachesCommand, server.URL, "results", "", "foo",
+		`{"name":"gomod","key":"go-mod"}`, `{"name":"npm","key":"npm","restoreKeys":["npm-"]}`}
+	if err := Process(args); err != (OK{message: "Restored workspace caches"}) {
+		t.Fatalf("Process() = %v", err)
+	}
+
+	if got, err := os.ReadFile(filepath.Join(workspaceCacheMountDir, "gomod", "cache.txt")); err != nil || string(got) != "gomod" {
+		t.Errorf("restored cache.txt = %q, %v, want %q", got, err, "gomod")
+	}
+	if _, err := os.Stat(filepath.Join(workspaceCacheStateDir, "gomod")); !os.IsNotExist(err) {
+		t.Errorf("expected no state for the gomod cache restored from its key, got %v", err)
+	}
+	if got, err := os.ReadFile(filepath.Join(workspaceCacheStateDir, "npm")); err != nil || string(got) != "s3://results/caches/foo/npm.tar.gz" {
+		t.Errorf("npm cache state = %q, %v, want %q", got, err, "s3://results/caches/foo/npm.tar.gz")
+	}
+
+	msg, err := os.ReadFile(terminationMessagePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := termination.ParseMessage(zap.NewNop().Sugar(), string(msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := workspacecache.StatusesFromResults(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []v1.WorkspaceCacheStatus{
+		{Name: "gomod", Key: "go-mod", RestoredKey: "go-mod", Outcome: v1.WorkspaceCacheHit},
+		{Name: "npm", Key: "npm", Outcome: v1.WorkspaceCacheMiss},
+	}
+	if d := cmp.Diff(want, statuses); d != "" {
+		t.Errorf("termination message %s", diff.PrintWantGot(d))
+	}
+
+	args = []string{RestoreWorkspaceCachesCommand, server.URL, "results", "", "foo", `{"name":`}
+	if err := Process(args); err == nil {
+		t.Errorf("Process() expected an error for an invalid cache")
+	}
+}
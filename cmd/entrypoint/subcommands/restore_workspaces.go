@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subcommands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+)
+
+// RestoreWorkspacesCommand is the command name for restoring the snapshots of the workspaces
+// bound with transfer.
+const RestoreWorkspacesCommand = "restore-workspaces"
+
+// credentialsDir is the location of the credentials to access the bucket the snapshots are stored in.
+// Included as a global variable to allow overriding for tests.
+var credentialsDir = objectstorageresults.CredentialsDir
+
+// restoreWorkspaces extracts, in order, the snapshots into the directories of the workspaces.
+// Each snapshot is given as <dir>=<uri>, with the URI of a snapshot in the bucket served at the endpoint.
+func restoreWorkspaces(endpoint, bucket, region string, snapshots []string) error {
+	b, err := objectstorageresults.NewBucketFromCredentialsDir(endpoint, bucket, region, credentialsDir)
+	if err != nil {
+		return err
+	}
+	for _, s := range snapshots {
+		dir, uri, found := strings.Cut(s, "=")
+		if !found || dir == "" || uri == "" {
+			return fmt.Errorf("invalid workspace snapshot %q, expected <dir>=<uri>", s)
+		}
+		if err := workspacetransfer.Restore(context.Background(), b, dir, uri); err != nil {
+			return err
+		}
+	}
+	return nil
+}
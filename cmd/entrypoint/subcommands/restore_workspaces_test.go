@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subcommands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults/objectstoragetest"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+)
+
+func TestRestoreWorkspaces(t *testing.T) {
+	server := objectstoragetest.NewServer(t)
+	credentialsDir = t.TempDir()
+	defer func() { credentialsDir = objectstorageresults.CredentialsDir }()
+	for key, value := range map[string]string{
+		objectstorageresults.AccessKeyIDKey:     objectstoragetest.AccessKeyID,
+		objectstorageresults.SecretAccessKeyKey: objectstoragetest.SecretAccessKey,
+	} {
+		if err := os.WriteFile(filepath.Join(credentialsDir, key), []byte(value), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Two TaskRuns write the same file, the snapshot restored last wins.
+	b := server.Bucket()
+	var uris []string
+	for _, name := range []string{"first", "second"} {
+		src := t.TempDir()
+		if err := os.WriteFile(filepath.Join(src, "out.txt"), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(src, name+".txt"), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		uri := workspacetransfer.SnapshotURI("results", "foo", name, "source")
+		if err := workspacetransfer.Snapshot(context.Background(), b, src, uri, t.TempDir()); err != nil {
+			t.Fatalf("Snapshot() returned unexpected error: %v", err)
+		}
+		uris = append(uris, uri)
+	}
+
+	dst := t.TempDir()
+	args := []string{RestoreWorkspacesCommand, server.URL, "results", "", dst + "=" + uris[0], dst + "=" + uris[1]}
+	if err := Process(args); err != (OK{message: "Restored workspaces"}) {
+		t.Fatalf("Process() = %v", err)
+	}
+	for name, want := range map[string]string{
+		"out.txt":    "second",
+		"first.txt":  "first",
+		"second.txt": "second",
+	} {
+		got, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil || string(got) != want {
+			t.Errorf("restored %s = %q, %v, want %q", name, got, err, want)
+		}
+	}
+
+	args = []string{RestoreWorkspacesCommand, server.URL, "results", "", dst + "=" + workspacetransfer.SnapshotURI("results", "foo", "missing", "source")}
+	if err := Process(args); err == nil {
+		t.Errorf("Process() expected an error for a missing snapshot")
+	}
+}
@@ -87,6 +87,36 @@ func Process(args []string) error {
 			}
 			return OK{message: "Decoded script " + src}
 		}
+	case RestoreWorkspacesCommand:
+		// If invoked in "restore-workspaces" mode (`entrypoint restore-workspaces <endpoint> <bucket> <region> <dir>=<uri>...`),
+		// download the snapshots from the bucket and extract them in order into the directories.
+		if len(args) >= 5 {
+			endpoint, bucket, region := args[1], args[2], args[3]
+			if err := restoreWorkspaces(endpoint, bucket, region, args[4:]); err != nil {
+				return SubcommandError{subcommand: RestoreWorkspacesCommand, message: err.Error()}
+			}
+			return OK{message: "Restored workspaces"}
+		}
+	case RestoreWorkspaceCachesCommand:
+		// If invoked in "restore-workspace-caches" mode (`entrypoint restore-workspace-caches <endpoint> <bucket> <region> <namespace> <cache>...`),
+		// restore the cache workspaces, given as JSON, from the archives of the namespace in the bucket.
+		if len(args) >= 6 {
+			endpoint, bucket, region, namespace := args[1], args[2], args[3], args[4]
+			if err := restoreWorkspaceCaches(endpoint, bucket, region, namespace, args[5:]); err != nil {
+				return SubcommandError{subcommand: RestoreWorkspaceCachesCommand, message: err.Error()}
+			}
+			return OK{message: "Restored workspace caches"}
+		}
+	case ExtractImageVolumeCommand:
+		// If invoked in "extract-image-volume" mode (`entrypoint extract-image-volume <dst>`),
+		// copy the content of the image the container runs into <dst>.
+		if len(args) == 2 {
+			dst := args[1]
+			if err := extractImageVolume("/", dst); err != nil {
+				return SubcommandError{subcommand: ExtractImageVolumeCommand, message: err.Error()}
+			}
+			return OK{message: "Extracted image into " + dst}
+		}
 	case StepInitCommand:
 		if err := stepInit(args[1:]); err != nil {
 			return SubcommandError{subcommand: StepInitCommand, message: err.Error()}
@@ -36,6 +36,7 @@ require (
 
 require (
 	code.gitea.io/sdk/gitea v0.22.1
+	github.com/aws/aws-sdk-go-v2 v1.43.0
 	github.com/elastic/crd-ref-docs v0.3.0
 	github.com/go-jose/go-jose/v3 v3.0.5
 	github.com/goccy/kpoward v0.1.0
@@ -153,7 +154,6 @@ require (
 )
 
 require (
-	github.com/aws/aws-sdk-go-v2 v1.43.0 // indirect
 	github.com/aws/aws-sdk-go-v2/config v1.32.31 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.19.30 // indirect
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.18.31 // indirect
@@ -0,0 +1,414 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectstorageresults stores the values of results in an S3-compatible bucket
+// under their digest, so that only their URIs go through the termination messages of the
+// steps and the status of the TaskRuns.
+package objectstorageresults
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// CredentialsDir is the directory in which the Secret holding the credentials to access
+	// the bucket is mounted in the Steps.
+	CredentialsDir = "/tekton/results-storage"
+	// AccessKeyIDKey is the key of the access key ID in the Secret holding the credentials.
+	AccessKeyIDKey = "access-key-id"
+	// SecretAccessKeyKey is the key of the secret access key in the Secret holding the credentials.
+	SecretAccessKeyKey = "secret-access-key"
+
+	uriScheme     = "s3://"
+	digestPrefix  = "sha256/"
+	defaultRegion = "us-east-1"
+	// emptyPayloadHash is the SHA-256 digest of an empty payload, used to sign requests without a body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	// responseHeaderTimeout bounds the wait for the response to a request once it is sent. The transfer of
+	// the body is not bounded, since snapshots of workspaces can take long to upload or download.
+	responseHeaderTimeout = 30 * time.Second
+	// controllerRequestTimeout bounds the requests sent by the controller, which only transfer the values
+	// of results and listings of objects.
+	controllerRequestTimeout = time.Minute
+)
+
+// transport is shared by the Buckets so that they reuse their connections.
+var transport = func() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.ResponseHeaderTimeout = responseHeaderTimeout
+	return t
+}()
+
+// ErrNotConfigured indicates that the bucket in which results are stored is not configured.
+var ErrNotConfigured = errors.New("results-from is set to object-storage but the results bucket is not configured")
+
+// Bucket is an S3-compatible bucket in which the values of results are stored. It is
+// addressed with path-style requests, which are supported by MinIO and most other
+// S3-compatible implementations.
+type Bucket struct {
+	// Endpoint is the URL of the S3-compatible API, e.g. http://minio.minio.svc:9000
+	Endpoint string
+	// Name is the name of the bucket
+	Name string
+	// Region is the region requests are signed for
+	Region string
+	// Credentials are the credentials requests are signed with
+	Credentials aws.Credentials
+	// Client is the client used to send the requests
+	Client *http.Client
+}
+
+// NewBucket returns the Bucket of the given name served at the endpoint, accessed with the given credentials.
+func NewBucket(endpoint, name, region, accessKeyID, secretAccessKey string) *Bucket {
+	if region == "" {
+		region = defaultRegion
+	}
+	return &Bucket{
+		Endpoint: strings.TrimSuffix(endpoint, "/"),
+		Name:     name,
+		Region:   region,
+		Credentials: aws.Credentials{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+		},
+		Client: &http.Client{Transport: transport},
+	}
+}
+
+// NewBucketFromCredentialsDir returns the Bucket of the given name served at the endpoint, accessed
+// with the credentials read from the files of a mounted Secret in dir.
+func NewBucketFromCredentialsDir(endpoint, name, region, dir string) (*Bucket, error) {
+	accessKeyID, err := os.ReadFile(filepath.Join(dir, AccessKeyIDKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the results bucket credentials: %w", err)
+	}
+	secretAccessKey, err := os.ReadFile(filepath.Join(dir, SecretAccessKeyKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the results bucket credentials: %w", err)
+	}
+	return NewBucket(endpoint, name, region, strings.TrimSpace(string(accessKeyID)), strings.TrimSpace(string(secretAccessKey))), nil
+}
+
+// NewBucketFromConfig returns the Bucket configured in config-defaults, accessed by the controller with
+// the credentials read from the configured Secret in the given namespace.
+func NewBucketFromConfig(ctx context.Context, secretLister corev1listers.SecretLister, namespace string) (*Bucket, error) {
+	cfg := config.FromContextOrDefaults(ctx).Defaults
+	if cfg.DefaultResultsObjectStorageEndpoint == "" || cfg.DefaultResultsObjectStorageBucket == "" || cfg.DefaultResultsObjectStorageSecret == "" {
+		return nil, ErrNotConfigured
+	}
+	secret, err := secretLister.Secrets(namespace).Get(cfg.DefaultResultsObjectStorageSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the results bucket credentials: %w", err)
+	}
+	b := NewBucket(cfg.DefaultResultsObjectStorageEndpoint, cfg.DefaultResultsObjectStorageBucket, cfg.DefaultResultsObjectStorageRegion,
+		string(secret.Data[AccessKeyIDKey]), string(secret.Data[SecretAccessKeyKey]))
+	b.Client.Timeout = controllerRequestTimeout
+	return b, nil
+}
+
+// IsURI returns true if the value is the URI of a value stored in object storage.
+func IsURI(value string) bool {
+	return strings.HasPrefix(value, uriScheme)
+}
+
+// Upload stores the value in the bucket under its digest and returns its URI.
+// Uploading the same value several times stores it once.
+func (b *Bucket) Upload(ctx context.Context, value []byte) (string, error) {
+	sum := sha256.Sum256(value)
+	digest := hex.EncodeToString(sum[:])
+	key := digestPrefix + digest
+	if err := b.PutObject(ctx, key, bytes.NewReader(value), int64(len(value)), digest); err != nil {
+		return "", fmt.Errorf("failed to upload result: %w", err)
+	}
+	return URI(b.Name, key), nil
+}
+
+// Download returns the value stored at the URI, after checking that it matches its digest.
+func (b *Bucket) Download(ctx context.Context, uri string) ([]byte, error) {
+	bucket, key, found := strings.Cut(strings.TrimPrefix(uri, uriScheme), "/")
+	if !IsURI(uri) || !found || !strings.HasPrefix(key, digestPrefix) {
+		return nil, fmt.Errorf("invalid result URI %q", uri)
+	}
+	if bucket != b.Name {
+		return nil, fmt.Errorf("result URI %q is not in bucket %q", uri, b.Name)
+	}
+	resp, err := b.do(ctx, http.MethodGet, key, nil, nil, http.NoBody, 0, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download result %q: %s", uri, resp.Status)
+	}
+	value, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download result %q: %w", uri, err)
+	}
+	if sum := sha256.Sum256(value); hex.EncodeToString(sum[:]) != strings.TrimPrefix(key, digestPrefix) {
+		return nil, fmt.Errorf("result %q does not match its digest", uri)
+	}
+	return value, nil
+}
+
+// URI returns the URI of the object stored under key in the named bucket.
+func URI(bucket, key string) string {
+	return uriScheme + bucket + "/" + key
+}
+
+// Key returns the key of the object at the URI, which must be in the bucket.
+func (b *Bucket) Key(uri string) (string, error) {
+	bucket, key, found := strings.Cut(strings.TrimPrefix(uri, uriScheme), "/")
+	if !IsURI(uri) || !found || key == "" {
+		return "", fmt.Errorf("invalid object URI %q", uri)
+	}
+	if bucket != b.Name {
+		return "", fmt.Errorf("object URI %q is not in bucket %q", uri, b.Name)
+	}
+	return key, nil
+}
+
+// PutObject stores the size bytes read from body in the bucket under key. The digest is the
+// hex-encoded SHA-256 digest of the content, which the request is signed with.
+func (b *Bucket) PutObject(ctx context.Context, key string, body io.Reader, size int64, digest string) error {
+	resp, err := b.do(ctx, http.MethodPut, key, nil, nil, body, size, digest)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to store %q in bucket %q: %s", key, b.Name, resp.Status)
+	}
+	return nil
+}
+
+// GetObject returns the content stored in the bucket under key, which the caller must close.
+func (b *Bucket) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := b.do(ctx, http.MethodGet, key, nil, nil, http.NoBody, 0, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %q from bucket %q: %s", key, b.Name, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// Object is an object stored in a bucket.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// ListObjects returns the objects stored in the bucket under keys starting with prefix.
+func (b *Bucket) ListObjects(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	for {
+		resp, err := b.do(ctx, http.MethodGet, "", query, nil, http.NoBody, 0, emptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Contents []struct {
+				Key          string    `xml:"Key"`
+				Size         int64     `xml:"Size"`
+				LastModified time.Time `xml:"LastModified"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to list %q in bucket %q: %s", prefix, b.Name, resp.Status)
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %q in bucket %q: %w", prefix, b.Name, err)
+		}
+		for _, c := range page.Contents {
+			objects = append(objects, Object{Key: c.Key, Size: c.Size, LastModified: c.LastModified})
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return objects, nil
+		}
+		query.Set("continuation-token", page.NextContinuationToken)
+	}
+}
+
+// TouchObject sets the last modification time of the object stored under key to now, by
+// copying the object onto itself.
+func (b *Bucket) TouchObject(ctx context.Context, key string) error {
+	header := http.Header{
+		"X-Amz-Copy-Source":        {"/" + b.Name + "/" + key},
+		"X-Amz-Metadata-Directive": {"REPLACE"},
+	}
+	resp, err := b.do(ctx, http.MethodPut, key, nil, header, http.NoBody, 0, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to touch %q in bucket %q: %s", key, b.Name, resp.Status)
+	}
+	return nil
+}
+
+// DeleteObject removes the object stored in the bucket under key.
+func (b *Bucket) DeleteObject(ctx context.Context, key string) error {
+	resp, err := b.do(ctx, http.MethodDelete, key, nil, nil, http.NoBody, 0, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to delete %q from bucket %q: %s", key, b.Name, resp.Status)
+	}
+	return nil
+}
+
+// do sends a request for the object with the given key, or for the bucket itself if the key is
+// empty, signed with AWS Signature Version 4.
+func (b *Bucket) do(ctx context.Context, method, key string, query url.Values, header http.Header, body io.Reader, size int64, payloadHash string) (*http.Response, error) {
+	target := b.Endpoint + "/" + b.Name
+	if key != "" {
+		target += "/" + key
+	}
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.ContentLength = size
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	signer := v4.NewSigner(func(o *v4.SignerOptions) {
+		// S3 expects the path of the request to be escaped only once
+		o.DisableURIPathEscaping = true
+	})
+	if err := signer.SignHTTP(ctx, b.Credentials, req, payloadHash, "s3", b.Region, time.Now()); err != nil {
+		return nil, err
+	}
+	return b.Client.Do(req)
+}
+
+// Cache keeps the values downloaded from a bucket in memory. Values are stored under their
+// digest so the value of a URI never changes, and cached values never need to be refreshed.
+type Cache struct {
+	lru *lru.Cache
+}
+
+// NewCache returns a Cache keeping at most size values.
+func NewCache(size int) (*Cache, error) {
+	l, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{lru: l}, nil
+}
+
+// sharedCacheSize is the number of values kept by the Cache returned by SharedCache.
+const sharedCacheSize = 1000
+
+var sharedCache = sync.OnceValue(func() *Cache {
+	c, _ := NewCache(sharedCacheSize)
+	return c
+})
+
+// SharedCache returns the Cache shared by the reconcilers of the controller, so that the values
+// downloaded to validate the results of a TaskRun are not downloaded again to resolve them in
+// its PipelineRun.
+func SharedCache() *Cache {
+	return sharedCache()
+}
+
+// Download returns the value stored at the URI, downloading it from the bucket unless it is cached.
+// A nil Cache always downloads the value.
+func (c *Cache) Download(ctx context.Context, b *Bucket, uri string) ([]byte, error) {
+	if c == nil {
+		return b.Download(ctx, uri)
+	}
+	if value, ok := c.lru.Get(uri); ok {
+		return value.([]byte), nil
+	}
+	value, err := b.Download(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	c.lru.Add(uri, value)
+	return value, nil
+}
+
+// HasStoredResults returns true if the value of any of the results is stored in object storage.
+func HasStoredResults(results []v1.TaskRunResult) bool {
+	for _, r := range results {
+		if r.URI != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// FetchResults returns a copy of the results in which the values stored in object storage
+// are downloaded and parsed according to the type of the results.
+func FetchResults(ctx context.Context, cache *Cache, b *Bucket, results []v1.TaskRunResult) ([]v1.TaskRunResult, error) {
+	fetched := make([]v1.TaskRunResult, 0, len(results))
+	for _, r := range results {
+		if r.URI == "" {
+			fetched = append(fetched, r)
+			continue
+		}
+		content, err := cache.Download(ctx, b, r.URI)
+		if err != nil {
+			return nil, err
+		}
+		value := v1.ResultValue{}
+		if r.Type == v1.ResultsTypeString || r.Type == "" {
+			value = *v1.NewStructuredValues(string(content))
+		} else if err := value.UnmarshalJSON(content); err != nil {
+			return nil, fmt.Errorf("failed to parse result %q: %w", r.Name, err)
+		}
+		fetched = append(fetched, v1.TaskRunResult{Name: r.Name, Type: r.Type, Value: value})
+	}
+	return fetched, nil
+}
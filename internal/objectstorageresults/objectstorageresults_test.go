@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstorageresults_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults/objectstoragetest"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test/diff"
+)
+
+func TestBucket_UploadDownload(t *testing.T) {
+	s := objectstoragetest.NewServer(t)
+	b := s.Bucket()
+	ctx := context.Background()
+
+	uri, err := b.Upload(ctx, []byte("hello"))
+	if err != nil {
+		t.Fatalf("Upload() returned unexpected error: %v", err)
+	}
+	wantURI := "s3://results/sha256/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if uri != wantURI {
+		t.Errorf("Upload() = %q, want %q", uri, wantURI)
+	}
+	if !objectstorageresults.IsURI(uri) {
+		t.Errorf("IsURI(%q) = false, want true", uri)
+	}
+	if _, ok := s.Object("sha256/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"); !ok {
+		t.Errorf("Upload() did not store the value under its digest, objects: %v", s.Keys())
+	}
+
+	value, err := b.Download(ctx, uri)
+	if err != nil {
+		t.Fatalf("Download() returned unexpected error: %v", err)
+	}
+	if string(value) != "hello" {
+		t.Errorf("Download() = %q, want %q", value, "hello")
+	}
+}
+
+func TestBucket_ListTouchDeleteObjects(t *testing.T) {
+	s := objectstoragetest.NewServer(t)
+	bucket := s.Bucket()
+	ctx := t.Context()
+	for _, key := range []string{"caches/foo/a", "caches/foo/bb", "caches/foo/ccc", "caches/bar/a"} {
+		if err := bucket.PutObject(ctx, key, strings.NewReader(key), int64(len(key)), digest(key)); err != nil {
+			t.Fatalf("PutObject() = %v", err)
+		}
+	}
+	if err := bucket.TouchObject(ctx, "caches/foo/a"); err != nil {
+		t.Fatalf("TouchObject() = %v", err)
+	}
+	if err := bucket.DeleteObject(ctx, "caches/foo/bb"); err != nil {
+		t.Fatalf("DeleteObject() = %v", err)
+	}
+	if err := bucket.PutObject(ctx, "caches/foo/dddd", strings.NewReader("dddd"), 4, digest("dddd")); err != nil {
+		t.Fatalf("PutObject() = %v", err)
+	}
+
+	objects, err := bucket.ListObjects(ctx, "caches/foo/")
+	if err != nil {
+		t.Fatalf("ListObjects() = %v", err)
+	}
+	want := []objectstorageresults.Object{
+		{Key: "caches/foo/a", Size: 12, LastModified: s.LastModified("caches/foo/a")},
+		{Key: "caches/foo/ccc", Size: 14, LastModified: s.LastModified("caches/foo/ccc")},
+		{Key: "caches/foo/dddd", Size: 4, LastModified: s.LastModified("caches/foo/dddd")},
+	}
+	if d := cmp.Diff(want, objects); d != "" {
+		t.Errorf("ListObjects() %s", diff.PrintWantGot(d))
+	}
+	if !objects[0].LastModified.After(objects[1].LastModified) {
+		t.Errorf("expected the touched object to be modified after %s, got %s", objects[1].LastModified, objects[0].LastModified)
+	}
+}
+
+func TestBucket_Download_Errors(t *testing.T) {
+	s := objectstoragetest.NewServer(t)
+	b := s.Bucket()
+	digest := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	s.SetObject("sha256/"+digest, []byte("tampered"))
+
+	for _, tc := range []struct {
+		name    string
+		uri     string
+		wantErr string
+	}{{
+		name:    "not a URI",
+		uri:     "hello",
+		wantErr: `invalid result URI "hello"`,
+	}, {
+		name:    "not digest-addressed",
+		uri:     "s3://results/hello",
+		wantErr: `invalid result URI "s3://results/hello"`,
+	}, {
+		name:    "other bucket",
+		uri:     "s3://other/sha256/" + digest,
+		wantErr: `result URI "s3://other/sha256/` + digest + `" is not in bucket "results"`,
+	}, {
+		name:    "missing",
+		uri:     "s3://results/sha256/0000",
+		wantErr: `failed to download result "s3://results/sha256/0000": 404 Not Found`,
+	}, {
+		name:    "digest mismatch",
+		uri:     "s3://results/sha256/" + digest,
+		wantErr: `result "s3://results/sha256/` + digest + `" does not match its digest`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := b.Download(context.Background(), tc.uri)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("Download() error = %v, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewBucketFromCredentialsDir(t *testing.T) {
+	s := objectstoragetest.NewServer(t)
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, objectstorageresults.AccessKeyIDKey), []byte("access\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, objectstorageresults.SecretAccessKeyKey), []byte("secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	b, err := objectstorageresults.NewBucketFromCredentialsDir(s.URL+"/", "results", "eu-west-1", dir)
+	if err != nil {
+		t.Fatalf("NewBucketFromCredentialsDir() returned unexpected error: %v", err)
+	}
+	if b.Endpoint != s.URL || b.Region != "eu-west-1" || b.Credentials.AccessKeyID != "access" || b.Credentials.SecretAccessKey != "secret" {
+		t.Errorf("NewBucketFromCredentialsDir() = %+v", b)
+	}
+	if _, err := b.Upload(context.Background(), []byte("hello")); err != nil {
+		t.Errorf("Upload() returned unexpected error: %v", err)
+	}
+
+	if _, err := objectstorageresults.NewBucketFromCredentialsDir(s.URL, "results", "", t.TempDir()); err == nil {
+		t.Error("NewBucketFromCredentialsDir() without credentials returned no error")
+	}
+}
+
+func TestNewBucketFromConfig(t *testing.T) {
+	secretLister := objectstoragetest.SecretLister(objectstoragetest.CredentialsSecret("foo", "results-storage"))
+	defaults, err := config.NewDefaultsFromMap(map[string]string{
+		"default-results-object-storage-endpoint": "http://minio:9000",
+		"default-results-object-storage-bucket":   "results",
+		"default-results-object-storage-secret":   "results-storage",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := config.ToContext(context.Background(), &config.Config{Defaults: defaults})
+
+	b, err := objectstorageresults.NewBucketFromConfig(ctx, secretLister, "foo")
+	if err != nil {
+		t.Fatalf("NewBucketFromConfig() returned unexpected error: %v", err)
+	}
+	if b.Endpoint != "http://minio:9000" || b.Name != "results" || b.Region != "us-east-1" || b.Credentials.AccessKeyID != "access" || b.Credentials.SecretAccessKey != "secret" {
+		t.Errorf("NewBucketFromConfig() = %+v", b)
+	}
+	if b.Client.Timeout == 0 {
+		t.Error("NewBucketFromConfig() returned a Bucket whose requests never time out")
+	}
+
+	if _, err := objectstorageresults.NewBucketFromConfig(ctx, secretLister, "bar"); err == nil {
+		t.Error("NewBucketFromConfig() without Secret returned no error")
+	}
+	if _, err := objectstorageresults.NewBucketFromConfig(context.Background(), secretLister, "foo"); err != objectstorageresults.ErrNotConfigured { //nolint:errorlint
+		t.Errorf("NewBucketFromConfig() without config error = %v, want %v", err, objectstorageresults.ErrNotConfigured)
+	}
+}
+
+func TestFetchResults(t *testing.T) {
+	s := objectstoragetest.NewServer(t)
+	b := s.Bucket()
+	ctx := context.Background()
+	upload := func(value string) string {
+		t.Helper()
+		uri, err := b.Upload(ctx, []byte(value))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return uri
+	}
+	results := []v1.TaskRunResult{{
+		Name:  "inline",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues("foo"),
+	}, {
+		Name:  "string",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues(""),
+		URI:   upload(`["a"]`),
+	}, {
+		Name:  "array",
+		Type:  v1.ResultsTypeArray,
+		Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{}},
+		URI:   upload(`["a","b"]`),
+	}, {
+		Name:  "object",
+		Type:  v1.ResultsTypeObject,
+		Value: v1.ResultValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{}},
+		URI:   upload(`{"a":"b"}`),
+	}}
+	if !objectstorageresults.HasStoredResults(results) {
+		t.Error("HasStoredResults() = false, want true")
+	}
+	cache, err := objectstorageresults.NewCache(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []v1.TaskRunResult{{
+		Name:  "inline",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues("foo"),
+	}, {
+		Name:  "string",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues(`["a"]`),
+	}, {
+		Name:  "array",
+		Type:  v1.ResultsTypeArray,
+		Value: *v1.NewStructuredValues("a", "b"),
+	}, {
+		Name:  "object",
+		Type:  v1.ResultsTypeObject,
+		Value: *v1.NewObject(map[string]string{"a": "b"}),
+	}}
+	for range 2 {
+		got, err := objectstorageresults.FetchResults(ctx, cache, b, results)
+		if err != nil {
+			t.Fatalf("FetchResults() returned unexpected error: %v", err)
+		}
+		if d := cmp.Diff(want, got); d != "" {
+			t.Errorf("FetchResults() %s", diff.PrintWantGot(d))
+		}
+	}
+	if s.Gets() != 3 {
+		t.Errorf("FetchResults() downloaded %d values, want each of the 3 values downloaded once", s.Gets())
+	}
+	if objectstorageresults.HasStoredResults(want) {
+		t.Error("HasStoredResults() = true, want false")
+	}
+}
+
+func digest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectstoragetest provides a fake S3-compatible server to test the code storing
+// objects in a bucket.
+package objectstoragetest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// BucketName is the name of the bucket returned by Server.Bucket.
+	BucketName = "results"
+	// AccessKeyID is the access key ID the Server accepts requests signed with.
+	AccessKeyID = "access"
+	// SecretAccessKey is the secret access key of the Bucket returned by Server.Bucket.
+	SecretAccessKey = "secret"
+)
+
+// Server is a minimal S3-compatible server storing objects in memory.
+type Server struct {
+	// URL is the endpoint of the server
+	URL string
+
+	mu       sync.Mutex
+	objects  map[string][]byte
+	modified map[string]time.Time
+	gets     int
+	// now is advanced by a second on every write, so that objects are ordered by modification time
+	now time.Time
+}
+
+// NewServer starts a Server, which is closed when the test ends.
+func NewServer(t *testing.T) *Server {
+	t.Helper()
+	s := &Server{objects: map[string][]byte{}, modified: map[string]time.Time{}, now: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	s.URL = server.URL
+	return s
+}
+
+// Bucket returns a Bucket named BucketName served by the Server.
+func (s *Server) Bucket() *objectstorageresults.Bucket {
+	return objectstorageresults.NewBucket(s.URL, BucketName, "", AccessKeyID, SecretAccessKey)
+}
+
+// CredentialsSecret returns a Secret holding the credentials accepted by the Server.
+func CredentialsSecret(namespace, name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Data: map[string][]byte{
+			objectstorageresults.AccessKeyIDKey:     []byte(AccessKeyID),
+			objectstorageresults.SecretAccessKeyKey: []byte(SecretAccessKey),
+		},
+	}
+}
+
+// SecretLister returns a lister of the given Secrets.
+func SecretLister(secrets ...*corev1.Secret) corev1listers.SecretLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, secret := range secrets {
+		_ = indexer.Add(secret)
+	}
+	return corev1listers.NewSecretLister(indexer)
+}
+
+// Object returns the content of the object stored under key in the bucket named BucketName.
+func (s *Server) Object(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.objects[path(key)]
+	return object, ok
+}
+
+// SetObject stores content under key in the bucket named BucketName, bypassing the checks of the requests.
+func (s *Server) SetObject(key string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[path(key)] = content
+	s.modified[path(key)] = s.now
+}
+
+// Keys returns the sorted keys of the objects stored in the bucket named BucketName.
+func (s *Server) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for p := range s.objects {
+		if key := strings.TrimPrefix(p, path("")); key != p {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// LastModified returns the last modification time of the object stored under key in the bucket named BucketName.
+func (s *Server) LastModified(key string) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.modified[path(key)]
+}
+
+// Gets returns the number of objects downloaded from the Server.
+func (s *Server) Gets() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gets
+}
+
+func path(key string) string {
+	return "/" + BucketName + "/" + key
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+AccessKeyID+"/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		s.now = s.now.Add(time.Second)
+		if source := r.Header.Get("X-Amz-Copy-Source"); source != "" {
+			object, ok := s.objects[source]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			s.objects[r.URL.Path] = object
+			s.modified[r.URL.Path] = s.now
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if sum := sha256.Sum256(body); hex.EncodeToString(sum[:]) != r.Header.Get("X-Amz-Content-Sha256") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.objects[r.URL.Path] = body
+		s.modified[r.URL.Path] = s.now
+	case http.MethodGet:
+		if r.URL.Query().Get("list-type") == "2" {
+			s.list(w, r)
+			return
+		}
+		s.gets++
+		object, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(object)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		delete(s.modified, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// list answers ListObjectsV2 requests, two objects at a time to exercise pagination.
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	bucket := r.URL.Path + "/"
+	var keys []string
+	for p := range s.objects {
+		if key := strings.TrimPrefix(p, bucket); key != p && strings.HasPrefix(key, r.URL.Query().Get("prefix")) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	start, _ := strconv.Atoi(r.URL.Query().Get("continuation-token"))
+	end := min(start+2, len(keys))
+	fmt.Fprint(w, "<ListBucketResult>")
+	for _, key := range keys[start:end] {
+		fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>%d</Size><LastModified>%s</LastModified></Contents>",
+			key, len(s.objects[bucket+key]), s.modified[bucket+key].Format(time.RFC3339))
+	}
+	if end < len(keys) {
+		fmt.Fprintf(w, "<IsTruncated>true</IsTruncated><NextContinuationToken>%d</NextContinuationToken>", end)
+	}
+	fmt.Fprint(w, "</ListBucketResult>")
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workspacecache keeps the content of the workspaces bound with cache across the TaskRuns
+// of a namespace. An init container restores a gzipped tarball saved under the key of the cache, or
+// under one of its restore keys, from the bucket configured for results in object storage, and the
+// last Step saves the workspace under the key when it was not restored from it. The controller
+// evicts the least recently used tarballs of a namespace once they exceed the configured size.
+package workspacecache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/result"
+)
+
+const (
+	// MountDir is the directory under which the volumes of the cache workspaces, and of the
+	// workspaces holding the files hashed into their keys, are mounted in the containers
+	// restoring and saving them.
+	MountDir = "/tekton/workspace-cache"
+	// StateDir is the directory in which the init container writes, for each cache workspace
+	// to save, a file named after the workspace holding the URI the last Step saves it to.
+	StateDir = "/tekton/workspace-cache-state"
+
+	keyPrefix     = "caches/"
+	archiveSuffix = ".tar.gz"
+	// resultKeyPrefix prefixes the name of the workspace in the keys of the results reporting
+	// how the cache workspaces were restored in the termination message of the init container.
+	resultKeyPrefix = "WorkspaceCache."
+)
+
+// ErrNotConfigured indicates that the bucket in which the archives are stored is not configured.
+var ErrNotConfigured = errors.New("a workspace is bound with cache but the results bucket is not configured")
+
+// Cache is a cache workspace restored by the init container.
+type Cache struct {
+	// Name is the name of the workspace
+	Name string `json:"name"`
+	v1.WorkspaceCache
+}
+
+// ResolveKey returns the key of the cache, with "$(hash)" replaced by the SHA-256 digest of the
+// names and contents of the files matching its HashFiles under root.
+func (c Cache) ResolveKey(root string) (string, error) {
+	if !strings.Contains(c.Key, v1.CacheHashVariable) {
+		return c.Key, nil
+	}
+	fsys := os.DirFS(root)
+	h := sha256.New()
+	matched := false
+	for _, pattern := range c.HashFiles {
+		names, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return "", fmt.Errorf("invalid hashFiles pattern %q: %w", pattern, err)
+		}
+		for _, name := range names {
+			info, err := fs.Stat(fsys, name)
+			if err != nil {
+				return "", err
+			}
+			if info.IsDir() {
+				continue
+			}
+			f, err := fsys.Open(name)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(h, "%s\x00", name)
+			_, err = io.Copy(h, f)
+			f.Close()
+			if err != nil {
+				return "", fmt.Errorf("failed to hash %s: %w", name, err)
+			}
+			matched = true
+		}
+	}
+	if !matched {
+		return "", fmt.Errorf("no file matches the hashFiles %q of the cache of workspace %q", c.HashFiles, c.Name)
+	}
+	return strings.ReplaceAll(c.Key, v1.CacheHashVariable, hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// Restore extracts into root/<name> the archive of the namespace saved under the key of the cache,
+// or else the most recently used one matching the first restore key with a match, and returns how
+// the workspace was restored along with the URI to save it to, which is empty when the archive of
+// the key was restored.
+func Restore(ctx context.Context, b *objectstorageresults.Bucket, namespace, root string, c Cache) (v1.WorkspaceCacheStatus, string, error) {
+	key, err := c.ResolveKey(root)
+	if err != nil {
+		return v1.WorkspaceCacheStatus{}, "", err
+	}
+	status := v1.WorkspaceCacheStatus{Name: c.Name, Key: key, Outcome: v1.WorkspaceCacheMiss}
+	prefix := keyPrefix + namespace + "/"
+
+	candidates := append([]string{key}, c.RestoreKeys...)
+	for i, candidate := range candidates {
+		objects, err := b.ListObjects(ctx, prefix+candidate)
+		if err != nil {
+			return v1.WorkspaceCacheStatus{}, "", err
+		}
+		objects = slices.DeleteFunc(objects, func(o objectstorageresults.Object) bool {
+			if i == 0 {
+				return o.Key != prefix+key+archiveSuffix
+			}
+			return !strings.HasSuffix(o.Key, archiveSuffix)
+		})
+		if len(objects) == 0 {
+			continue
+		}
+		latest := slices.MaxFunc(objects, func(a, b objectstorageresults.Object) int {
+			return a.LastModified.Compare(b.LastModified)
+		})
+		if err := workspacetransfer.Restore(ctx, b, filepath.Join(root, c.Name), objectstorageresults.URI(b.Name, latest.Key)); err != nil {
+			return v1.WorkspaceCacheStatus{}, "", err
+		}
+		// Touching the archive records that it was used for the eviction of the least recently
+		// used archives. Failing to do so only makes it more likely to be evicted.
+		_ = b.TouchObject(ctx, latest.Key)
+
+		status.RestoredKey = strings.TrimSuffix(strings.TrimPrefix(latest.Key, prefix), archiveSuffix)
+		if i == 0 {
+			status.Outcome = v1.WorkspaceCacheHit
+			return status, "", nil
+		}
+		status.Outcome = v1.WorkspaceCachePartialHit
+		break
+	}
+	return status, objectstorageresults.URI(b.Name, prefix+key+archiveSuffix), nil
+}
+
+// Evict deletes the least recently used archives of the namespace until they take at most limit bytes.
+func Evict(ctx context.Context, b *objectstorageresults.Bucket, namespace string, limit int64) error {
+	objects, err := b.ListObjects(ctx, keyPrefix+namespace+"/")
+	if err != nil {
+		return err
+	}
+	var size int64
+	for _, o := range objects {
+		size += o.Size
+	}
+	slices.SortFunc(objects, func(a, b objectstorageresults.Object) int {
+		return a.LastModified.Compare(b.LastModified)
+	})
+	for _, o := range objects {
+		if size <= limit {
+			break
+		}
+		if err := b.DeleteObject(ctx, o.Key); err != nil {
+			return err
+		}
+		size -= o.Size
+	}
+	return nil
+}
+
+// StatusResult returns the result reporting how a cache workspace was restored, written to the
+// termination message of the init container.
+func StatusResult(status v1.WorkspaceCacheStatus) (result.RunResult, error) {
+	value, err := json.Marshal(status)
+	if err != nil {
+		return result.RunResult{}, err
+	}
+	return result.RunResult{Key: resultKeyPrefix + status.Name, Value: string(value), ResultType: result.InternalTektonResultType}, nil
+}
+
+// StatusesFromResults returns how the cache workspaces were restored, from the results of the
+// termination message of the init container.
+func StatusesFromResults(results []result.RunResult) ([]v1.WorkspaceCacheStatus, error) {
+	var statuses []v1.WorkspaceCacheStatus
+	for _, r := range results {
+		if r.ResultType != result.InternalTektonResultType || !strings.HasPrefix(r.Key, resultKeyPrefix) {
+			continue
+		}
+		var status v1.WorkspaceCacheStatus
+		if err := json.Unmarshal([]byte(r.Value), &status); err != nil {
+			return nil, fmt.Errorf("invalid workspace cache status %q: %w", r.Value, err)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacecache_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults/objectstoragetest"
+	"github.com/tektoncd/pipeline/internal/workspacecache"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/result"
+	"github.com/tektoncd/pipeline/test/diff"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolveKey(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "source", "go.sum"), "v1")
+	writeFile(t, filepath.Join(root, "source", "tools", "go.sum"), "v1")
+	cache := workspacecache.Cache{Name: "gomod", WorkspaceCache: v1.WorkspaceCache{
+		Key:       "go-mod-$(hash)",
+		HashFiles: []string{"source/go.sum", "source/*/go.sum"},
+	}}
+
+	key, err := cache.ResolveKey(root)
+	if err != nil {
+		t.Fatalf("ResolveKey() = %v", err)
+	}
+	if !strings.HasPrefix(key, "go-mod-") || len(key) != len("go-mod-")+64 {
+		t.Errorf("ResolveKey() = %q, want go-mod- followed by a SHA-256 digest", key)
+	}
+	if again, err := cache.ResolveKey(root); err != nil || again != key {
+		t.Errorf("ResolveKey() = %q, %v, want %q", again, err, key)
+	}
+	writeFile(t, filepath.Join(root, "source", "tools", "go.sum"), "v2")
+	if changed, err := cache.ResolveKey(root); err != nil || changed == key {
+		t.Errorf("ResolveKey() = %q, %v, want a key other than %q once a hashed file changed", changed, err, key)
+	}
+
+	if key, err := (workspacecache.Cache{WorkspaceCache: v1.WorkspaceCache{Key: "static"}}).ResolveKey(root); err != nil || key != "static" {
+		t.Errorf("ResolveKey() = %q, %v, want %q", key, err, "static")
+	}
+	cache.HashFiles = []string{"source/package-lock.json"}
+	if _, err := cache.ResolveKey(root); err == nil {
+		t.Error("expected an error when no file matches hashFiles")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	bucket := objectstoragetest.NewServer(t).Bucket()
+	ctx := t.Context()
+	cache := workspacecache.Cache{Name: "gomod", WorkspaceCache: v1.WorkspaceCache{
+		Key:         "go-mod-v2",
+		RestoreKeys: []string{"go-mod-"},
+	}}
+
+	save := func(key, content string) {
+		t.Helper()
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "content"), content)
+		if err := workspacetransfer.Snapshot(ctx, bucket, dir, "s3://results/caches/"+key+".tar.gz", t.TempDir()); err != nil {
+			t.Fatalf("Snapshot() = %v", err)
+		}
+	}
+	restore := func(c workspacecache.Cache) (v1.WorkspaceCacheStatus, string, string) {
+		t.Helper()
+		root := t.TempDir()
+		if err := os.Mkdir(filepath.Join(root, c.Name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		status, uri, err := workspacecache.Restore(ctx, bucket, "foo", root, c)
+		if err != nil {
+			t.Fatalf("Restore() = %v", err)
+		}
+		content, err := os.ReadFile(filepath.Join(root, c.Name, "content"))
+		if err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		return status, uri, string(content)
+	}
+
+	status, uri, content := restore(cache)
+	want := v1.WorkspaceCacheStatus{Name: "gomod", Key: "go-mod-v2", Outcome: v1.WorkspaceCacheMiss}
+	if d := cmp.Diff(want, status); d != "" || uri != "s3://results/caches/foo/go-mod-v2.tar.gz" || content != "" {
+		t.Errorf("Restore() = %s, %q, %q", diff.PrintWantGot(d), uri, content)
+	}
+
+	save("foo/go-mod-v0", "v0")
+	save("foo/go-mod-v1", "v1")
+	save("bar/go-mod-v2", "bar")
+	status, uri, content = restore(cache)
+	want = v1.WorkspaceCacheStatus{Name: "gomod", Key: "go-mod-v2", RestoredKey: "go-mod-v1", Outcome: v1.WorkspaceCachePartialHit}
+	if d := cmp.Diff(want, status); d != "" || uri != "s3://results/caches/foo/go-mod-v2.tar.gz" || content != "v1" {
+		t.Errorf("Restore() = %s, %q, %q", diff.PrintWantGot(d), uri, content)
+	}
+
+	save("foo/go-mod-v2", "v2")
+	status, uri, content = restore(cache)
+	want = v1.WorkspaceCacheStatus{Name: "gomod", Key: "go-mod-v2", RestoredKey: "go-mod-v2", Outcome: v1.WorkspaceCacheHit}
+	if d := cmp.Diff(want, status); d != "" || uri != "" || content != "v2" {
+		t.Errorf("Restore() = %s, %q, %q", diff.PrintWantGot(d), uri, content)
+	}
+
+	// Restoring go-mod-v0 makes it the most recently used archive matching the restore key.
+	cache.Key = "go-mod-v0"
+	if status, _, _ := restore(cache); status.Outcome != v1.WorkspaceCacheHit {
+		t.Fatalf("Restore() outcome = %s, want %s", status.Outcome, v1.WorkspaceCacheHit)
+	}
+	cache.Key = "go-mod-v3"
+	if status, _, content := restore(cache); status.RestoredKey != "go-mod-v0" || content != "v0" {
+		t.Errorf("Restore() restored %q with %q, want the most recently used go-mod-v0", status.RestoredKey, content)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	s := objectstoragetest.NewServer(t)
+	bucket := s.Bucket()
+	ctx := t.Context()
+	for _, key := range []string{"caches/foo/a.tar.gz", "caches/foo/b.tar.gz", "caches/foo/c.tar.gz", "caches/bar/a.tar.gz"} {
+		sum := sha256.Sum256([]byte("0123456789"))
+		if err := bucket.PutObject(ctx, key, strings.NewReader("0123456789"), 10, hex.EncodeToString(sum[:])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := bucket.TouchObject(ctx, "caches/foo/a.tar.gz"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := workspacecache.Evict(ctx, bucket, "foo", 20); err != nil {
+		t.Fatalf("Evict() = %v", err)
+	}
+	want := []string{"caches/bar/a.tar.gz", "caches/foo/a.tar.gz", "caches/foo/c.tar.gz"}
+	if d := cmp.Diff(want, s.Keys()); d != "" {
+		t.Errorf("objects after eviction %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestStatusResult(t *testing.T) {
+	status := v1.WorkspaceCacheStatus{Name: "gomod", Key: "go-mod-v2", RestoredKey: "go-mod-v1", Outcome: v1.WorkspaceCachePartialHit}
+	r, err := workspacecache.StatusResult(status)
+	if err != nil {
+		t.Fatalf("StatusResult() = %v", err)
+	}
+	statuses, err := workspacecache.StatusesFromResults([]result.RunResult{{Key: "StartedAt", Value: "2026-01-01T00:00:00Z", ResultType: result.InternalTektonResultType}, r})
+	if err != nil {
+		t.Fatalf("StatusesFromResults() = %v", err)
+	}
+	if d := cmp.Diff([]v1.WorkspaceCacheStatus{status}, statuses); d != "" {
+		t.Errorf("StatusesFromResults() %s", diff.PrintWantGot(d))
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workspacetransfer moves the content of the workspaces bound with transfer between
+// the TaskRuns of a PipelineRun. The last Step of a TaskRun uploads a gzipped tarball of the
+// workspace to the bucket configured for results in object storage, and an init container
+// extracts it into the workspaces of the TaskRuns which depend on it.
+package workspacetransfer
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+)
+
+const (
+	// MountDir is the directory under which the volumes of the workspaces bound with transfer
+	// are mounted in the containers restoring and snapshotting them.
+	MountDir = "/tekton/workspace-transfer"
+
+	keyPrefix = "workspaces/"
+)
+
+// ErrNotConfigured indicates that the bucket in which the snapshots are stored is not configured.
+var ErrNotConfigured = errors.New("a workspace is bound with transfer but the results bucket is not configured")
+
+// SnapshotURI returns the URI, in the named bucket, of the snapshot of the workspace of a TaskRun.
+func SnapshotURI(bucket, namespace, taskRunName, workspaceName string) string {
+	return objectstorageresults.URI(bucket, path.Join(keyPrefix+namespace, taskRunName, workspaceName+".tar.gz"))
+}
+
+// InNamespace returns true if the URI is the one of a snapshot taken by a TaskRun of the
+// namespace in the named bucket, so that TaskRuns cannot read the snapshots of other namespaces.
+func InNamespace(uri, bucket, namespace string) bool {
+	prefix := objectstorageresults.URI(bucket, keyPrefix+namespace+"/")
+	return strings.HasPrefix(uri, prefix) && !strings.Contains(strings.TrimPrefix(uri, prefix), "..")
+}
+
+// Snapshot uploads the content of dir to the URI as a gzipped tarball, which is first
+// written to a temporary file in scratchDir.
+func Snapshot(ctx context.Context, b *objectstorageresults.Bucket, dir, uri, scratchDir string) error {
+	key, err := b.Key(uri)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(scratchDir, "workspace-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	h := sha256.New()
+	gz := gzip.NewWriter(io.MultiWriter(f, h))
+	tw := tar.NewWriter(gz)
+	if err := tw.AddFS(os.DirFS(dir)); err != nil {
+		return fmt.Errorf("failed to archive %s: %w", dir, err)
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := b.PutObject(ctx, key, f, size, hex.EncodeToString(h.Sum(nil))); err != nil {
+		return fmt.Errorf("failed to upload the snapshot of %s: %w", dir, err)
+	}
+	return nil
+}
+
+// Snapshotter uploads the snapshots of workspaces to a bucket.
+type Snapshotter struct {
+	// Bucket is the bucket the snapshots are uploaded to
+	Bucket *objectstorageresults.Bucket
+	// ScratchDir is the directory the snapshots are written to before being uploaded
+	ScratchDir string
+}
+
+// Snapshot uploads the content of dir to the URI.
+func (s Snapshotter) Snapshot(ctx context.Context, dir, uri string) error {
+	return Snapshot(ctx, s.Bucket, dir, uri, s.ScratchDir)
+}
+
+// Restore extracts the snapshot at the URI into dir, overwriting the files it already holds.
+// Entries of the snapshot cannot be written outside of dir.
+func Restore(ctx context.Context, b *objectstorageresults.Bucket, dir, uri string) error {
+	key, err := b.Key(uri)
+	if err != nil {
+		return err
+	}
+	body, err := b.GetObject(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to download the snapshot %s: %w", uri, err)
+	}
+	defer body.Close()
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("failed to read the snapshot %s: %w", uri, err)
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read the snapshot %s: %w", uri, err)
+		}
+		if err := extract(root, hdr, tr); err != nil {
+			return fmt.Errorf("failed to extract %s from the snapshot %s: %w", hdr.Name, uri, err)
+		}
+	}
+}
+
+// extract writes the entry of the tarball described by hdr under root.
+func extract(root *os.Root, hdr *tar.Header, r io.Reader) error {
+	name := filepath.FromSlash(hdr.Name)
+	perm := hdr.FileInfo().Mode().Perm()
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		return root.MkdirAll(name, perm)
+	case tar.TypeReg:
+		if err := root.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			return err
+		}
+		f, err := root.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, r); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		// The mode of a file which already existed is not changed by OpenFile.
+		return root.Chmod(name, perm)
+	case tar.TypeSymlink:
+		if err := root.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			return err
+		}
+		if err := root.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return root.Symlink(hdr.Linkname, name)
+	default:
+		// Snapshots only hold directories, regular files and symbolic links.
+		return nil
+	}
+}
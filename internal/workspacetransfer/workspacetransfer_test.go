@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacetransfer_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults/objectstoragetest"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+)
+
+func TestSnapshotURI(t *testing.T) {
+	uri := workspacetransfer.SnapshotURI("results", "foo", "pr-build", "source")
+	if want := "s3://results/workspaces/foo/pr-build/source.tar.gz"; uri != want {
+		t.Errorf("SnapshotURI() = %q, want %q", uri, want)
+	}
+}
+
+func TestInNamespace(t *testing.T) {
+	for _, tc := range []struct {
+		uri  string
+		want bool
+	}{
+		{uri: "s3://results/workspaces/foo/pr-build/source.tar.gz", want: true},
+		{uri: "s3://results/workspaces/bar/pr-build/source.tar.gz", want: false},
+		{uri: "s3://results/workspaces/foobar/pr-build/source.tar.gz", want: false},
+		{uri: "s3://other/workspaces/foo/pr-build/source.tar.gz", want: false},
+		{uri: "s3://results/workspaces/foo/../bar/pr-build/source.tar.gz", want: false},
+		{uri: "s3://results/sha256/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", want: false},
+	} {
+		if got := workspacetransfer.InNamespace(tc.uri, "results", "foo"); got != tc.want {
+			t.Errorf("InNamespace(%q) = %t, want %t", tc.uri, got, tc.want)
+		}
+	}
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	b := objectstoragetest.NewServer(t).Bucket()
+	ctx := context.Background()
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "pkg", "app"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "pkg", "app", "main.go"), []byte("package main"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "build.sh"), []byte("#!/bin/sh"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("pkg/app/main.go", filepath.Join(src, "main.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	uri := workspacetransfer.SnapshotURI("results", "foo", "pr-build", "source")
+	scratch := t.TempDir()
+	if err := workspacetransfer.Snapshot(ctx, b, src, uri, scratch); err != nil {
+		t.Fatalf("Snapshot() returned unexpected error: %v", err)
+	}
+	if entries, err := os.ReadDir(scratch); err != nil || len(entries) != 0 {
+		t.Errorf("Snapshot() left files in the scratch directory: %v, %v", entries, err)
+	}
+
+	dst := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dst, "build.sh"), []byte("stale"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := workspacetransfer.Restore(ctx, b, dst, uri); err != nil {
+		t.Fatalf("Restore() returned unexpected error: %v", err)
+	}
+	for name, want := range map[string]string{
+		"pkg/app/main.go": "package main",
+		"build.sh":        "#!/bin/sh",
+		"main.go":         "package main",
+	} {
+		got, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Errorf("Restore() did not restore %s: %v", name, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("Restore() restored %s with %q, want %q", name, got, want)
+		}
+	}
+	if target, err := os.Readlink(filepath.Join(dst, "main.go")); err != nil || target != "pkg/app/main.go" {
+		t.Errorf("Restore() did not restore the symbolic link: %q, %v", target, err)
+	}
+	if fi, err := os.Stat(filepath.Join(dst, "build.sh")); err != nil || fi.Mode().Perm()&0o100 == 0 {
+		t.Errorf("Restore() did not restore the mode of build.sh: %v, %v", fi, err)
+	}
+}
+
+func TestRestore_Errors(t *testing.T) {
+	s := objectstoragetest.NewServer(t)
+	b := s.Bucket()
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	content := []byte("escaped")
+	if err := tw.WriteHeader(&tar.Header{Name: "../escaped", Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	s.SetObject("workspaces/foo/pr-evil/source.tar.gz", buf.Bytes())
+
+	for _, tc := range []struct {
+		name string
+		uri  string
+	}{{
+		name: "missing snapshot",
+		uri:  "s3://results/workspaces/foo/pr-missing/source.tar.gz",
+	}, {
+		name: "other bucket",
+		uri:  "s3://other/workspaces/foo/pr-build/source.tar.gz",
+	}, {
+		name: "entry outside of the workspace",
+		uri:  "s3://results/workspaces/foo/pr-evil/source.tar.gz",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := t.TempDir()
+			dst := filepath.Join(parent, "workspace")
+			if err := os.Mkdir(dst, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := workspacetransfer.Restore(ctx, b, dst, tc.uri); err == nil {
+				t.Errorf("Restore() expected an error")
+			}
+			if _, err := os.Stat(filepath.Join(parent, "escaped")); err == nil {
+				t.Errorf("Restore() wrote outside of the workspace")
+			}
+		})
+	}
+}
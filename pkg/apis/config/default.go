@@ -27,6 +27,7 @@ import (
 
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/yaml"
 )
@@ -44,6 +45,9 @@ const (
 	DefaultCloudEventSinkValue = ""
 	// DefaultMaxMatrixCombinationsCount is used when no max matrix combinations count is specified.
 	DefaultMaxMatrixCombinationsCount = 256
+	// DefaultMaxMatrixConcurrency is used when no max matrix concurrency is specified, 0 does not bound
+	// the number of Combinations of a Matrix running at once.
+	DefaultMaxMatrixConcurrency = 0
 	// DefaultResolverTypeValue is used when no default resolver type is specified
 	DefaultResolverTypeValue = ""
 	// default resource requirements, will be applied to all the containers, which has empty resource requirements
@@ -69,6 +73,7 @@ const (
 	defaultCloudEventsSinkKey               = "default-cloud-events-sink"
 	defaultTaskRunWorkspaceBinding          = "default-task-run-workspace-binding"
 	defaultMaxMatrixCombinationsCountKey    = "default-max-matrix-combinations-count"
+	defaultMaxMatrixConcurrencyKey          = "default-max-matrix-concurrency"
 	defaultForbiddenEnv                     = "default-forbidden-env"
 	defaultResolverTypeKey                  = "default-resolver-type"
 	defaultContainerResourceRequirementsKey = "default-container-resource-requirements"
@@ -77,6 +82,15 @@ const (
 	defaultMaximumResolutionTimeout         = "default-maximum-resolution-timeout"
 	defaultSidecarLogPollingIntervalKey     = "default-sidecar-log-polling-interval"
 	DefaultStepRefConcurrencyLimitKey       = "default-step-ref-concurrency-limit"
+
+	defaultResultsObjectStorageEndpointKey = "default-results-object-storage-endpoint"
+	defaultResultsObjectStorageBucketKey   = "default-results-object-storage-bucket"
+	defaultResultsObjectStorageRegionKey   = "default-results-object-storage-region"
+	defaultResultsObjectStorageSecretKey   = "default-results-object-storage-secret"
+
+	defaultProvenanceSigningSecretKey = "default-provenance-signing-secret"
+
+	defaultWorkspaceCacheSizeLimitKey = "default-workspace-cache-size-limit"
 )
 
 // DefaultConfig holds all the default configurations for the config.
@@ -93,6 +107,7 @@ type Defaults struct {
 	DefaultCloudEventsSink               string // Deprecated. Use the events package instead
 	DefaultTaskRunWorkspaceBinding       string
 	DefaultMaxMatrixCombinationsCount    int
+	DefaultMaxMatrixConcurrency          int
 	DefaultForbiddenEnv                  []string
 	DefaultResolverType                  string
 	DefaultContainerResourceRequirements map[string]corev1.ResourceRequirements
@@ -104,6 +119,20 @@ type Defaults struct {
 	// It is used to control the responsiveness and resource usage of the sidecar in both production and test environments.
 	DefaultSidecarLogPollingInterval time.Duration
 	DefaultStepRefConcurrencyLimit   int
+	// DefaultResultsObjectStorageEndpoint, DefaultResultsObjectStorageBucket and DefaultResultsObjectStorageRegion
+	// locate the S3-compatible bucket in which results are stored when "results-from" is set to "object-storage".
+	// DefaultResultsObjectStorageSecret is the name of the Secret holding the credentials to access the bucket,
+	// which must exist in the namespaces of the TaskRuns.
+	DefaultResultsObjectStorageEndpoint string
+	DefaultResultsObjectStorageBucket   string
+	DefaultResultsObjectStorageRegion   string
+	DefaultResultsObjectStorageSecret   string
+	// DefaultProvenanceSigningSecret is the name of the Secret, in the namespace of the controller, holding
+	// the key signing provenance attestations when "enable-provenance-attestations" is set to "true".
+	DefaultProvenanceSigningSecret string
+	// DefaultWorkspaceCacheSizeLimit is the number of bytes the archives of the cache workspaces of a
+	// namespace can take in the bucket before the least recently used ones are evicted, 0 for no limit.
+	DefaultWorkspaceCacheSizeLimit int64
 }
 
 // GetDefaultsConfigName returns the name of the configmap containing all
@@ -133,12 +162,19 @@ func (cfg *Defaults) Equals(other *Defaults) bool {
 		other.DefaultCloudEventsSink == cfg.DefaultCloudEventsSink &&
 		other.DefaultTaskRunWorkspaceBinding == cfg.DefaultTaskRunWorkspaceBinding &&
 		other.DefaultMaxMatrixCombinationsCount == cfg.DefaultMaxMatrixCombinationsCount &&
+		other.DefaultMaxMatrixConcurrency == cfg.DefaultMaxMatrixConcurrency &&
 		other.DefaultResolverType == cfg.DefaultResolverType &&
 		other.DefaultImagePullBackOffTimeout == cfg.DefaultImagePullBackOffTimeout &&
 		other.DefaultCreateContainerErrorTimeout == cfg.DefaultCreateContainerErrorTimeout &&
 		other.DefaultMaximumResolutionTimeout == cfg.DefaultMaximumResolutionTimeout &&
 		other.DefaultSidecarLogPollingInterval == cfg.DefaultSidecarLogPollingInterval &&
 		other.DefaultStepRefConcurrencyLimit == cfg.DefaultStepRefConcurrencyLimit &&
+		other.DefaultResultsObjectStorageEndpoint == cfg.DefaultResultsObjectStorageEndpoint &&
+		other.DefaultResultsObjectStorageBucket == cfg.DefaultResultsObjectStorageBucket &&
+		other.DefaultResultsObjectStorageRegion == cfg.DefaultResultsObjectStorageRegion &&
+		other.DefaultResultsObjectStorageSecret == cfg.DefaultResultsObjectStorageSecret &&
+		other.DefaultProvenanceSigningSecret == cfg.DefaultProvenanceSigningSecret &&
+		other.DefaultWorkspaceCacheSizeLimit == cfg.DefaultWorkspaceCacheSizeLimit &&
 		reflect.DeepEqual(other.DefaultForbiddenEnv, cfg.DefaultForbiddenEnv)
 }
 
@@ -150,6 +186,7 @@ func NewDefaultsFromMap(cfgMap map[string]string) (*Defaults, error) {
 		DefaultManagedByLabelValue:         DefaultManagedByLabelValue,
 		DefaultCloudEventsSink:             DefaultCloudEventSinkValue,
 		DefaultMaxMatrixCombinationsCount:  DefaultMaxMatrixCombinationsCount,
+		DefaultMaxMatrixConcurrency:        DefaultMaxMatrixConcurrency,
 		DefaultResolverType:                DefaultResolverTypeValue,
 		DefaultImagePullBackOffTimeout:     DefaultImagePullBackOffTimeout,
 		DefaultCreateContainerErrorTimeout: DefaultCreateContainerErrorTimeout,
@@ -205,6 +242,14 @@ func NewDefaultsFromMap(cfgMap map[string]string) (*Defaults, error) {
 		}
 		tc.DefaultMaxMatrixCombinationsCount = int(matrixCombinationsCount)
 	}
+
+	if defaultMaxMatrixConcurrency, ok := cfgMap[defaultMaxMatrixConcurrencyKey]; ok {
+		matrixConcurrency, err := strconv.ParseInt(defaultMaxMatrixConcurrency, 10, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing default config %q", defaultMaxMatrixConcurrencyKey)
+		}
+		tc.DefaultMaxMatrixConcurrency = int(matrixConcurrency)
+	}
 	if defaultForbiddenEnvString, ok := cfgMap[defaultForbiddenEnv]; ok {
 		tmpString := sets.NewString()
 		fEnvs := strings.Split(defaultForbiddenEnvString, ",")
@@ -266,6 +311,34 @@ func NewDefaultsFromMap(cfgMap map[string]string) (*Defaults, error) {
 		tc.DefaultStepRefConcurrencyLimit = int(stepRefConcurrencyLimit)
 	}
 
+	if endpoint, ok := cfgMap[defaultResultsObjectStorageEndpointKey]; ok {
+		tc.DefaultResultsObjectStorageEndpoint = endpoint
+	}
+
+	if bucket, ok := cfgMap[defaultResultsObjectStorageBucketKey]; ok {
+		tc.DefaultResultsObjectStorageBucket = bucket
+	}
+
+	if region, ok := cfgMap[defaultResultsObjectStorageRegionKey]; ok {
+		tc.DefaultResultsObjectStorageRegion = region
+	}
+
+	if secret, ok := cfgMap[defaultResultsObjectStorageSecretKey]; ok {
+		tc.DefaultResultsObjectStorageSecret = secret
+	}
+
+	if secret, ok := cfgMap[defaultProvenanceSigningSecretKey]; ok {
+		tc.DefaultProvenanceSigningSecret = secret
+	}
+
+	if sizeLimit, ok := cfgMap[defaultWorkspaceCacheSizeLimitKey]; ok {
+		q, err := resource.ParseQuantity(sizeLimit)
+		if err != nil || q.Sign() < 0 {
+			return nil, fmt.Errorf("failed parsing default config %q", defaultWorkspaceCacheSizeLimitKey)
+		}
+		tc.DefaultWorkspaceCacheSizeLimit = q.Value()
+	}
+
 	return &tc, nil
 }
 
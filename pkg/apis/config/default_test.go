@@ -127,6 +127,7 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 			fileName:      "config-defaults-matrix",
 			expectedConfig: &config.Defaults{
 				DefaultMaxMatrixCombinationsCount:  1024,
+				DefaultMaxMatrixConcurrency:        16,
 				DefaultTimeoutMinutes:              60,
 				DefaultServiceAccount:              "default",
 				DefaultManagedByLabelValue:         config.DefaultManagedByLabelValue,
@@ -240,6 +241,55 @@ func TestNewDefaultsFromConfigMap(t *testing.T) {
 				DefaultSidecarLogPollingInterval:   100 * time.Millisecond,
 			},
 		},
+		{
+			expectedError: false,
+			fileName:      "config-defaults-results-object-storage",
+			expectedConfig: &config.Defaults{
+				DefaultTimeoutMinutes:               60,
+				DefaultServiceAccount:               "default",
+				DefaultManagedByLabelValue:          config.DefaultManagedByLabelValue,
+				DefaultMaxMatrixCombinationsCount:   256,
+				DefaultMaximumResolutionTimeout:     1 * time.Minute,
+				DefaultSidecarLogPollingInterval:    100 * time.Millisecond,
+				DefaultStepRefConcurrencyLimit:      5,
+				DefaultResultsObjectStorageEndpoint: "http://minio.minio.svc:9000",
+				DefaultResultsObjectStorageBucket:   "tekton-results",
+				DefaultResultsObjectStorageRegion:   "eu-west-1",
+				DefaultResultsObjectStorageSecret:   "results-storage-credentials",
+			},
+		},
+		{
+			expectedError: false,
+			fileName:      "config-defaults-provenance-signing-secret",
+			expectedConfig: &config.Defaults{
+				DefaultTimeoutMinutes:             60,
+				DefaultServiceAccount:             "default",
+				DefaultManagedByLabelValue:        config.DefaultManagedByLabelValue,
+				DefaultMaxMatrixCombinationsCount: 256,
+				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultSidecarLogPollingInterval:  100 * time.Millisecond,
+				DefaultStepRefConcurrencyLimit:    5,
+				DefaultProvenanceSigningSecret:    "signing-secrets",
+			},
+		},
+		{
+			expectedError: false,
+			fileName:      "config-defaults-workspace-cache-size-limit",
+			expectedConfig: &config.Defaults{
+				DefaultTimeoutMinutes:             60,
+				DefaultServiceAccount:             "default",
+				DefaultManagedByLabelValue:        config.DefaultManagedByLabelValue,
+				DefaultMaxMatrixCombinationsCount: 256,
+				DefaultMaximumResolutionTimeout:   1 * time.Minute,
+				DefaultSidecarLogPollingInterval:  100 * time.Millisecond,
+				DefaultStepRefConcurrencyLimit:    5,
+				DefaultWorkspaceCacheSizeLimit:    10 * 1024 * 1024 * 1024,
+			},
+		},
+		{
+			expectedError: true,
+			fileName:      "config-defaults-workspace-cache-size-limit-err",
+		},
 	}
 
 	for _, tc := range testCases {
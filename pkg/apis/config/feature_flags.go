@@ -57,6 +57,8 @@ const (
 	ResultExtractionMethodTerminationMessage = "termination-message"
 	// ResultExtractionMethodSidecarLogs is the value used for "results-from" as a way to extract results from tasks using sidecar logs.
 	ResultExtractionMethodSidecarLogs = "sidecar-logs"
+	// ResultExtractionMethodObjectStorage is the value used for "results-from" as a way to extract results from tasks using an S3-compatible object storage.
+	ResultExtractionMethodObjectStorage = "object-storage"
 	// DefaultDisableCredsInit is the default value for "disable-creds-init".
 	DefaultDisableCredsInit = false
 	// DefaultRunningInEnvWithInjectedSidecars is the default value for "running-in-environment-with-injected-sidecars".
@@ -121,6 +123,16 @@ const (
 	EnableTerminationMessageCompression = "enable-termination-message-compression"
 	// DefaultEnableTerminationMessageCompression is the default value for EnableTerminationMessageCompression
 	DefaultEnableTerminationMessageCompression = false
+	// EnableProvenanceAttestations is the flag to enable generating a signed in-toto SLSA provenance
+	// attestation for each TaskRun and PipelineRun when it completes.
+	EnableProvenanceAttestations = "enable-provenance-attestations"
+
+	// EnablePodLevelResources is the flag to set the task-level compute resources of a TaskRun as the pod-level
+	// resources of its Pod, which requires the PodLevelResources feature of the cluster, instead of dividing
+	// them among its Steps.
+	EnablePodLevelResources = "enable-pod-level-resources"
+	// DefaultEnablePodLevelResources is the default value for EnablePodLevelResources
+	DefaultEnablePodLevelResources = false
 
 	// EnableStepActions is the flag to enable step actions (no-op since it's stable)
 	EnableStepActions = "enable-step-actions"
@@ -193,6 +205,13 @@ var (
 		Enabled:   DefaultAlphaFeatureEnabled,
 	}
 
+	// DefaultEnableProvenanceAttestationsFlag is the default PerFeatureFlag value for EnableProvenanceAttestations
+	DefaultEnableProvenanceAttestationsFlag = PerFeatureFlag{
+		Name:      EnableProvenanceAttestations,
+		Stability: AlphaAPIFields,
+		Enabled:   DefaultAlphaFeatureEnabled,
+	}
+
 	DefaultEnableTektonOCIBundles = PerFeatureFlag{
 		Name:       EnableTektonOCIBundles,
 		Stability:  AlphaAPIFields,
@@ -235,6 +254,8 @@ type FeatureFlags struct {
 	EnableKubernetesSidecar             bool   `json:"enableKubernetesSidecar,omitempty"`
 	EnableWaitExponentialBackoff        bool   `json:"enableWaitExponentialBackoff,omitempty"`
 	EnableTerminationMessageCompression bool   `json:"enableTerminationMessageCompression,omitempty"`
+	EnableProvenanceAttestations        bool   `json:"enableProvenanceAttestations,omitempty"`
+	EnablePodLevelResources             bool   `json:"enablePodLevelResources,omitempty"`
 	// DeprecatedEnableTektonOCIBundles is maintained for backward compatibility
 	// to allow deletion of PipelineRuns created before v0.62.x.
 	// This field is not used and can be removed in a future release
@@ -351,6 +372,12 @@ func NewFeatureFlagsFromMap(cfgMap map[string]string) (*FeatureFlags, error) {
 	if err := setPerFeatureFlag(EnableTerminationMessageCompression, DefaultEnableTerminationMessageCompressionFlag, &tc.EnableTerminationMessageCompression); err != nil {
 		return nil, err
 	}
+	if err := setPerFeatureFlag(EnableProvenanceAttestations, DefaultEnableProvenanceAttestationsFlag, &tc.EnableProvenanceAttestations); err != nil {
+		return nil, err
+	}
+	if err := setFeature(EnablePodLevelResources, DefaultEnablePodLevelResources, &tc.EnablePodLevelResources); err != nil {
+		return nil, err
+	}
 
 	return &tc, nil
 }
@@ -423,7 +450,7 @@ func setResultExtractionMethod(cfgMap map[string]string, defaultValue string, fe
 		value = strings.ToLower(cfg)
 	}
 	switch value {
-	case ResultExtractionMethodTerminationMessage, ResultExtractionMethodSidecarLogs:
+	case ResultExtractionMethodTerminationMessage, ResultExtractionMethodSidecarLogs, ResultExtractionMethodObjectStorage:
 		*feature = value
 	default:
 		return fmt.Errorf("invalid value for feature flag %q: %q", resultExtractionMethod, value)
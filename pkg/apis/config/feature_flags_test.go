@@ -81,6 +81,8 @@ func TestNewFeatureFlagsFromConfigMap(t *testing.T) {
 				EnableConciseResolverSyntax:              true,
 				EnableKubernetesSidecar:                  true,
 				EnableTerminationMessageCompression:      true,
+				EnableProvenanceAttestations:             true,
+				EnablePodLevelResources:                  true,
 			},
 			fileName: "feature-flags-all-flags-set",
 		},
@@ -189,6 +191,26 @@ func TestNewFeatureFlagsFromConfigMap(t *testing.T) {
 			},
 			fileName: "feature-flags-results-via-sidecar-logs",
 		},
+		{
+			expectedConfig: &config.FeatureFlags{
+				EnableAPIFields:                  config.DefaultEnableAPIFields,
+				SendCloudEventsForRuns:           config.DefaultSendCloudEventsForRuns,
+				EnforceNonfalsifiability:         config.DefaultEnforceNonfalsifiability,
+				VerificationNoMatchPolicy:        config.DefaultNoMatchPolicyConfig,
+				RunningInEnvWithInjectedSidecars: config.DefaultRunningInEnvWithInjectedSidecars,
+				AwaitSidecarReadiness:            config.DefaultAwaitSidecarReadiness,
+				EnableProvenanceInStatus:         config.DefaultEnableProvenanceInStatus,
+				ResultExtractionMethod:           config.ResultExtractionMethodObjectStorage,
+				MaxResultSize:                    config.DefaultMaxResultSize,
+				SetSecurityContext:               config.DefaultSetSecurityContext,
+				Coschedule:                       config.DefaultCoschedule,
+				EnableKeepPodOnCancel:            config.DefaultEnableKeepPodOnCancel.Enabled,
+				EnableCELInWhenExpression:        config.DefaultEnableCELInWhenExpression.Enabled,
+				EnableParamEnum:                  config.DefaultEnableParamEnum.Enabled,
+				DisableInlineSpec:                config.DefaultDisableInlineSpec,
+			},
+			fileName: "feature-flags-results-via-object-storage",
+		},
 	}
 
 	for _, tc := range testCases {
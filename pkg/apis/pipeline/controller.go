@@ -34,4 +34,7 @@ const (
 
 	// CustomRunControllerName holds the name of the CustomRun controller
 	CustomRunControllerName = "CustomRun"
+
+	// ApprovalRequestControllerName holds the name of the ApprovalRequest controller
+	ApprovalRequestControllerName = "ApprovalRequest"
 )
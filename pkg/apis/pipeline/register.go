@@ -56,6 +56,18 @@ const (
 	// Set to Tasks/Finally depending on the position of the PipelineTask
 	MemberOfLabelKey = GroupName + "/memberOf"
 
+	// CacheKeyLabelKey is used as the label identifier for the cache key of a TaskRun
+	// created for a PipelineTask with caching enabled
+	CacheKeyLabelKey = GroupName + "/cacheKey"
+
+	// ConcurrencyGroupLabelKey is used as the label identifier for the digest of the
+	// concurrency group of a PipelineRun
+	ConcurrencyGroupLabelKey = GroupName + "/concurrencyGroup"
+
+	// ConcurrencyQueuedAnnotationKey is used as the annotation identifier for a PipelineRun
+	// held pending by the controller until a slot frees up in its concurrency group
+	ConcurrencyQueuedAnnotationKey = GroupName + "/concurrencyQueued"
+
 	// ManagedBy is the value of the "managedBy" field for resources
 	// managed by the Tekton Pipeline controller.
 	ManagedBy = GroupName + "/pipeline"
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// TaskCache enables the reuse of a previous successful TaskRun of a PipelineTask.
+// The cache key is a digest of the resolved Task spec, the params of the TaskRun,
+// the taskRunSpecs overrides of the PipelineTask and the digests of the workspaces
+// listed in Workspaces.
+type TaskCache struct {
+	// Workspaces declares the digests of the content of workspaces used as inputs of the
+	// PipelineTask, e.g. the revision fetched into a source workspace.
+	// Workspaces that are not listed do not take part in the cache key.
+	// +optional
+	// +listType=atomic
+	Workspaces []CacheWorkspace `json:"workspaces,omitempty"`
+}
+
+// CacheWorkspace declares the digest of the content of a workspace bound to a cached PipelineTask
+type CacheWorkspace struct {
+	// Name is the name of the workspace binding in the PipelineTask
+	Name string `json:"name"`
+	// Digest identifies the content of the workspace. It can reference params and
+	// results of previous PipelineTasks.
+	Digest string `json:"digest"`
+}
+
+// GetVarSubstitutionExpressions extracts all the values between "$(" and ")" in the workspace digests
+func (tc *TaskCache) GetVarSubstitutionExpressions() []string {
+	if tc == nil {
+		return nil
+	}
+	var allExpressions []string
+	for _, cw := range tc.Workspaces {
+		allExpressions = append(allExpressions, validateString(cw.Digest)...)
+	}
+	return allExpressions
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	"github.com/tektoncd/pipeline/test/diff"
+	"knative.dev/pkg/apis"
+)
+
+func TestPipelineTask_ValidateCache(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		pt      PipelineTask
+		wantErr *apis.FieldError
+		wc      func(context.Context) context.Context
+	}{{
+		name: "no cache",
+		pt:   PipelineTask{Name: "foo", TaskRef: &TaskRef{Name: "bar"}},
+	}, {
+		name: "cache with workspace digests",
+		pt: PipelineTask{
+			Name:       "foo",
+			TaskRef:    &TaskRef{Name: "bar"},
+			Workspaces: []WorkspacePipelineTaskBinding{{Name: "source", Workspace: "ws"}},
+			Cache: &TaskCache{Workspaces: []CacheWorkspace{{
+				Name:   "source",
+				Digest: "$(tasks.fetch.results.commit)",
+			}}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:    "cache requires alpha api fields",
+		pt:      PipelineTask{Name: "foo", TaskRef: &TaskRef{Name: "bar"}, Cache: &TaskCache{}},
+		wantErr: apis.ErrGeneric(`cache requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "cache with matrix",
+		pt: PipelineTask{
+			Name:    "foo",
+			TaskRef: &TaskRef{Name: "bar"},
+			Matrix: &Matrix{Params: Params{{
+				Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}},
+			}}},
+			Cache: &TaskCache{},
+		},
+		wantErr: apis.ErrMultipleOneOf("cache", "matrix"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "cache with custom task",
+		pt: PipelineTask{
+			Name:    "foo",
+			TaskRef: &TaskRef{APIVersion: "example.dev/v0", Kind: "Example"},
+			Cache:   &TaskCache{},
+		},
+		wantErr: apis.ErrInvalidValue("cache is not supported for custom tasks", "cache"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "cache with child pipeline",
+		pt: PipelineTask{
+			Name:        "foo",
+			PipelineRef: &PipelineRef{Name: "bar"},
+			Cache:       &TaskCache{},
+		},
+		wantErr: apis.ErrInvalidValue("cache is not supported for child pipelines", "cache"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "invalid workspace digests",
+		pt: PipelineTask{
+			Name:       "foo",
+			TaskRef:    &TaskRef{Name: "bar"},
+			Workspaces: []WorkspacePipelineTaskBinding{{Name: "source", Workspace: "ws"}},
+			Cache: &TaskCache{Workspaces: []CacheWorkspace{
+				{Digest: "abc"},
+				{Name: "source"},
+				{Name: "source", Digest: "abc"},
+				{Name: "output", Digest: "abc"},
+			}},
+		},
+		wantErr: apis.ErrMissingField("cache.workspaces[0].name").Also(
+			apis.ErrMissingField("cache.workspaces[1].digest")).Also(
+			apis.ErrInvalidValue(`workspace "source" appears more than once`, "cache.workspaces[2].name")).Also(
+			apis.ErrInvalidValue(`workspace "output" is not bound in the PipelineTask`, "cache.workspaces[3].name")),
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.wc != nil {
+				ctx = tc.wc(ctx)
+			}
+			err := tc.pt.validateCache(ctx)
+			if d := cmp.Diff(tc.wantErr.Error(), err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
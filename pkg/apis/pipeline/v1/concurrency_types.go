@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// Concurrency limits the number of PipelineRuns of the same group running at the same time.
+type Concurrency struct {
+	// Group is the key shared by the PipelineRuns that must not run concurrently, e.g.
+	// "deploy-$(params.environment)". It can reference the params of the PipelineRun.
+	// Groups are scoped to the namespace of the PipelineRun.
+	Group string `json:"group"`
+	// MaxInFlight is the number of PipelineRuns of the group allowed to run at the same time.
+	// Defaults to 1.
+	// +optional
+	MaxInFlight int32 `json:"maxInFlight,omitempty"`
+	// Strategy decides what happens to a PipelineRun when the group is full:
+	// "queue" (default) holds it until a PipelineRun of the group completes,
+	// "cancel-older" cancels the oldest running PipelineRuns of the group to make room,
+	// "cancel-newer" cancels it.
+	// +optional
+	Strategy ConcurrencyStrategy `json:"strategy,omitempty"`
+}
+
+// ConcurrencyStrategy is the behavior of a concurrency group when it is full
+type ConcurrencyStrategy string
+
+const (
+	// ConcurrencyStrategyQueue holds new PipelineRuns and starts them in creation order
+	ConcurrencyStrategyQueue ConcurrencyStrategy = "queue"
+	// ConcurrencyStrategyCancelOlder cancels the oldest running PipelineRuns in favor of new ones
+	ConcurrencyStrategyCancelOlder ConcurrencyStrategy = "cancel-older"
+	// ConcurrencyStrategyCancelNewer cancels new PipelineRuns while the group is full
+	ConcurrencyStrategyCancelNewer ConcurrencyStrategy = "cancel-newer"
+)
+
+// GetMaxInFlight returns the number of PipelineRuns of the group allowed to run at the same time
+func (c *Concurrency) GetMaxInFlight() int {
+	if c.MaxInFlight < 1 {
+		return 1
+	}
+	return int(c.MaxInFlight)
+}
+
+// GetStrategy returns the strategy of the group, defaulting to queue
+func (c *Concurrency) GetStrategy() ConcurrencyStrategy {
+	if c.Strategy == "" {
+		return ConcurrencyStrategyQueue
+	}
+	return c.Strategy
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/substitution"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
+)
+
+// Validate validates the Concurrency of a PipelineRun. The group can only reference
+// the string params provided by the PipelineRun.
+func (c *Concurrency) Validate(ctx context.Context, params Params) (errs *apis.FieldError) {
+	if c == nil {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "concurrency", config.AlphaAPIFields))
+	if c.Group == "" {
+		errs = errs.Also(apis.ErrMissingField("group"))
+	} else {
+		paramNames := sets.NewString()
+		for _, p := range params {
+			if p.Value.Type == ParamTypeString {
+				paramNames.Insert(p.Name)
+			}
+		}
+		errs = errs.Also(substitution.ValidateNoReferencesToUnknownVariablesWithDetail(c.Group, "params", paramNames).ViaField("group"))
+	}
+	if c.MaxInFlight < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 1", c.MaxInFlight), "maxInFlight"))
+	}
+	switch c.Strategy {
+	case "", ConcurrencyStrategyQueue, ConcurrencyStrategyCancelOlder, ConcurrencyStrategyCancelNewer:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s should be %s, %s or %s", c.Strategy, ConcurrencyStrategyQueue, ConcurrencyStrategyCancelOlder, ConcurrencyStrategyCancelNewer), "strategy"))
+	}
+	return errs
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test/diff"
+	"knative.dev/pkg/apis"
+)
+
+func TestConcurrency_Validate(t *testing.T) {
+	params := v1.Params{
+		{Name: "environment", Value: *v1.NewStructuredValues("prod")},
+		{Name: "regions", Value: *v1.NewStructuredValues("us", "eu")},
+	}
+	for _, tc := range []struct {
+		name        string
+		concurrency *v1.Concurrency
+		wantErr     *apis.FieldError
+		wc          func(context.Context) context.Context
+	}{{
+		name: "no concurrency",
+	}, {
+		name: "group with params",
+		concurrency: &v1.Concurrency{
+			Group:       "deploy-$(params.environment)",
+			MaxInFlight: 2,
+			Strategy:    v1.ConcurrencyStrategyCancelOlder,
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:        "concurrency requires alpha api fields",
+		concurrency: &v1.Concurrency{Group: "deploy"},
+		wantErr:     apis.ErrGeneric(`concurrency requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name:        "missing group",
+		concurrency: &v1.Concurrency{},
+		wantErr:     apis.ErrMissingField("group"),
+		wc:          cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:        "group referencing an unknown param",
+		concurrency: &v1.Concurrency{Group: "deploy-$(params.region)"},
+		wantErr:     &apis.FieldError{Message: "non-existent variable `region` in \"deploy-$(params.region)\"", Paths: []string{"group"}},
+		wc:          cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:        "group referencing an array param",
+		concurrency: &v1.Concurrency{Group: "deploy-$(params.regions)"},
+		wantErr:     &apis.FieldError{Message: "non-existent variable `regions` in \"deploy-$(params.regions)\"", Paths: []string{"group"}},
+		wc:          cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:        "negative maxInFlight",
+		concurrency: &v1.Concurrency{Group: "deploy", MaxInFlight: -1},
+		wantErr:     apis.ErrInvalidValue("-1 should be >= 1", "maxInFlight"),
+		wc:          cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:        "unknown strategy",
+		concurrency: &v1.Concurrency{Group: "deploy", Strategy: "cancel-all"},
+		wantErr:     apis.ErrInvalidValue("cancel-all should be queue, cancel-older or cancel-newer", "strategy"),
+		wc:          cfgtesting.EnableAlphaAPIFields,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.wc != nil {
+				ctx = tc.wc(ctx)
+			}
+			err := tc.concurrency.Validate(ctx, params)
+			if d := cmp.Diff(tc.wantErr.Error(), err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
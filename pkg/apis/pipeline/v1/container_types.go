@@ -157,6 +157,23 @@ type Step struct {
 	// When is a list of when expressions that need to be true for the task to run
 	// +optional
 	When StepWhenExpressions `json:"when,omitempty"`
+	// ParallelGroup is the name of the group of parallel Steps this Step belongs to.
+	// Consecutive Steps with the same ParallelGroup run at the same time, and the
+	// Steps following them start once all of them are done.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	ParallelGroup string `json:"parallelGroup,omitempty"`
+	// Retries is the number of times the Step is run again in its container when it fails,
+	// before the Step is considered failed. The Step timeout covers all the attempts.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	Retries int `json:"retries,omitempty"`
+	// RetryDelay is the delay to wait after a failed attempt before running the Step again.
+	// Refer Go's ParseDuration documentation for expected format: https://golang.org/pkg/time/#ParseDuration
+	// +optional
+	RetryDelay *metav1.Duration `json:"retryDelay,omitempty"`
 }
 
 // Ref can be used to refer to a specific instance of a StepAction.
@@ -236,6 +236,23 @@ func (s *Step) Validate(ctx context.Context) (errs *apis.FieldError) {
 		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "step stderr stream support", config.AlphaAPIFields).ViaField("stderrconfig"))
 	}
 
+	// Retries is an alpha feature and will fail validation if it's used in a task spec
+	// when the enable-api-fields feature gate is not "alpha".
+	if s.Retries != 0 || s.RetryDelay != nil {
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "step retries", config.AlphaAPIFields).ViaField("retries"))
+	}
+	if s.Retries < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 0", s.Retries), "retries"))
+	}
+	if s.RetryDelay != nil {
+		if s.RetryDelay.Duration < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(s.RetryDelay.Duration, "retryDelay", "negative retry delay"))
+		}
+		if s.Retries == 0 {
+			errs = errs.Also(apis.ErrGeneric("retryDelay can only be set when retries is set", "retryDelay"))
+		}
+	}
+
 	// Validate usage of step result reference.
 	// Referencing previous step's results are only allowed in `env`, `command` and `args`.
 	errs = errs.Also(validateStepResultReference(s))
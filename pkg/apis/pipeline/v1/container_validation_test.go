@@ -218,6 +218,13 @@ func TestStepValidate(t *testing.T) {
 				MountPath: "/tekton/home/subdir",
 			}},
 		},
+	}, {
+		name: "valid step with retries",
+		Step: v1.Step{
+			Image:      "myimage",
+			Retries:    2,
+			RetryDelay: &metav1.Duration{Duration: 10 * time.Second},
+		},
 	}}
 	for _, st := range tests {
 		t.Run(st.name, func(t *testing.T) {
@@ -337,6 +344,38 @@ func TestStepValidateError(t *testing.T) {
 			Message: "invalid value: -10s",
 			Paths:   []string{"negative timeout"},
 		},
+	}, {
+		name: "negative retries",
+		Step: v1.Step{
+			Image:   "myimage",
+			Retries: -1,
+		},
+		expectedError: apis.FieldError{
+			Message: "invalid value: -1 should be >= 0",
+			Paths:   []string{"retries"},
+		},
+	}, {
+		name: "negative retry delay",
+		Step: v1.Step{
+			Image:      "myimage",
+			Retries:    1,
+			RetryDelay: &metav1.Duration{Duration: -10 * time.Second},
+		},
+		expectedError: apis.FieldError{
+			Message: "invalid value: -10s",
+			Paths:   []string{"retryDelay"},
+			Details: "negative retry delay",
+		},
+	}, {
+		name: "retry delay without retries",
+		Step: v1.Step{
+			Image:      "myimage",
+			RetryDelay: &metav1.Duration{Duration: 10 * time.Second},
+		},
+		expectedError: apis.FieldError{
+			Message: "retryDelay can only be set when retries is set",
+			Paths:   []string{"retryDelay"},
+		},
 	}}
 	for _, st := range tests {
 		t.Run(st.name, func(t *testing.T) {
@@ -806,6 +845,13 @@ func TestStepIncompatibleAPIVersions(t *testing.T) {
 					Path: "/tmp/stderr.txt",
 				},
 			},
+		}, {
+			name:            "step retries requires alpha",
+			requiredVersion: "alpha",
+			step: v1.Step{
+				Image:   "foo",
+				Retries: 1,
+			},
 		},
 	} {
 		for _, version := range versions {
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// JSONSchemaType is the type of a value described by a JSONSchema.
+type JSONSchemaType string
+
+// Valid JSONSchemaTypes:
+const (
+	JSONSchemaTypeString  JSONSchemaType = "string"
+	JSONSchemaTypeNumber  JSONSchemaType = "number"
+	JSONSchemaTypeInteger JSONSchemaType = "integer"
+	JSONSchemaTypeBoolean JSONSchemaType = "boolean"
+	JSONSchemaTypeObject  JSONSchemaType = "object"
+	JSONSchemaTypeArray   JSONSchemaType = "array"
+)
+
+// AllJSONSchemaTypes can be used for JSONSchemaType validation.
+var AllJSONSchemaTypes = []JSONSchemaType{JSONSchemaTypeString, JSONSchemaTypeNumber, JSONSchemaTypeInteger, JSONSchemaTypeBoolean, JSONSchemaTypeObject, JSONSchemaTypeArray}
+
+// JSONSchema is the subset of JSON Schema used to validate the value of a param or a result.
+// The strings of a value are checked as they are against a string schema, and are parsed as
+// JSON against the other schemas, so that a string param can hold a number, a boolean or a
+// nested object.
+type JSONSchema struct {
+	// Type is the type of the value: "string", "number", "integer", "boolean", "object" or "array".
+	// Any type is allowed if it is not set.
+	// +optional
+	Type JSONSchemaType `json:"type,omitempty"`
+	// Properties are the schemas of the keys of an object.
+	// +optional
+	Properties map[string]JSONSchema `json:"properties,omitempty"`
+	// Required are the keys an object must have.
+	// +optional
+	// +listType=atomic
+	Required []string `json:"required,omitempty"`
+	// AdditionalProperties allows an object to have keys that are not in its Properties. It defaults to true.
+	// +optional
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+	// Items is the schema of the items of an array.
+	// +optional
+	Items *JSONSchema `json:"items,omitempty"`
+	// Pattern is a regular expression a string must match.
+	// +optional
+	Pattern string `json:"pattern,omitempty"`
+	// MinLength is the minimum length of a string.
+	// +optional
+	MinLength *int64 `json:"minLength,omitempty"`
+	// MaxLength is the maximum length of a string.
+	// +optional
+	MaxLength *int64 `json:"maxLength,omitempty"`
+	// Minimum is the minimum value of a number.
+	// +optional
+	Minimum *float64 `json:"minimum,omitempty"`
+	// Maximum is the maximum value of a number.
+	// +optional
+	Maximum *float64 `json:"maximum,omitempty"`
+	// MinItems is the minimum number of items of an array.
+	// +optional
+	MinItems *int64 `json:"minItems,omitempty"`
+	// MaxItems is the maximum number of items of an array.
+	// +optional
+	MaxItems *int64 `json:"maxItems,omitempty"`
+}
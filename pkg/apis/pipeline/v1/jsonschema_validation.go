@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"unicode/utf8"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate validates that the JSONSchema is well formed.
+func (s *JSONSchema) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if s.Type != "" && !slices.Contains(AllJSONSchemaTypes, s.Type) {
+		errs = errs.Also(apis.ErrInvalidValue(s.Type, "type", fmt.Sprintf("type must be one of %v", AllJSONSchemaTypes)))
+	}
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(s.Pattern, "pattern", err.Error()))
+		}
+	}
+	errs = errs.Also(validateSchemaBounds(s.MinLength, s.MaxLength, "minLength", "maxLength"))
+	errs = errs.Also(validateSchemaBounds(s.MinItems, s.MaxItems, "minItems", "maxItems"))
+	if s.Minimum != nil && s.Maximum != nil && *s.Minimum > *s.Maximum {
+		errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("minimum %v is greater than maximum %v", *s.Minimum, *s.Maximum), "minimum", "maximum"))
+	}
+	for _, key := range sortedPropertyNames(s.Properties) {
+		property := s.Properties[key]
+		errs = errs.Also(property.Validate(ctx).ViaFieldKey("properties", key))
+	}
+	if s.Items != nil {
+		errs = errs.Also(s.Items.Validate(ctx).ViaField("items"))
+	}
+	return errs
+}
+
+// validateSchemaBounds validates that a pair of length bounds is positive and ordered.
+func validateSchemaBounds(minimum, maximum *int64, minField, maxField string) (errs *apis.FieldError) {
+	if minimum != nil && *minimum < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 0", *minimum), minField))
+	}
+	if maximum != nil && *maximum < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 0", *maximum), maxField))
+	}
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("%s %d is greater than %s %d", minField, *minimum, maxField, *maximum), minField, maxField))
+	}
+	return errs
+}
+
+// validateForType validates that the JSONSchema can describe a param or a result of the given type:
+// the schema of an array or of an object must describe an array or an object, while the string of
+// a string param or result may hold any JSON value.
+func (s *JSONSchema) validateForType(t ParamType) *apis.FieldError {
+	switch t {
+	case ParamTypeArray, ParamTypeObject:
+		if s.Type != "" && string(s.Type) != string(t) {
+			return apis.ErrInvalidValue(s.Type, "type", fmt.Sprintf("the schema of an %s must be of type %s", t, t))
+		}
+	}
+	return nil
+}
+
+// ValidateValue validates a param or result value against the JSONSchema.
+func (s *JSONSchema) ValidateValue(value ParamValue) error {
+	var v interface{}
+	switch value.Type {
+	case ParamTypeArray:
+		items := make([]interface{}, 0, len(value.ArrayVal))
+		for i, item := range value.ArrayVal {
+			itemValue, err := s.items().parse(item, fmt.Sprintf("[%d]", i))
+			if err != nil {
+				return err
+			}
+			items = append(items, itemValue)
+		}
+		v = items
+	case ParamTypeObject:
+		object := make(map[string]interface{}, len(value.ObjectVal))
+		for key, val := range value.ObjectVal {
+			keyValue, err := s.property(key).parse(val, key)
+			if err != nil {
+				return err
+			}
+			object[key] = keyValue
+		}
+		v = object
+	default:
+		var err error
+		if v, err = s.parse(value.StringVal, ""); err != nil {
+			return err
+		}
+	}
+	return s.validate(v, "")
+}
+
+// items returns the schema of the items of an array, if any.
+func (s *JSONSchema) items() *JSONSchema {
+	if s == nil {
+		return nil
+	}
+	return s.Items
+}
+
+// property returns the schema of the given key of an object, if any.
+func (s *JSONSchema) property(key string) *JSONSchema {
+	if s == nil {
+		return nil
+	}
+	if property, ok := s.Properties[key]; ok {
+		return &property
+	}
+	return nil
+}
+
+// parse returns the JSON value held by a string of a param or a result: the string itself for
+// a string schema or without schema, or the JSON it is parsed into otherwise.
+func (s *JSONSchema) parse(str string, path string) (interface{}, error) {
+	if s == nil || s.Type == "" || s.Type == JSONSchemaTypeString {
+		return str, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(str), &v); err != nil {
+		return nil, schemaError(path, "%q is not a valid %s", str, s.Type)
+	}
+	return v, nil
+}
+
+// validate validates a JSON value against the JSONSchema.
+func (s *JSONSchema) validate(v interface{}, path string) error {
+	if s == nil {
+		return nil
+	}
+	if s.Type != "" && !hasSchemaType(v, s.Type) {
+		return schemaError(path, "must be of type %s", s.Type)
+	}
+
+	switch val := v.(type) {
+	case string:
+		length := int64(utf8.RuneCountInString(val))
+		if s.MinLength != nil && length < *s.MinLength {
+			return schemaError(path, "must be at least %d characters long", *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return schemaError(path, "must be at most %d characters long", *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			re, err := regexp.Compile(s.Pattern)
+			if err != nil {
+				return schemaError(path, "invalid pattern %q: %v", s.Pattern, err)
+			}
+			if !re.MatchString(val) {
+				return schemaError(path, "%q does not match the pattern %q", val, s.Pattern)
+			}
+		}
+	case float64:
+		if s.Minimum != nil && val < *s.Minimum {
+			return schemaError(path, "must be greater than or equal to %v", *s.Minimum)
+		}
+		if s.Maximum != nil && val > *s.Maximum {
+			return schemaError(path, "must be less than or equal to %v", *s.Maximum)
+		}
+	case map[string]interface{}:
+		for _, key := range s.Required {
+			if _, ok := val[key]; !ok {
+				return schemaError(path, "missing required key %q", key)
+			}
+		}
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			property, ok := s.Properties[key]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return schemaError(path, "key %q is not allowed", key)
+				}
+				continue
+			}
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			if err := property.validate(val[key], keyPath); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if s.MinItems != nil && int64(len(val)) < *s.MinItems {
+			return schemaError(path, "must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && int64(len(val)) > *s.MaxItems {
+			return schemaError(path, "must have at most %d items", *s.MaxItems)
+		}
+		for i, item := range val {
+			if err := s.Items.validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasSchemaType returns true if the JSON value is of the given type.
+func hasSchemaType(v interface{}, t JSONSchemaType) bool {
+	switch val := v.(type) {
+	case string:
+		return t == JSONSchemaTypeString
+	case float64:
+		return t == JSONSchemaTypeNumber || (t == JSONSchemaTypeInteger && val == math.Trunc(val))
+	case bool:
+		return t == JSONSchemaTypeBoolean
+	case map[string]interface{}:
+		return t == JSONSchemaTypeObject
+	case []interface{}:
+		return t == JSONSchemaTypeArray
+	}
+	return false
+}
+
+// schemaError returns an error about the value at the given path, if any.
+func schemaError(path string, format string, args ...interface{}) error {
+	if path == "" {
+		return fmt.Errorf(format, args...)
+	}
+	return fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...))
+}
+
+// sortedPropertyNames returns the keys of the properties of a JSONSchema in order.
+func sortedPropertyNames(properties map[string]JSONSchema) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
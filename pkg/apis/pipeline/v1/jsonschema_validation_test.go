@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test/diff"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+)
+
+func TestJSONSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema v1.JSONSchema
+	}{{
+		name:   "empty schema",
+		schema: v1.JSONSchema{},
+	}, {
+		name: "string schema",
+		schema: v1.JSONSchema{
+			Type:      v1.JSONSchemaTypeString,
+			Pattern:   "^[a-z]+$",
+			MinLength: ptr.To(int64(1)),
+			MaxLength: ptr.To(int64(10)),
+		},
+	}, {
+		name: "nested object schema",
+		schema: v1.JSONSchema{
+			Type:     v1.JSONSchemaTypeObject,
+			Required: []string{"url"},
+			Properties: map[string]v1.JSONSchema{
+				"url":  {Type: v1.JSONSchemaTypeString},
+				"tags": {Type: v1.JSONSchemaTypeArray, Items: &v1.JSONSchema{Type: v1.JSONSchemaTypeString}},
+			},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.schema.Validate(t.Context()); err != nil {
+				t.Errorf("JSONSchema.Validate() = %v", err)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaValidateError(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        v1.JSONSchema
+		expectedError apis.FieldError
+	}{{
+		name:   "invalid type",
+		schema: v1.JSONSchema{Type: "map"},
+		expectedError: apis.FieldError{
+			Message: `invalid value: map`,
+			Paths:   []string{"type"},
+			Details: "type must be one of [string number integer boolean object array]",
+		},
+	}, {
+		name:   "invalid pattern",
+		schema: v1.JSONSchema{Pattern: "("},
+		expectedError: apis.FieldError{
+			Message: `invalid value: (`,
+			Paths:   []string{"pattern"},
+			Details: "error parsing regexp: missing closing ): `(`",
+		},
+	}, {
+		name:   "minLength greater than maxLength",
+		schema: v1.JSONSchema{MinLength: ptr.To(int64(3)), MaxLength: ptr.To(int64(2))},
+		expectedError: apis.FieldError{
+			Message: "minLength 3 is greater than maxLength 2",
+			Paths:   []string{"maxLength", "minLength"},
+		},
+	}, {
+		name: "invalid nested schema",
+		schema: v1.JSONSchema{
+			Type: v1.JSONSchemaTypeObject,
+			Properties: map[string]v1.JSONSchema{
+				"tags": {Type: v1.JSONSchemaTypeArray, Items: &v1.JSONSchema{MinItems: ptr.To(int64(-1))}},
+			},
+		},
+		expectedError: apis.FieldError{
+			Message: `invalid value: -1 should be >= 0`,
+			Paths:   []string{"properties[tags].items.minItems"},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Validate(t.Context())
+			if err == nil {
+				t.Fatalf("Expected an error, got nothing for %v", tt.schema)
+			}
+			if d := cmp.Diff(tt.expectedError.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
+				t.Errorf("JSONSchema.Validate() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestJSONSchemaValidateValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema v1.JSONSchema
+		value  v1.ParamValue
+	}{{
+		name:   "string matching pattern",
+		schema: v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^v[0-9]+$", MaxLength: ptr.To(int64(3))},
+		value:  *v1.NewStructuredValues("v12"),
+	}, {
+		name:   "string holding an integer",
+		schema: v1.JSONSchema{Type: v1.JSONSchemaTypeInteger, Minimum: ptr.To(float64(1)), Maximum: ptr.To(float64(5))},
+		value:  *v1.NewStructuredValues("3"),
+	}, {
+		name: "string holding a JSON object",
+		schema: v1.JSONSchema{
+			Type:       v1.JSONSchemaTypeObject,
+			Required:   []string{"digest"},
+			Properties: map[string]v1.JSONSchema{"digest": {Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"}},
+		},
+		value: *v1.NewStructuredValues(`{"digest": "sha256:abc", "extra": true}`),
+	}, {
+		name:   "array of integers",
+		schema: v1.JSONSchema{Type: v1.JSONSchemaTypeArray, MinItems: ptr.To(int64(2)), Items: &v1.JSONSchema{Type: v1.JSONSchemaTypeInteger}},
+		value:  *v1.NewStructuredValues("1", "2"),
+	}, {
+		name: "object with typed keys",
+		schema: v1.JSONSchema{
+			Type:                 v1.JSONSchemaTypeObject,
+			AdditionalProperties: ptr.To(false),
+			Properties: map[string]v1.JSONSchema{
+				"url":     {Type: v1.JSONSchemaTypeString},
+				"enabled": {Type: v1.JSONSchemaTypeBoolean},
+			},
+		},
+		value: *v1.NewObject(map[string]string{"url": "https://tekton.dev", "enabled": "true"}),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.schema.ValidateValue(tt.value); err != nil {
+				t.Errorf("JSONSchema.ValidateValue() = %v", err)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaValidateValueError(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        v1.JSONSchema
+		value         v1.ParamValue
+		expectedError string
+	}{{
+		name:          "string not matching pattern",
+		schema:        v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^v[0-9]+$"},
+		value:         *v1.NewStructuredValues("latest"),
+		expectedError: `"latest" does not match the pattern "^v[0-9]+$"`,
+	}, {
+		name:          "string too long",
+		schema:        v1.JSONSchema{MaxLength: ptr.To(int64(3))},
+		value:         *v1.NewStructuredValues("abcd"),
+		expectedError: "must be at most 3 characters long",
+	}, {
+		name:          "string not holding a number",
+		schema:        v1.JSONSchema{Type: v1.JSONSchemaTypeNumber},
+		value:         *v1.NewStructuredValues("three"),
+		expectedError: `"three" is not a valid number`,
+	}, {
+		name:          "number instead of integer",
+		schema:        v1.JSONSchema{Type: v1.JSONSchemaTypeInteger},
+		value:         *v1.NewStructuredValues("1.5"),
+		expectedError: "must be of type integer",
+	}, {
+		name:          "integer above maximum",
+		schema:        v1.JSONSchema{Type: v1.JSONSchemaTypeInteger, Maximum: ptr.To(float64(5))},
+		value:         *v1.NewStructuredValues("6"),
+		expectedError: "must be less than or equal to 5",
+	}, {
+		name: "JSON object missing a required key",
+		schema: v1.JSONSchema{
+			Type:     v1.JSONSchemaTypeObject,
+			Required: []string{"digest"},
+		},
+		value:         *v1.NewStructuredValues(`{"url": "https://tekton.dev"}`),
+		expectedError: `missing required key "digest"`,
+	}, {
+		name: "JSON object with an invalid nested key",
+		schema: v1.JSONSchema{
+			Type: v1.JSONSchemaTypeObject,
+			Properties: map[string]v1.JSONSchema{
+				"image": {
+					Type:       v1.JSONSchemaTypeObject,
+					Properties: map[string]v1.JSONSchema{"digest": {Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"}},
+				},
+			},
+		},
+		value:         *v1.NewStructuredValues(`{"image": {"digest": "md5:abc"}}`),
+		expectedError: `image.digest: "md5:abc" does not match the pattern "^sha256:"`,
+	}, {
+		name:          "array with too few items",
+		schema:        v1.JSONSchema{Type: v1.JSONSchemaTypeArray, MinItems: ptr.To(int64(2))},
+		value:         v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"1"}},
+		expectedError: "must have at least 2 items",
+	}, {
+		name:          "array item not holding an integer",
+		schema:        v1.JSONSchema{Type: v1.JSONSchemaTypeArray, Items: &v1.JSONSchema{Type: v1.JSONSchemaTypeInteger}},
+		value:         *v1.NewStructuredValues("1", "two"),
+		expectedError: `[1]: "two" is not a valid integer`,
+	}, {
+		name: "object with a key not allowed",
+		schema: v1.JSONSchema{
+			Type:                 v1.JSONSchemaTypeObject,
+			AdditionalProperties: ptr.To(false),
+			Properties:           map[string]v1.JSONSchema{"url": {Type: v1.JSONSchemaTypeString}},
+		},
+		value:         *v1.NewObject(map[string]string{"url": "https://tekton.dev", "commit": "abc"}),
+		expectedError: `key "commit" is not allowed`,
+	}, {
+		name: "object key not holding a boolean",
+		schema: v1.JSONSchema{
+			Type:       v1.JSONSchemaTypeObject,
+			Properties: map[string]v1.JSONSchema{"enabled": {Type: v1.JSONSchemaTypeBoolean}},
+		},
+		value:         *v1.NewObject(map[string]string{"enabled": "yes"}),
+		expectedError: `enabled: "yes" is not a valid boolean`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.ValidateValue(tt.value)
+			if err == nil {
+				t.Fatalf("Expected an error, got nothing for %v", tt.value)
+			}
+			if d := cmp.Diff(tt.expectedError, err.Error()); d != "" {
+				t.Errorf("JSONSchema.ValidateValue() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/test/diff"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestMatrix_FanOut(t *testing.T) {
@@ -584,6 +585,83 @@ func TestMatrix_FanOut(t *testing.T) {
 					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "I-do-not-exist"},
 				},
 			}},
+		}, {
+			name: "matrix exclude removes combinations",
+			matrix: v1.Matrix{
+				Params: v1.Params{{
+					Name:  "platform",
+					Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"linux", "mac"}},
+				}, {
+					Name:  "browser",
+					Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"chrome", "safari"}},
+				}},
+				Exclude: v1.ExcludeParamsList{{
+					Params: v1.Params{{
+						Name: "platform", Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "linux"},
+					}, {
+						Name: "browser", Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "safari"},
+					}},
+				}, {
+					Params: v1.Params{{
+						Name: "browser", Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "firefox"},
+					}},
+				}},
+			},
+			want: []v1.Params{{
+				{
+					Name:  "browser",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "chrome"},
+				}, {
+					Name:  "platform",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "linux"},
+				},
+			}, {
+				{
+					Name:  "browser",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "chrome"},
+				}, {
+					Name:  "platform",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "mac"},
+				},
+			}, {
+				{
+					Name:  "browser",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "safari"},
+				}, {
+					Name:  "platform",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "mac"},
+				},
+			}},
+		}, {
+			name: "matrix include is applied after exclude",
+			matrix: v1.Matrix{
+				Params: v1.Params{{
+					Name:  "platform",
+					Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"linux", "mac"}},
+				}},
+				Exclude: v1.ExcludeParamsList{{
+					Params: v1.Params{{
+						Name: "platform", Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "mac"},
+					}},
+				}},
+				Include: v1.IncludeParamsList{{
+					Name: "linux-flags",
+					Params: v1.Params{{
+						Name: "platform", Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "linux"},
+					}, {
+						Name: "flags", Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "-race"},
+					}},
+				}},
+			},
+			want: []v1.Params{{
+				{
+					Name:  "flags",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "-race"},
+				}, {
+					Name:  "platform",
+					Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "linux"},
+				},
+			}},
 		}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -941,6 +1019,21 @@ func TestPipelineTask_CountCombinations(t *testing.T) {
 			return matrix
 		}(),
 		want: math.MaxInt,
+	}, {
+		name: "combinations count excludes the matrix exclude combinations",
+		matrix: &v1.Matrix{
+			Params: v1.Params{{
+				Name: "platform", Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"linux", "mac", "windows"}},
+			}, {
+				Name: "browser", Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"chrome", "safari"}},
+			}},
+			Exclude: v1.ExcludeParamsList{{
+				Params: v1.Params{{
+					Name: "browser", Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "safari"},
+				}},
+			}},
+		},
+		want: 3,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -951,6 +1044,42 @@ func TestPipelineTask_CountCombinations(t *testing.T) {
 	}
 }
 
+func TestMatrix_GetMinSuccessful(t *testing.T) {
+	fromInt, fromPercent := intstr.FromInt32(2), intstr.FromString("50%")
+	tooMany := intstr.FromInt32(10)
+	for _, tc := range []struct {
+		name   string
+		matrix *v1.Matrix
+		want   int
+	}{{
+		name:   "no failure policy",
+		matrix: &v1.Matrix{},
+		want:   5,
+	}, {
+		name:   "no minSuccessful",
+		matrix: &v1.Matrix{FailurePolicy: &v1.MatrixFailurePolicy{FailFast: true}},
+		want:   5,
+	}, {
+		name:   "minSuccessful count",
+		matrix: &v1.Matrix{FailurePolicy: &v1.MatrixFailurePolicy{MinSuccessful: &fromInt}},
+		want:   2,
+	}, {
+		name:   "minSuccessful percentage is rounded up",
+		matrix: &v1.Matrix{FailurePolicy: &v1.MatrixFailurePolicy{MinSuccessful: &fromPercent}},
+		want:   3,
+	}, {
+		name:   "minSuccessful is at most the count of combinations",
+		matrix: &v1.Matrix{FailurePolicy: &v1.MatrixFailurePolicy{MinSuccessful: &tooMany}},
+		want:   5,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.matrix.GetMinSuccessful(5); got != tc.want {
+				t.Errorf("Matrix.GetMinSuccessful() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
 // matrixWithArrayParams returns a Matrix with numParams array params, each
 // holding arrayLen distinct values. It is used to build matrices whose true
 // combination count (arrayLen^numParams) overflows a 64-bit int.
@@ -35,6 +35,10 @@ const ParamsPrefix = "params"
 const matrixField = "matrix"
 const lengthField = "length"
 
+// SensitiveParamRedactedValue is the value which replaces the value of a sensitive parameter once it
+// has been moved to a Secret.
+const SensitiveParamRedactedValue = "[REDACTED]"
+
 // ParamSpec defines arbitrary parameters needed beyond typed inputs (such as
 // resources). Parameter values are provided by users as inputs on a TaskRun
 // or PipelineRun.
@@ -63,6 +67,16 @@ type ParamSpec struct {
 	// If Enum is not set, no input validation is performed for the param.
 	// +optional
 	Enum []string `json:"enum,omitempty"`
+	// Schema is the JSON Schema the value of the parameter must match.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Schema *JSONSchema `json:"schema,omitempty"`
+	// Sensitive marks the parameter as holding a secret value, such as a token. The value supplied
+	// by a TaskRun or a PipelineRun is moved to a Secret and injected in the Steps through an
+	// environment variable, so that it never appears in the run, its status or the Pod spec.
+	// +optional
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // ParamSpecs is a list of ParamSpec
@@ -176,6 +190,50 @@ func (ps ParamSpecs) validateParamEnums(ctx context.Context) *apis.FieldError {
 	return errs
 }
 
+// ValidateParamSchemas validates feature flag, JSON Schema and default value for Param Schema
+func (ps ParamSpecs) ValidateParamSchemas(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	for _, p := range ps {
+		if p.Schema == nil {
+			continue
+		}
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "param schema", config.AlphaAPIFields).ViaField("schema").ViaKey(p.Name))
+		errs = errs.Also(p.Schema.Validate(ctx).ViaField("schema").ViaKey(p.Name))
+		errs = errs.Also(p.Schema.validateForType(p.Type).ViaField("schema").ViaKey(p.Name))
+		// a default value referencing variables is only known once they are substituted
+		if p.Default != nil && !p.Default.containsVariables() {
+			if err := p.Schema.ValidateValue(*p.Default); err != nil {
+				errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("param default value does not match the schema: %v", err), "").ViaKey(p.Name))
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateSensitiveParams validates feature flag, type and allowed fields for sensitive params
+func (ps ParamSpecs) ValidateSensitiveParams(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	for _, p := range ps {
+		if !p.Sensitive {
+			continue
+		}
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "sensitive params", config.AlphaAPIFields).ViaField("sensitive").ViaKey(p.Name))
+		if p.Type != ParamTypeString {
+			errs = errs.Also(apis.ErrGeneric("sensitive can only be set with string type param", "").ViaKey(p.Name))
+		}
+		if p.Default != nil {
+			errs = errs.Also(apis.ErrDisallowedFields("default").ViaKey(p.Name))
+		}
+		if len(p.Enum) > 0 {
+			errs = errs.Also(apis.ErrDisallowedFields("enum").ViaKey(p.Name))
+		}
+		if p.Schema != nil {
+			errs = errs.Also(apis.ErrDisallowedFields("schema").ViaKey(p.Name))
+		}
+	}
+	return errs
+}
+
 // findDups returns the duplicate element in the given slice
 func findDups(vals []string) sets.String {
 	seen := sets.String{}
@@ -195,6 +253,31 @@ type Param struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value ParamValue `json:"value"`
+	// ValueFrom references the Secret holding the value of a sensitive parameter. When it is set,
+	// Value holds a redacted placeholder.
+	// +optional
+	ValueFrom *ParamValueSource `json:"valueFrom,omitempty"`
+}
+
+// ParamValueSource references the source of the value of a parameter.
+type ParamValueSource struct {
+	// SecretKeyRef selects a key of a Secret in the namespace of the run.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
+// Validate validates that the ParamValueSource references a key of a Secret
+func (s *ParamValueSource) Validate(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "param valueFrom", config.AlphaAPIFields))
+	if s.SecretKeyRef == nil {
+		return errs.Also(apis.ErrMissingField("secretKeyRef"))
+	}
+	if s.SecretKeyRef.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("secretKeyRef.name"))
+	}
+	if s.SecretKeyRef.Key == "" {
+		errs = errs.Also(apis.ErrMissingField("secretKeyRef.key"))
+	}
+	return errs
 }
 
 // GetVarSubstitutionExpressions extracts all the value between "$(" and ")"" for a Parameter
@@ -322,6 +405,45 @@ func (ps Params) ReplaceVariables(stringReplacements map[string]string, arrayRep
 	return params
 }
 
+// ReplaceMovedSensitiveParams returns a copy of the params in which the params set inline are replaced
+// by the params of the same name in moved whose value has been moved to a Secret, and whether any
+// param was replaced.
+func (ps Params) ReplaceMovedSensitiveParams(moved Params) (Params, bool) {
+	movedByName := make(map[string]Param)
+	for _, p := range moved {
+		if p.ValueFrom != nil {
+			movedByName[p.Name] = p
+		}
+	}
+	params := ps.DeepCopy()
+	replaced := false
+	for i := range params {
+		if m, ok := movedByName[params[i].Name]; ok && params[i].ValueFrom == nil {
+			params[i] = *m.DeepCopy()
+			replaced = true
+		}
+	}
+	return params, replaced
+}
+
+// RedactSensitiveParams returns a copy of the params in which the values of the params declared
+// sensitive in paramSpecs are replaced by SensitiveParamRedactedValue.
+func (ps Params) RedactSensitiveParams(paramSpecs ParamSpecs) Params {
+	sensitive := sets.NewString()
+	for _, p := range paramSpecs {
+		if p.Sensitive {
+			sensitive.Insert(p.Name)
+		}
+	}
+	params := ps.DeepCopy()
+	for i := range params {
+		if sensitive.Has(params[i].Name) {
+			params[i].Value = *NewStructuredValues(SensitiveParamRedactedValue)
+		}
+	}
+	return params
+}
+
 // ExtractDefaultParamArrayLengths extract and return the lengths of all array params
 // Example of returned value: {"a-array-params": 2,"b-array-params": 2 }
 func (ps ParamSpecs) ExtractDefaultParamArrayLengths() map[string]int {
@@ -617,6 +739,24 @@ func (paramValues *ParamValue) applyOrCorrect(stringReplacements map[string]stri
 	}
 }
 
+// containsVariables returns true if the ParamValue references variables that have not been substituted yet.
+func (paramValues ParamValue) containsVariables() bool {
+	if strings.Contains(paramValues.StringVal, "$(") {
+		return true
+	}
+	for _, v := range paramValues.ArrayVal {
+		if strings.Contains(v, "$(") {
+			return true
+		}
+	}
+	for _, v := range paramValues.ObjectVal {
+		if strings.Contains(v, "$(") {
+			return true
+		}
+	}
+	return false
+}
+
 // NewStructuredValues creates an ParamValues of type ParamTypeString or ParamTypeArray, based on
 // how many inputs are given (>1 input will create an array, not string).
 func NewStructuredValues(value string, values ...string) *ParamValue {
@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 )
@@ -828,3 +829,52 @@ func TestValidateNoDuplicateNames(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ReplaceMovedSensitiveParams(t *testing.T) {
+	valueFrom := &v1.ParamValueSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "run-sensitive-params"},
+		Key:                  "token",
+	}}
+	params := v1.Params{
+		{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")},
+		{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+	}
+	moved := v1.Params{
+		{Name: "token", Value: *v1.NewStructuredValues(v1.SensitiveParamRedactedValue), ValueFrom: valueFrom},
+		{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+	}
+
+	got, replaced := params.ReplaceMovedSensitiveParams(moved)
+	if !replaced {
+		t.Error("ReplaceMovedSensitiveParams() did not replace any param")
+	}
+	if d := cmp.Diff(moved, got); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+
+	if _, replaced := moved.ReplaceMovedSensitiveParams(moved); replaced {
+		t.Error("ReplaceMovedSensitiveParams() replaced a param which was already moved")
+	}
+}
+
+func TestParams_RedactSensitiveParams(t *testing.T) {
+	params := v1.Params{
+		{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")},
+		{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+	}
+	paramSpecs := v1.ParamSpecs{
+		{Name: "token", Type: v1.ParamTypeString, Sensitive: true},
+		{Name: "url", Type: v1.ParamTypeString},
+	}
+	want := v1.Params{
+		{Name: "token", Value: *v1.NewStructuredValues(v1.SensitiveParamRedactedValue)},
+		{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+	}
+
+	if d := cmp.Diff(want, params.RedactSensitiveParams(paramSpecs)); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+	if params[0].Value.StringVal != "s3cr3t" {
+		t.Errorf("RedactSensitiveParams() modified the params: %v", params)
+	}
+}
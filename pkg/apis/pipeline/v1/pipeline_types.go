@@ -131,6 +131,13 @@ type PipelineSpec struct {
 	// or after a failure which would result in ending the Pipeline
 	// +listType=atomic
 	Finally []PipelineTask `json:"finally,omitempty"`
+	// Imports declares Pipelines whose Tasks and Finally tasks are inlined into this Pipeline,
+	// with their names prefixed by the name of the import.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	// +listType=atomic
+	Imports []PipelineImport `json:"imports,omitempty"`
 }
 
 // PipelineResult used to describe the results of a pipeline
@@ -151,6 +158,12 @@ type PipelineResult struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value ResultValue `json:"value"`
+
+	// Schema is the JSON Schema the value of the result must match.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Schema *JSONSchema `json:"schema,omitempty"`
 }
 
 // PipelineTaskMetadata contains the labels or annotations for an EmbeddedTask
@@ -218,12 +231,27 @@ type PipelineTask struct {
 	// +optional
 	Retries int `json:"retries,omitempty"`
 
+	// RetryPolicy configures the delay between retries and which failures are retried.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
 	// RunAfter is the list of PipelineTask names that should be executed before
 	// this Task executes. (Used to force a specific ordering in graph execution.)
 	// +optional
 	// +listType=atomic
 	RunAfter []string `json:"runAfter,omitempty"`
 
+	// RunAfterTriggers sets the outcome of the PipelineTasks of RunAfter which triggers this
+	// PipelineTask, e.g. to run it only when one of them failed. PipelineTasks of RunAfter
+	// which aren't listed keep the default behavior.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	// +listType=atomic
+	RunAfterTriggers []RunAfterTrigger `json:"runAfterTriggers,omitempty"`
+
 	// Parameters declares parameters passed to this task.
 	// +optional
 	Params Params `json:"params,omitempty"`
@@ -243,6 +271,13 @@ type PipelineTask struct {
 	// +optional
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 
+	// Cache enables the reuse of the results of a previous successful TaskRun with
+	// the same resolved Task spec, params and workspace digests instead of running the Task again.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	Cache *TaskCache `json:"cache,omitempty"`
+
 	// PipelineRef is a reference to a pipeline definition.
 	// This is an alpha field. You must set the "enable-api-fields" feature flag
 	// to "alpha" for this field to be supported. When enabled, the referenced
@@ -28,7 +28,9 @@ import (
 	"github.com/tektoncd/pipeline/test/diff"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 )
 
@@ -1200,6 +1202,123 @@ func TestPipelineTask_ValidateMatrix(t *testing.T) {
 	}
 }
 
+func TestPipelineTask_ValidateMatrixMaxConcurrency(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		matrix    *Matrix
+		apiFields string
+		wantErrs  *apis.FieldError
+	}{{
+		name: "maxConcurrency",
+		matrix: &Matrix{
+			Params:         Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			MaxConcurrency: 1,
+		},
+		apiFields: "alpha",
+	}, {
+		name: "negative maxConcurrency",
+		matrix: &Matrix{
+			Params:         Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			MaxConcurrency: -1,
+		},
+		apiFields: "alpha",
+		wantErrs:  apis.ErrInvalidValue("-1 should be >= 1", "matrix.maxConcurrency"),
+	}, {
+		name: "maxConcurrency requires alpha",
+		matrix: &Matrix{
+			Params:         Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			MaxConcurrency: 1,
+		},
+		apiFields: "beta",
+		wantErrs:  apis.ErrGeneric(`matrix.maxConcurrency requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "exclude",
+		matrix: &Matrix{
+			Params: Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			Exclude: ExcludeParamsList{{
+				Params: Params{{Name: "platform", Value: ParamValue{Type: ParamTypeString, StringVal: "mac"}}},
+			}},
+		},
+		apiFields: "alpha",
+	}, {
+		name: "exclude of a parameter not in matrix params",
+		matrix: &Matrix{
+			Params: Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			Exclude: ExcludeParamsList{{
+				Params: Params{{Name: "browser", Value: ParamValue{Type: ParamTypeString, StringVal: "safari"}}},
+			}, {}},
+		},
+		apiFields: "alpha",
+		wantErrs: apis.ErrInvalidValue(`"browser" is not a parameter of matrix.params`, "matrix.exclude[0].params[0].name").
+			Also(apis.ErrMissingField("matrix.exclude[1].params")),
+	}, {
+		name: "exclude of an array parameter",
+		matrix: &Matrix{
+			Params: Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			Exclude: ExcludeParamsList{{
+				Params: Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"mac"}}}},
+			}},
+		},
+		apiFields: "alpha",
+		wantErrs:  apis.ErrInvalidValue("parameters of type string only are allowed, but got param type array", "matrix.exclude[0].params[0].value"),
+	}, {
+		name: "exclude requires alpha",
+		matrix: &Matrix{
+			Params: Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			Exclude: ExcludeParamsList{{
+				Params: Params{{Name: "platform", Value: ParamValue{Type: ParamTypeString, StringVal: "mac"}}},
+			}},
+		},
+		apiFields: "beta",
+		wantErrs:  apis.ErrGeneric(`matrix.exclude requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "failurePolicy",
+		matrix: &Matrix{
+			Params:        Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			FailurePolicy: &MatrixFailurePolicy{FailFast: true, MinSuccessful: ptr.To(intstr.FromString("50%"))},
+		},
+		apiFields: "alpha",
+	}, {
+		name: "failurePolicy with a zero minSuccessful",
+		matrix: &Matrix{
+			Params:        Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			FailurePolicy: &MatrixFailurePolicy{MinSuccessful: ptr.To(intstr.FromInt32(0))},
+		},
+		apiFields: "alpha",
+		wantErrs:  apis.ErrInvalidValue("0 should be >= 1", "matrix.failurePolicy.minSuccessful"),
+	}, {
+		name: "failurePolicy with an invalid minSuccessful percentage",
+		matrix: &Matrix{
+			Params:        Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			FailurePolicy: &MatrixFailurePolicy{MinSuccessful: ptr.To(intstr.FromString("150%"))},
+		},
+		apiFields: "alpha",
+		wantErrs:  apis.ErrInvalidValue("150% should be a number or a percentage between 1% and 100%", "matrix.failurePolicy.minSuccessful"),
+	}, {
+		name: "failurePolicy requires alpha",
+		matrix: &Matrix{
+			Params:        Params{{Name: "platform", Value: ParamValue{Type: ParamTypeArray, ArrayVal: []string{"linux", "mac"}}}},
+			FailurePolicy: &MatrixFailurePolicy{FailFast: true},
+		},
+		apiFields: "beta",
+		wantErrs:  apis.ErrGeneric(`matrix.failurePolicy requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			featureFlags, _ := config.NewFeatureFlagsFromMap(map[string]string{
+				"enable-api-fields": tc.apiFields,
+			})
+			ctx := config.ToContext(t.Context(), &config.Config{
+				FeatureFlags: featureFlags,
+				Defaults:     &config.Defaults{DefaultMaxMatrixCombinationsCount: 4},
+			})
+			pt := &PipelineTask{Name: "task", Matrix: tc.matrix}
+			if d := cmp.Diff(tc.wantErrs.Error(), pt.validateMatrix(ctx).Error()); d != "" {
+				t.Errorf("PipelineTask.validateMatrix() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestPipelineTask_ValidateEmbeddedOrType(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	"github.com/tektoncd/pipeline/test/diff"
+	"knative.dev/pkg/apis"
+)
+
+func TestPipelineSpec_ValidatePipelineImports(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		ps      *PipelineSpec
+		wantErr *apis.FieldError
+		wc      func(context.Context) context.Context
+	}{{
+		name: "tasks referencing imported tasks",
+		ps: &PipelineSpec{
+			Workspaces: []PipelineWorkspaceDeclaration{{Name: "source"}},
+			Imports: []PipelineImport{{
+				Name:        "build",
+				PipelineRef: &PipelineRef{Name: "build-and-scan"},
+				Params:      Params{{Name: "image", Value: *NewStructuredValues("registry/app")}},
+				Workspaces:  []WorkspacePipelineTaskBinding{{Name: "shared", Workspace: "source"}},
+			}, {
+				Name:        "deploy",
+				PipelineRef: &PipelineRef{ResolverRef: ResolverRef{Resolver: "git"}},
+			}},
+			Tasks: []PipelineTask{{
+				Name:     "notify",
+				TaskRef:  &TaskRef{Name: "notify"},
+				RunAfter: []string{"deploy-rollout"},
+				Params:   Params{{Name: "digest", Value: *NewStructuredValues("$(tasks.build-scan.results.digest)")}},
+			}},
+			Finally: []PipelineTask{{
+				Name:    "report",
+				TaskRef: &TaskRef{Name: "report"},
+				Params:  Params{{Name: "status", Value: *NewStructuredValues("$(tasks.build-scan.status)")}},
+			}},
+			Results: []PipelineResult{{Name: "digest", Value: *NewStructuredValues("$(tasks.build-scan.results.digest)")}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "imports require alpha api fields",
+		ps: &PipelineSpec{
+			Imports: []PipelineImport{{Name: "build", PipelineRef: &PipelineRef{Name: "build-and-scan"}}},
+		},
+		wantErr: apis.ErrGeneric(`imports requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "invalid imports",
+		ps: &PipelineSpec{
+			Imports: []PipelineImport{{
+				PipelineRef: &PipelineRef{Name: "build-and-scan"},
+			}, {
+				Name:        "build",
+				PipelineRef: &PipelineRef{Name: "build-and-scan"},
+				Params: Params{
+					{Name: "image", Value: *NewStructuredValues("registry/app")},
+					{Name: "image", Value: *NewStructuredValues("registry/other")},
+				},
+			}, {
+				Name: "build",
+			}, {
+				Name:        "Build_Scan",
+				PipelineRef: &PipelineRef{Name: "build-and-scan"},
+				Workspaces:  []WorkspacePipelineTaskBinding{{Workspace: "source"}},
+			}},
+		},
+		wantErr: apis.ErrMissingField("imports[0].name").Also(
+			apis.ErrGeneric(`parameter names must be unique, the parameter "image" is also defined at`, "imports[1].params[1].name")).Also(
+			apis.ErrInvalidValue(`import "build" appears more than once`, "imports[2].name")).Also(
+			apis.ErrMissingField("imports[2].pipelineRef")).Also(
+			&apis.FieldError{
+				Message: `invalid value "Build_Scan"`,
+				Paths:   []string{"imports[3].name"},
+				Details: "Import name must be a valid DNS Label. For more info refer to https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+			}).Also(
+			apis.ErrMissingField("imports[3].workspaces[0].name")),
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.wc != nil {
+				ctx = tc.wc(ctx)
+			}
+			err := tc.ps.Validate(ctx)
+			if d := cmp.Diff(tc.wantErr.Error(), err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestPipelineSpec_ValidatePipelineImportsWorkspacesUsage(t *testing.T) {
+	ps := &PipelineSpec{
+		Workspaces: []PipelineWorkspaceDeclaration{{Name: "source"}},
+		Imports: []PipelineImport{{
+			Name:        "build",
+			PipelineRef: &PipelineRef{Name: "build-and-scan"},
+			Workspaces: []WorkspacePipelineTaskBinding{
+				{Name: "shared", Workspace: "source"},
+				{Name: "cache", Workspace: "cache"},
+			},
+		}},
+	}
+	wantErr := apis.ErrInvalidValue(`import "build" expects workspace "cache" to be provided by pipeline`, "imports[0].workspaces[1].workspace")
+	if d := cmp.Diff(wantErr.Error(), ps.validatePipelineWorkspacesUsage().Error()); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+}
@@ -161,6 +161,24 @@ func (pr *PipelineRun) IsPending() bool {
 	return pr.Spec.Status == PipelineRunSpecStatusPending
 }
 
+// IsPaused returns true if the PipelineRun's spec status is set to Paused state
+func (pr *PipelineRun) IsPaused() bool {
+	return pr.Spec.Status == PipelineRunSpecStatusPaused
+}
+
+// PausedFor returns how long the PipelineRun has been paused, including the ongoing pause
+// if any. The time spent paused does not count against the pipeline and tasks timeouts.
+func (pr *PipelineRun) PausedFor(c clock.PassiveClock) time.Duration {
+	var d time.Duration
+	if pr.Status.PausedDuration != nil {
+		d = pr.Status.PausedDuration.Duration
+	}
+	if pr.Status.PausedTime != nil {
+		d += c.Since(pr.Status.PausedTime.Time)
+	}
+	return d
+}
+
 // GetNamespacedName returns a k8s namespaced name that identifies this PipelineRun
 func (pr *PipelineRun) GetNamespacedName() types.NamespacedName {
 	return types.NamespacedName{Namespace: pr.Namespace, Name: pr.Name}
@@ -172,8 +190,18 @@ func (pr *PipelineRun) IsTimeoutConditionSet() bool {
 	return condition.IsFalse() && condition.Reason == PipelineRunReasonTimedOut.String()
 }
 
-// SetTimeoutCondition sets the status of the PipelineRun to timed out.
+// SetTimeoutCondition sets the status of the PipelineRun to timed out, or to queue timed out when it
+// never started executing within its queue timeout.
 func (pr *PipelineRun) SetTimeoutCondition(ctx context.Context) {
+	if pr.IsQueued() {
+		pr.Status.SetCondition(&apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  PipelineRunReasonQueueTimedOut.String(),
+			Message: fmt.Sprintf("PipelineRun %q did not start executing within the queue timeout %q", pr.Name, pr.Spec.Timeouts.Queue.Duration.String()),
+		})
+		return
+	}
 	pr.Status.SetCondition(&apis.Condition{
 		Type:    apis.ConditionSucceeded,
 		Status:  corev1.ConditionFalse,
@@ -182,16 +210,40 @@ func (pr *PipelineRun) SetTimeoutCondition(ctx context.Context) {
 	})
 }
 
+// TimeoutStartTime returns the time the timeouts of the PipelineRun count down from: the time the first Step
+// of its TaskRuns started when it has a queue timeout, and the time it started otherwise.
+func (pr *PipelineRun) TimeoutStartTime() *metav1.Time {
+	if pr.Spec.Timeouts != nil && pr.Spec.Timeouts.Queue != nil {
+		return pr.Status.ExecutionStartTime
+	}
+	return pr.Status.StartTime
+}
+
+// IsQueued returns true if the PipelineRun has a queue timeout, started, and is waiting for the first Step
+// of its TaskRuns to start.
+func (pr *PipelineRun) IsQueued() bool {
+	return pr.Spec.Timeouts != nil && pr.Spec.Timeouts.Queue != nil && pr.HasStarted() && pr.Status.ExecutionStartTime == nil
+}
+
+// HasQueueTimedOut returns true if the PipelineRun waited for the first Step of its TaskRuns to start longer
+// than its queue timeout, not counting the time it was paused.
+func (pr *PipelineRun) HasQueueTimedOut(c clock.PassiveClock) bool {
+	if !pr.IsQueued() || pr.Spec.Timeouts.Queue.Duration == config.NoTimeoutDuration {
+		return false
+	}
+	return c.Since(pr.Status.StartTime.Time)-pr.PausedFor(c) > pr.Spec.Timeouts.Queue.Duration
+}
+
 // HasTimedOut returns true if a pipelinerun has exceeded its spec.Timeout based on its status.Timeout
 func (pr *PipelineRun) HasTimedOut(ctx context.Context, c clock.PassiveClock) bool {
 	timeout := pr.PipelineTimeout(ctx)
-	startTime := pr.Status.StartTime
+	startTime := pr.TimeoutStartTime()
 
 	if !startTime.IsZero() {
 		if timeout == config.NoTimeoutDuration {
 			return false
 		}
-		runtime := c.Since(startTime.Time)
+		runtime := c.Since(startTime.Time) - pr.PausedFor(c)
 		if runtime > timeout {
 			return true
 		}
@@ -206,8 +258,8 @@ func (pr *PipelineRun) HasTimedOutForALongTime(ctx context.Context, c clock.Pass
 		return false
 	}
 	timeout := pr.PipelineTimeout(ctx)
-	startTime := pr.Status.StartTime
-	runtime := c.Since(startTime.Time)
+	startTime := pr.TimeoutStartTime()
+	runtime := c.Since(startTime.Time) - pr.PausedFor(c)
 	// We are arbitrarily defining large margin as doubling the spec.timeout
 	return runtime >= 2*timeout
 }
@@ -215,13 +267,13 @@ func (pr *PipelineRun) HasTimedOutForALongTime(ctx context.Context, c clock.Pass
 // HaveTasksTimedOut returns true if a pipelinerun has exceeded its spec.Timeouts.Tasks
 func (pr *PipelineRun) HaveTasksTimedOut(ctx context.Context, c clock.PassiveClock) bool {
 	timeout := pr.TasksTimeout()
-	startTime := pr.Status.StartTime
+	startTime := pr.TimeoutStartTime()
 
 	if !startTime.IsZero() && timeout != nil {
 		if timeout.Duration == config.NoTimeoutDuration {
 			return false
 		}
-		runtime := c.Since(startTime.Time)
+		runtime := c.Since(startTime.Time) - pr.PausedFor(c)
 		if runtime > timeout.Duration {
 			return true
 		}
@@ -300,6 +352,24 @@ type PipelineRunSpec struct {
 	// This field is immutable.
 	// +optional
 	ManagedBy *string `json:"managedBy,omitempty"`
+	// Concurrency limits the number of PipelineRuns of the same group running at the same time.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	Concurrency *Concurrency `json:"concurrency,omitempty"`
+	// RerunOf references a completed PipelineRun in the same namespace to rerun. The runs of
+	// the PipelineTasks which succeeded in it are carried over, along with the PipelineTasks
+	// skipped because of their when expressions, and only the other PipelineTasks are run.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	RerunOf *PipelineRunRef `json:"rerunOf,omitempty"`
+}
+
+// PipelineRunRef references a PipelineRun in the same namespace.
+type PipelineRunRef struct {
+	// Name of the referenced PipelineRun.
+	Name string `json:"name"`
 }
 
 // TimeoutFields allows granular specification of pipeline, task, and finally timeouts
@@ -310,6 +380,13 @@ type TimeoutFields struct {
 	Tasks *metav1.Duration `json:"tasks,omitempty"`
 	// Finally sets the maximum allowed duration of this pipeline's finally
 	Finally *metav1.Duration `json:"finally,omitempty"`
+	// Queue sets the maximum allowed duration between the start of the PipelineRun and the start of
+	// the first Step of its TaskRuns, and is the queue timeout of each of its TaskRuns. When set, the
+	// other timeouts count down from ExecutionStartTime instead of StartTime.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	Queue *metav1.Duration `json:"queue,omitempty"`
 }
 
 // PipelineRunSpecStatus defines the pipelinerun spec status the user can provide
@@ -332,6 +409,10 @@ const (
 	// PipelineRunSpecStatusPending indicates that the user wants to postpone starting a PipelineRun
 	// until some condition is met
 	PipelineRunSpecStatusPending = "PipelineRunPending"
+
+	// PipelineRunSpecStatusPaused indicates that the user wants to stop scheduling new tasks
+	// of a running PipelineRun, while letting the running ones complete, until the status is cleared
+	PipelineRunSpecStatusPaused = "PipelineRunPaused"
 )
 
 // PipelineRunStatus defines the observed state of PipelineRun
@@ -362,8 +443,13 @@ const (
 	PipelineRunReasonCancelled PipelineRunReason = "Cancelled"
 	// PipelineRunReasonPending is the reason set when the PipelineRun is in the pending state
 	PipelineRunReasonPending PipelineRunReason = "PipelineRunPending"
+	// PipelineRunReasonPaused is the reason set when the PipelineRun is paused and doesn't schedule new Tasks
+	PipelineRunReasonPaused PipelineRunReason = "PipelineRunPaused"
 	// PipelineRunReasonTimedOut is the reason set when the PipelineRun has timed out
 	PipelineRunReasonTimedOut PipelineRunReason = "PipelineRunTimeout"
+	// PipelineRunReasonQueueTimedOut is the reason set when none of the TaskRuns of the PipelineRun started
+	// executing within its queue timeout
+	PipelineRunReasonQueueTimedOut PipelineRunReason = "PipelineRunQueueTimeout"
 	// PipelineRunReasonStopping indicates that no new Tasks will be scheduled by the controller, and the
 	// pipeline will stop once all running tasks complete their work
 	PipelineRunReasonStopping PipelineRunReason = "PipelineRunStopping"
@@ -442,6 +528,11 @@ const (
 	PipelineRunReasonCELEvaluationFailed PipelineRunReason = "CELEvaluationFailed"
 	// PipelineRunReasonInvalidParamValue indicates that the PipelineRun Param input value is not allowed.
 	PipelineRunReasonInvalidParamValue PipelineRunReason = "InvalidParamValue"
+	// PipelineRunReasonInvalidResultValue indicates that the value of a PipelineRun Result does not match its schema.
+	PipelineRunReasonInvalidResultValue PipelineRunReason = "InvalidResultValue"
+	// PipelineRunReasonCouldntGetRerunOf indicates that the PipelineRun referenced by rerunOf
+	// couldn't be retrieved, or is not done yet
+	PipelineRunReasonCouldntGetRerunOf PipelineRunReason = "CouldntGetRerunOf"
 )
 
 // PipelineTaskOnErrorAnnotation is used to pass the failure strategy to TaskRun pods from PipelineTask OnError field
@@ -514,6 +605,14 @@ type ChildStatusReference struct {
 	DisplayName string `json:"displayName,omitempty"`
 	// PipelineTaskName is the name of the PipelineTask this is referencing.
 	PipelineTaskName string `json:"pipelineTaskName,omitempty"`
+	// Cached is true when the TaskRun this is referencing was created by a previous
+	// PipelineRun and reused from the cache instead of being run again.
+	// +optional
+	Cached bool `json:"cached,omitempty"`
+	// CarriedOver is true when the run this is referencing was carried over from the
+	// PipelineRun referenced by rerunOf instead of being run again.
+	// +optional
+	CarriedOver bool `json:"carriedOver,omitempty"`
 
 	// WhenExpressions is the list of checks guarding the execution of the PipelineTask
 	// +optional
@@ -528,6 +627,10 @@ type PipelineRunStatusFields struct {
 	// StartTime is the time the PipelineRun is actually started.
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
+	// ExecutionStartTime is the time the first Step of the TaskRuns of the PipelineRun started.
+	// +optional
+	ExecutionStartTime *metav1.Time `json:"executionStartTime,omitempty"`
+
 	// CompletionTime is the time the PipelineRun completed.
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
@@ -547,6 +650,16 @@ type PipelineRunStatusFields struct {
 	// +listType=atomic
 	SkippedTasks []SkippedTask `json:"skippedTasks,omitempty"`
 
+	// list of tasks whose results were reused from a previous TaskRun with the same cache key
+	// +optional
+	// +listType=atomic
+	CachedTasks []CachedTask `json:"cachedTasks,omitempty"`
+
+	// list of tasks carried over from the PipelineRun referenced by rerunOf
+	// +optional
+	// +listType=atomic
+	CarriedOverTasks []CarriedOverTask `json:"carriedOverTasks,omitempty"`
+
 	// list of TaskRun and Run names, PipelineTask names, and API versions/kinds for children of this PipelineRun.
 	// +optional
 	// +listType=atomic
@@ -556,6 +669,14 @@ type PipelineRunStatusFields struct {
 	// +optional
 	FinallyStartTime *metav1.Time `json:"finallyStartTime,omitempty"`
 
+	// PausedTime is when the PipelineRun was paused, while it is paused.
+	// +optional
+	PausedTime *metav1.Time `json:"pausedTime,omitempty"`
+
+	// PausedDuration is the time the PipelineRun spent paused before its ongoing pause, if any.
+	// +optional
+	PausedDuration *metav1.Duration `json:"pausedDuration,omitempty"`
+
 	// Provenance contains some key authenticated metadata about how a software artifact was built (what sources, what inputs/outputs, etc.).
 	// +optional
 	Provenance *Provenance `json:"provenance,omitempty"`
@@ -578,6 +699,32 @@ type SkippedTask struct {
 	WhenExpressions []WhenExpression `json:"whenExpressions,omitempty"`
 }
 
+// CachedTask is used to describe the Tasks that were not run because a previous
+// successful TaskRun with the same cache key was found.
+type CachedTask struct {
+	// Name is the Pipeline Task name
+	Name string `json:"name"`
+	// TaskRunName is the name of the reused TaskRun
+	TaskRunName string `json:"taskRunName"`
+	// CacheKey is the digest of the resolved Task spec, params and workspace digests
+	// the TaskRun was found with
+	CacheKey string `json:"cacheKey"`
+}
+
+// CarriedOverTask is used to describe the Tasks that were not run again because they
+// succeeded, or were skipped because of their when expressions, in the PipelineRun
+// referenced by rerunOf.
+type CarriedOverTask struct {
+	// Name is the Pipeline Task name
+	Name string `json:"name"`
+	// PipelineRunName is the name of the PipelineRun the Task is carried over from
+	PipelineRunName string `json:"pipelineRunName"`
+	// Skipped is true when the Task was skipped because of its when expressions,
+	// and is skipped again without evaluating them
+	// +optional
+	Skipped bool `json:"skipped,omitempty"`
+}
+
 // SkippingReason explains why a PipelineTask was skipped.
 type SkippingReason string
 
@@ -586,6 +733,8 @@ const (
 	WhenExpressionsSkip SkippingReason = "When Expressions evaluated to false"
 	// ParentTasksSkip means the task was skipped because its parent was skipped
 	ParentTasksSkip SkippingReason = "Parent Tasks were skipped"
+	// RunAfterTriggersSkip means the task was skipped because the outcome of a task of runAfter didn't match its trigger
+	RunAfterTriggersSkip SkippingReason = "RunAfter triggers were not met"
 	// StoppingSkip means the task was skipped because the pipeline run is stopping
 	StoppingSkip SkippingReason = "PipelineRun was stopping"
 	// GracefullyCancelledSkip means the task was skipped because the pipeline run has been gracefully cancelled
@@ -300,6 +300,26 @@ func TestPipelineRunSetTimeoutCondition(t *testing.T) {
 			Reason:  "PipelineRunTimeout",
 			Message: `PipelineRun "test-pipeline-run" failed to finish within "1h0m0s"`,
 		},
+	}, {
+		name: "set condition to queue timeout before execution started",
+		pipelineRun: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline-run"},
+			Spec: v1.PipelineRunSpec{
+				Timeouts: &v1.TimeoutFields{
+					Pipeline: &metav1.Duration{Duration: time.Hour},
+					Queue:    &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+			Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				StartTime: &metav1.Time{Time: now},
+			}},
+		},
+		want: &apis.Condition{
+			Type:    "Succeeded",
+			Status:  "False",
+			Reason:  "PipelineRunQueueTimeout",
+			Message: `PipelineRun "test-pipeline-run" did not start executing within the queue timeout "5m0s"`,
+		},
 	}}
 
 	for _, tc := range tcs {
@@ -435,6 +455,108 @@ func TestPipelineRunHasTimedOut(t *testing.T) {
 	}
 }
 
+func TestPipelineRunHasTimedOutWhilePaused(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		specStatus     v1.PipelineRunSpecStatus
+		pausedTime     *metav1.Time
+		pausedDuration *metav1.Duration
+		expected       bool
+	}{{
+		name:     "never paused",
+		expected: true,
+	}, {
+		name:           "paused long enough before",
+		pausedDuration: &metav1.Duration{Duration: 30 * time.Minute},
+		expected:       false,
+	}, {
+		name:       "paused since long enough",
+		specStatus: v1.PipelineRunSpecStatusPaused,
+		pausedTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+		expected:   false,
+	}, {
+		name:           "paused not long enough",
+		specStatus:     v1.PipelineRunSpecStatusPaused,
+		pausedTime:     &metav1.Time{Time: now.Add(-5 * time.Minute)},
+		pausedDuration: &metav1.Duration{Duration: 5 * time.Minute},
+		expected:       true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: v1.PipelineRunSpec{
+					Status: tc.specStatus,
+					Timeouts: &v1.TimeoutFields{
+						Pipeline: &metav1.Duration{Duration: time.Hour},
+						Tasks:    &metav1.Duration{Duration: 30 * time.Minute},
+					},
+				},
+				Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime:      &metav1.Time{Time: now.Add(-45 * time.Minute)},
+					PausedTime:     tc.pausedTime,
+					PausedDuration: tc.pausedDuration,
+				}},
+			}
+			if got := pr.HaveTasksTimedOut(t.Context(), testClock); got != tc.expected {
+				t.Errorf("Expected HaveTasksTimedOut to be %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPipelineRunHasQueueTimedOut(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		queue              *metav1.Duration
+		pausedDuration     *metav1.Duration
+		executionStartTime *metav1.Time
+		expectedQueueTO    bool
+		expectedTimedOut   bool
+	}{{
+		name:             "no queue timeout",
+		expectedTimedOut: true,
+	}, {
+		name:            "queue timeout exceeded",
+		queue:           &metav1.Duration{Duration: 10 * time.Minute},
+		expectedQueueTO: true,
+	}, {
+		name:           "paused long enough not to exceed the queue timeout",
+		queue:          &metav1.Duration{Duration: 10 * time.Minute},
+		pausedDuration: &metav1.Duration{Duration: 10 * time.Minute},
+	}, {
+		name:  "no limit on queue time",
+		queue: &metav1.Duration{Duration: 0},
+	}, {
+		name:               "execution timeout counts from the execution start time",
+		queue:              &metav1.Duration{Duration: 10 * time.Minute},
+		executionStartTime: &metav1.Time{Time: now.Add(-11 * time.Minute)},
+		expectedTimedOut:   true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: v1.PipelineRunSpec{
+					Timeouts: &v1.TimeoutFields{
+						Pipeline: &metav1.Duration{Duration: 10 * time.Minute},
+						Queue:    tc.queue,
+					},
+				},
+				Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime:          &metav1.Time{Time: now.Add(-15 * time.Minute)},
+					PausedDuration:     tc.pausedDuration,
+					ExecutionStartTime: tc.executionStartTime,
+				}},
+			}
+			if got := pr.HasQueueTimedOut(testClock); got != tc.expectedQueueTO {
+				t.Errorf("Expected HasQueueTimedOut to be %t, got %t", tc.expectedQueueTO, got)
+			}
+			if got := pr.HasTimedOut(t.Context(), testClock); got != tc.expectedTimedOut {
+				t.Errorf("Expected HasTimedOut to be %t, got %t", tc.expectedTimedOut, got)
+			}
+		})
+	}
+}
+
 func TestPipelineRunTimeouts(t *testing.T) {
 	tcs := []struct {
 		name                   string
@@ -85,7 +85,7 @@ func TestPipelineRun_Invalid(t *testing.T) {
 				Status: "PipelineRunCancell",
 			},
 		},
-		want: apis.ErrInvalidValue("PipelineRunCancell should be Cancelled, CancelledRunFinally, StoppedRunFinally or PipelineRunPending", "spec.status"),
+		want: apis.ErrInvalidValue("PipelineRunCancell should be Cancelled, CancelledRunFinally, StoppedRunFinally, PipelineRunPending or PipelineRunPaused", "spec.status"),
 	}, {
 		name: "propagating params with pipelinespec and taskspec params not provided",
 		pr: v1.PipelineRun{
@@ -347,6 +347,56 @@ func TestPipelineRun_Invalid(t *testing.T) {
 			Message: "invalid value: PipelineRun cannot be Pending after it is started",
 			Paths:   []string{"spec.status"},
 		},
+	}, {
+		name: "pipelinerun paused without alpha feature gate",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				Status:      v1.PipelineRunSpecStatusPaused,
+				PipelineRef: &v1.PipelineRef{Name: "prname"},
+			},
+		},
+		want: apis.ErrGeneric(`PipelineRunPaused requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "rerunOf without alpha feature gate",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: "prname"},
+				RerunOf:     &v1.PipelineRunRef{Name: "previous"},
+			},
+		},
+		want: apis.ErrGeneric(`rerunOf requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "rerunOf without name",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: "prname"},
+				RerunOf:     &v1.PipelineRunRef{},
+			},
+		},
+		want: apis.ErrMissingField("spec.rerunOf.name"),
+		wc:   cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "rerunOf itself",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: "prname"},
+				RerunOf:     &v1.PipelineRunRef{Name: "pipelinerunname"},
+			},
+		},
+		want: apis.ErrInvalidValue("a PipelineRun cannot rerun itself", "spec.rerunOf.name"),
+		wc:   cfgtesting.EnableAlphaAPIFields,
 	}}
 
 	for _, tc := range tests {
@@ -740,6 +790,23 @@ func TestPipelineRun_Validate(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "pipelinerun paused",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				Status:      v1.PipelineRunSpecStatusPaused,
+				PipelineRef: &v1.PipelineRef{Name: "prname"},
+			},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime: &metav1.Time{Time: time.Now()},
+				},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
 	}, {
 		name: "pipelinerun cancelled",
 		pr: v1.PipelineRun{
@@ -807,6 +874,23 @@ func TestPipelineRun_Validate(t *testing.T) {
 			},
 		},
 		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "valid queue timeout",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinelinename",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{
+					Name: "prname",
+				},
+				Timeouts: &v1.TimeoutFields{
+					Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+					Queue:    &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
 	}, {
 		name: "valid task-specific timeouts",
 		pr: v1.PipelineRun{
@@ -829,6 +913,18 @@ func TestPipelineRun_Validate(t *testing.T) {
 				}},
 			},
 		},
+	}, {
+		name: "rerunOf a previous PipelineRun",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinerunname",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: "prname"},
+				RerunOf:     &v1.PipelineRunRef{Name: "previous"},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
 	}}
 
 	for _, ts := range tests {
@@ -1226,6 +1322,22 @@ func TestPipelineRun_InvalidTimeouts(t *testing.T) {
 			},
 		},
 		want: apis.ErrInvalidValue("-48h0m0s should be >= 0", "spec.timeouts.pipeline"),
+	}, {
+		name: "queue timeout without alpha feature gate",
+		pr: v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pipelinelinename",
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{
+					Name: "prname",
+				},
+				Timeouts: &v1.TimeoutFields{
+					Queue: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		},
+		want: apis.ErrGeneric("timeouts.queue requires \"enable-api-fields\" feature gate to be \"alpha\" but it is \"beta\"").ViaField("spec"),
 	}, {
 		name: "negative task-specific timeout",
 		pr: v1.PipelineRun{
@@ -23,6 +23,15 @@ type Provenance struct {
 	// RefSource identifies the source where a remote task/pipeline came from.
 	RefSource *RefSource `json:"refSource,omitempty"`
 
+	// BaseRefSource identifies the source where the base Task extended by the task came from.
+	BaseRefSource *RefSource `json:"baseRefSource,omitempty"`
+
+	// Attestation is the name of the ConfigMap holding the signed provenance attestation of the completed run.
+	Attestation string `json:"attestation,omitempty"`
+
+	// AttestationError is the reason why the provenance attestation of the completed run could not be signed.
+	AttestationError string `json:"attestationError,omitempty"`
+
 	// FeatureFlags identifies the feature flags that were used during the task/pipeline run
 	FeatureFlags *config.FeatureFlags `json:"featureFlags,omitempty"`
 }
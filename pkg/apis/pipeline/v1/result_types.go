@@ -38,6 +38,12 @@ type TaskResult struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value *ResultValue `json:"value,omitempty"`
+
+	// Schema is the JSON Schema the value of the result must match.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Schema *JSONSchema `json:"schema,omitempty"`
 }
 
 // StepResult used to describe the Results of a Step.
@@ -72,6 +78,11 @@ type TaskRunResult struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value ResultValue `json:"value"`
+
+	// URI is the digest-addressed location of the value of the result when it is
+	// stored in object storage rather than in the status, in which case Value is empty.
+	// +optional
+	URI string `json:"uri,omitempty"`
 }
 
 // TaskRunStepResult is a type alias of TaskRunResult
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 )
@@ -39,9 +40,20 @@ func (tr TaskResult) Validate(ctx context.Context) (errs *apis.FieldError) {
 	case tr.Type != ResultsTypeString:
 		errs = errs.Also(apis.ErrInvalidValue(tr.Type, "type", "type must be string"))
 	}
+	errs = errs.Also(ValidateResultSchema(ctx, tr.Schema, tr.Type))
 	return errs.Also(tr.validateValue(ctx))
 }
 
+// ValidateResultSchema validates feature flag and JSON Schema for Result Schema
+func ValidateResultSchema(ctx context.Context, schema *JSONSchema, t ResultsType) (errs *apis.FieldError) {
+	if schema == nil {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "result schema", config.AlphaAPIFields))
+	errs = errs.Also(schema.Validate(ctx).ViaField("schema"))
+	return errs.Also(schema.validateForType(ParamType(t)).ViaField("schema"))
+}
+
 // validateObjectResult validates the object result and check if the Properties is missing
 // for Properties values it will check if the type is string.
 func validateObjectResult(tr TaskResult) (errs *apis.FieldError) {
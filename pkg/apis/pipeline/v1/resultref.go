@@ -135,5 +135,6 @@ func PipelineTaskResultRefs(pt *PipelineTask) []*ResultRef {
 	}
 	taskSubExpressions := pt.GetVarSubstitutionExpressions()
 	refs = append(refs, NewResultRefs(taskSubExpressions)...)
+	refs = append(refs, NewResultRefs(pt.Cache.GetVarSubstitutionExpressions())...)
 	return refs
 }
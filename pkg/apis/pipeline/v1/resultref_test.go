@@ -886,6 +886,12 @@ func TestPipelineTaskResultRefs(t *testing.T) {
 				},
 			},
 		},
+		Cache: &v1.TaskCache{
+			Workspaces: []v1.CacheWorkspace{{
+				Name:   "source",
+				Digest: "$(tasks.pt15.results.r15)",
+			}},
+		},
 	}
 	refs := v1.PipelineTaskResultRefs(&pt)
 	expectedRefs := []*v1.ResultRef{{
@@ -936,6 +942,9 @@ func TestPipelineTaskResultRefs(t *testing.T) {
 	}, {
 		PipelineTask: "pt14",
 		Result:       "r14",
+	}, {
+		PipelineTask: "pt15",
+		Result:       "r15",
 	}}
 	if d := cmp.Diff(refs, expectedRefs, cmpopts.SortSlices(lessResultRef)); d != "" {
 		t.Errorf("%v", d)
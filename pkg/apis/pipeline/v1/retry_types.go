@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"hash/fnv"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+// RetryBackoffStrategy defines how the delay between two retries of a TaskRun is computed
+type RetryBackoffStrategy string
+
+const (
+	// RetryBackoffFixed waits the same delay before every retry
+	RetryBackoffFixed RetryBackoffStrategy = "fixed"
+	// RetryBackoffExponential doubles the delay after every retry
+	RetryBackoffExponential RetryBackoffStrategy = "exponential"
+)
+
+// RetryPolicy configures how a failed TaskRun is retried.
+// The number of retries is still set by the `retries` field.
+type RetryPolicy struct {
+	// Backoff configures the delay to wait after a failure before the next retry is started.
+	// If not set, the next retry is started immediately.
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// RetryOn restricts retries to failures matching at least one of the listed conditions.
+	// If empty, every failure except cancellation is retried.
+	// +optional
+	// +listType=atomic
+	RetryOn []RetryCondition `json:"retryOn,omitempty"`
+}
+
+// RetryBackoff defines the delay between two retries of a TaskRun
+type RetryBackoff struct {
+	// Strategy is the strategy used to compute the delay, either "fixed" or "exponential".
+	// Defaults to "fixed".
+	// +optional
+	Strategy RetryBackoffStrategy `json:"strategy,omitempty"`
+
+	// Delay is the delay before the first retry. With the "exponential" strategy,
+	// the delay is doubled for every subsequent retry.
+	// Refer Go's ParseDuration documentation for expected format: https://golang.org/pkg/time/#ParseDuration
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+
+	// MaxDelay caps the delay between two retries.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+
+	// Jitter is the maximum percentage (0-100) of the computed delay that is randomly
+	// added to it, so that TaskRuns failing at the same time are not all retried at once.
+	// +optional
+	Jitter int `json:"jitter,omitempty"`
+}
+
+// RetryCondition describes a TaskRun failure that should be retried.
+// When both Reason and ExitCodes are set, a failure must match both.
+type RetryCondition struct {
+	// Reason matches the reason of the failed TaskRun's Succeeded condition,
+	// e.g. "TaskRunTimeout", "PodEvicted" or "TaskRunImagePullFailed".
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ExitCodes matches when a Step of the failed TaskRun terminated with one of the listed exit codes.
+	// +optional
+	// +listType=atomic
+	ExitCodes []int32 `json:"exitCodes,omitempty"`
+}
+
+// ShouldRetry returns true if the failed TaskRun status matches the RetryOn conditions of the policy.
+// A nil policy, or one without RetryOn conditions, retries every failure.
+func (rp *RetryPolicy) ShouldRetry(status *TaskRunStatus) bool {
+	if rp == nil || len(rp.RetryOn) == 0 {
+		return true
+	}
+	for _, rc := range rp.RetryOn {
+		if rc.matches(status) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDelay returns the delay to wait before the given retry, starting at 1 for the first retry.
+// The seed is used to derive a stable jitter so that the delay is the same across reconciles.
+func (rp *RetryPolicy) GetDelay(retry int, seed string) time.Duration {
+	if rp == nil || rp.Backoff == nil {
+		return 0
+	}
+	return rp.Backoff.getDelay(retry, seed)
+}
+
+func (rb *RetryBackoff) getDelay(retry int, seed string) time.Duration {
+	if rb.Delay == nil || retry < 1 {
+		return 0
+	}
+	delay := rb.Delay.Duration
+	if rb.Strategy == RetryBackoffExponential {
+		for i := 1; i < retry; i++ {
+			// stop doubling once the cap is reached, or before the delay overflows
+			if (rb.MaxDelay != nil && delay >= rb.MaxDelay.Duration) || delay > math.MaxInt64/2 {
+				break
+			}
+			delay *= 2
+		}
+	}
+	if rb.Jitter > 0 {
+		h := fnv.New64a()
+		h.Write([]byte(seed + "/" + strconv.Itoa(retry)))
+		fraction := float64(h.Sum64()%1000) / 1000
+		if jittered := delay + time.Duration(fraction*float64(rb.Jitter)/100*float64(delay)); jittered > delay {
+			delay = jittered
+		}
+	}
+	if rb.MaxDelay != nil && delay > rb.MaxDelay.Duration {
+		delay = rb.MaxDelay.Duration
+	}
+	return delay
+}
+
+func (rc RetryCondition) matches(status *TaskRunStatus) bool {
+	if rc.Reason != "" {
+		cond := status.GetCondition(apis.ConditionSucceeded)
+		if cond == nil || cond.Reason != rc.Reason {
+			return false
+		}
+	}
+	if len(rc.ExitCodes) > 0 {
+		for _, step := range status.Steps {
+			if step.Terminated != nil && slices.Contains(rc.ExitCodes, step.Terminated.ExitCode) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
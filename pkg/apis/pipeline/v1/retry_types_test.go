@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func failedStatus(reason string, exitCodes ...int32) *v1.TaskRunStatus {
+	status := &v1.TaskRunStatus{
+		Status: duckv1.Status{Conditions: duckv1.Conditions{{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionFalse,
+			Reason: reason,
+		}}},
+	}
+	for _, code := range exitCodes {
+		status.Steps = append(status.Steps, v1.StepState{
+			ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: code}},
+		})
+	}
+	return status
+}
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		policy *v1.RetryPolicy
+		status *v1.TaskRunStatus
+		want   bool
+	}{{
+		name:   "nil policy retries every failure",
+		status: failedStatus(v1.TaskRunReasonFailed.String()),
+		want:   true,
+	}, {
+		name:   "no retryOn retries every failure",
+		policy: &v1.RetryPolicy{},
+		status: failedStatus(v1.TaskRunReasonFailed.String()),
+		want:   true,
+	}, {
+		name: "matching reason",
+		policy: &v1.RetryPolicy{RetryOn: []v1.RetryCondition{
+			{Reason: v1.TaskRunReasonTimedOut.String()},
+			{Reason: v1.TaskRunReasonPodEvicted.String()},
+		}},
+		status: failedStatus(v1.TaskRunReasonPodEvicted.String()),
+		want:   true,
+	}, {
+		name: "reason does not match",
+		policy: &v1.RetryPolicy{RetryOn: []v1.RetryCondition{
+			{Reason: v1.TaskRunReasonImagePullFailed.String()},
+		}},
+		status: failedStatus(v1.TaskRunReasonFailed.String()),
+		want:   false,
+	}, {
+		name: "matching exit code",
+		policy: &v1.RetryPolicy{RetryOn: []v1.RetryCondition{
+			{ExitCodes: []int32{137, 143}},
+		}},
+		status: failedStatus(v1.TaskRunReasonFailed.String(), 0, 143),
+		want:   true,
+	}, {
+		name: "exit code does not match",
+		policy: &v1.RetryPolicy{RetryOn: []v1.RetryCondition{
+			{ExitCodes: []int32{137}},
+		}},
+		status: failedStatus(v1.TaskRunReasonFailed.String(), 0, 1),
+		want:   false,
+	}, {
+		name: "reason and exit code must both match",
+		policy: &v1.RetryPolicy{RetryOn: []v1.RetryCondition{
+			{Reason: v1.TaskRunReasonTimedOut.String(), ExitCodes: []int32{1}},
+		}},
+		status: failedStatus(v1.TaskRunReasonFailed.String(), 1),
+		want:   false,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.policy.ShouldRetry(tc.status); got != tc.want {
+				t.Errorf("ShouldRetry() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_GetDelay(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		policy *v1.RetryPolicy
+		retry  int
+		want   time.Duration
+	}{{
+		name:  "nil policy",
+		retry: 1,
+		want:  0,
+	}, {
+		name:   "no backoff",
+		policy: &v1.RetryPolicy{},
+		retry:  1,
+		want:   0,
+	}, {
+		name: "fixed",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Delay: &metav1.Duration{Duration: 10 * time.Second},
+		}},
+		retry: 3,
+		want:  10 * time.Second,
+	}, {
+		name: "exponential first retry",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Strategy: v1.RetryBackoffExponential,
+			Delay:    &metav1.Duration{Duration: 10 * time.Second},
+		}},
+		retry: 1,
+		want:  10 * time.Second,
+	}, {
+		name: "exponential third retry",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Strategy: v1.RetryBackoffExponential,
+			Delay:    &metav1.Duration{Duration: 10 * time.Second},
+		}},
+		retry: 3,
+		want:  40 * time.Second,
+	}, {
+		name: "exponential capped by maxDelay",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Strategy: v1.RetryBackoffExponential,
+			Delay:    &metav1.Duration{Duration: 10 * time.Second},
+			MaxDelay: &metav1.Duration{Duration: 30 * time.Second},
+		}},
+		retry: 5,
+		want:  30 * time.Second,
+	}, {
+		name: "jitter capped by maxDelay",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Delay:    &metav1.Duration{Duration: 10 * time.Second},
+			MaxDelay: &metav1.Duration{Duration: 10 * time.Second},
+			Jitter:   100,
+		}},
+		retry: 1,
+		want:  10 * time.Second,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.policy.GetDelay(tc.retry, "foo/bar"); got != tc.want {
+				t.Errorf("GetDelay() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_GetDelay_Jitter(t *testing.T) {
+	policy := &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+		Delay:  &metav1.Duration{Duration: 10 * time.Second},
+		Jitter: 50,
+	}}
+	got := policy.GetDelay(1, "foo/bar")
+	if got < 10*time.Second || got > 15*time.Second {
+		t.Errorf("GetDelay() = %s, want between 10s and 15s", got)
+	}
+	if again := policy.GetDelay(1, "foo/bar"); again != got {
+		t.Errorf("GetDelay() is not stable for the same seed: %s != %s", again, got)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		policy *v1.RetryPolicy
+	}{{
+		name: "nil policy",
+	}, {
+		name: "fixed backoff",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Delay: &metav1.Duration{Duration: 10 * time.Second},
+		}},
+	}, {
+		name: "exponential backoff with cap and jitter",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Strategy: v1.RetryBackoffExponential,
+			Delay:    &metav1.Duration{Duration: 10 * time.Second},
+			MaxDelay: &metav1.Duration{Duration: time.Minute},
+			Jitter:   20,
+		}},
+	}, {
+		name: "retryOn reasons and exit codes",
+		policy: &v1.RetryPolicy{RetryOn: []v1.RetryCondition{
+			{Reason: v1.TaskRunReasonTimedOut.String()},
+			{ExitCodes: []int32{137}},
+			{Reason: v1.TaskRunReasonFailed.String(), ExitCodes: []int32{1, 2}},
+		}},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := cfgtesting.EnableAlphaAPIFields(t.Context())
+			if err := tc.policy.Validate(ctx); err != nil {
+				t.Errorf("RetryPolicy.Validate() returned an unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Invalidate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		policy  *v1.RetryPolicy
+		wantErr *apis.FieldError
+		wc      func(context.Context) context.Context
+	}{{
+		name:    "not enabled without alpha api fields",
+		policy:  &v1.RetryPolicy{},
+		wantErr: apis.ErrGeneric(`retryPolicy requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "unknown strategy",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Strategy: "linear",
+			Delay:    &metav1.Duration{Duration: time.Second},
+		}},
+		wantErr: apis.ErrInvalidValue("linear should be fixed or exponential", "backoff.strategy"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:    "missing delay",
+		policy:  &v1.RetryPolicy{Backoff: &v1.RetryBackoff{}},
+		wantErr: apis.ErrMissingField("backoff.delay"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "negative delay",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Delay: &metav1.Duration{Duration: -time.Second},
+		}},
+		wantErr: apis.ErrInvalidValue("-1s should be >= 0", "backoff.delay"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "maxDelay lower than delay",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Delay:    &metav1.Duration{Duration: time.Minute},
+			MaxDelay: &metav1.Duration{Duration: time.Second},
+		}},
+		wantErr: apis.ErrInvalidValue("1s should be >= delay 1m0s", "backoff.maxDelay"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "jitter out of bounds",
+		policy: &v1.RetryPolicy{Backoff: &v1.RetryBackoff{
+			Delay:  &metav1.Duration{Duration: time.Second},
+			Jitter: 150,
+		}},
+		wantErr: apis.ErrOutOfBoundsValue(150, 0, 100, "backoff.jitter"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name:    "empty retryOn condition",
+		policy:  &v1.RetryPolicy{RetryOn: []v1.RetryCondition{{}}},
+		wantErr: apis.ErrMissingOneOf("retryOn[0].reason", "retryOn[0].exitCodes"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "retryOn cancellation",
+		policy: &v1.RetryPolicy{RetryOn: []v1.RetryCondition{
+			{ExitCodes: []int32{1}},
+			{Reason: v1.TaskRunReasonCancelled.String()},
+		}},
+		wantErr: apis.ErrInvalidValue("TaskRunCancelled is never retried", "retryOn[1].reason"),
+		wc:      cfgtesting.EnableAlphaAPIFields,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.wc != nil {
+				ctx = tc.wc(ctx)
+			}
+			err := tc.policy.Validate(ctx)
+			if d := cmp.Diff(tc.wantErr.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestPipelineTask_ValidateRetryPolicy(t *testing.T) {
+	policy := &v1.RetryPolicy{Backoff: &v1.RetryBackoff{Delay: &metav1.Duration{Duration: time.Second}}}
+	for _, tc := range []struct {
+		name    string
+		task    v1.PipelineTask
+		wantErr *apis.FieldError
+	}{{
+		name: "task",
+		task: v1.PipelineTask{Name: "build", TaskRef: &v1.TaskRef{Name: "build"}, RetryPolicy: policy},
+	}, {
+		name: "custom task ref",
+		task: v1.PipelineTask{
+			Name:        "wait",
+			TaskRef:     &v1.TaskRef{APIVersion: "example.dev/v0", Kind: "Wait"},
+			RetryPolicy: policy,
+		},
+		wantErr: apis.ErrInvalidValue("retryPolicy is not supported for custom tasks", "retryPolicy"),
+	}, {
+		name: "custom task spec",
+		task: v1.PipelineTask{
+			Name:        "wait",
+			TaskSpec:    &v1.EmbeddedTask{TypeMeta: runtime.TypeMeta{APIVersion: "example.dev/v0", Kind: "Wait"}},
+			RetryPolicy: policy,
+		},
+		wantErr: apis.ErrInvalidValue("retryPolicy is not supported for custom tasks", "retryPolicy"),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := cfgtesting.EnableAlphaAPIFields(t.Context())
+			err := tc.task.Validate(ctx)
+			if d := cmp.Diff(tc.wantErr.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
+)
+
+// validateRunAfterTriggers validates the RunAfterTriggers field of a PipelineTask: each
+// trigger must name a PipelineTask of runAfter once, with a known condition.
+func (pt PipelineTask) validateRunAfterTriggers(ctx context.Context) (errs *apis.FieldError) {
+	if len(pt.RunAfterTriggers) == 0 {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "runAfterTriggers", config.AlphaAPIFields))
+	runAfter := sets.NewString(pt.RunAfter...)
+	seen := sets.NewString()
+	for i, trigger := range pt.RunAfterTriggers {
+		switch {
+		case trigger.Task == "":
+			errs = errs.Also(apis.ErrMissingField("task").ViaFieldIndex("runAfterTriggers", i))
+		case seen.Has(trigger.Task):
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("task %q appears more than once", trigger.Task), "task").ViaFieldIndex("runAfterTriggers", i))
+		case !runAfter.Has(trigger.Task):
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("task %q is not listed in runAfter", trigger.Task), "task").ViaFieldIndex("runAfterTriggers", i))
+		}
+		seen.Insert(trigger.Task)
+		switch trigger.Condition {
+		case RunAfterOnSuccess, RunAfterOnFailure, RunAfterAlways:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%q should be %s, %s or %s", trigger.Condition, RunAfterOnSuccess, RunAfterOnFailure, RunAfterAlways), "condition").ViaFieldIndex("runAfterTriggers", i))
+		}
+	}
+	return errs
+}
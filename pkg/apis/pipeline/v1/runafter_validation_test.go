@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	"github.com/tektoncd/pipeline/test/diff"
+	"knative.dev/pkg/apis"
+)
+
+func TestPipelineTask_ValidateRunAfterTriggers(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		pt      PipelineTask
+		wantErr *apis.FieldError
+		wc      func(context.Context) context.Context
+	}{{
+		name: "no triggers",
+		pt:   PipelineTask{Name: "foo", TaskRef: &TaskRef{Name: "bar"}, RunAfter: []string{"deploy"}},
+	}, {
+		name: "triggers on tasks of runAfter",
+		pt: PipelineTask{
+			Name:     "foo",
+			TaskRef:  &TaskRef{Name: "bar"},
+			RunAfter: []string{"deploy", "test", "build"},
+			RunAfterTriggers: []RunAfterTrigger{
+				{Task: "deploy", Condition: RunAfterOnFailure},
+				{Task: "test", Condition: RunAfterAlways},
+				{Task: "build", Condition: RunAfterOnSuccess},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "triggers require alpha api fields",
+		pt: PipelineTask{
+			Name:             "foo",
+			TaskRef:          &TaskRef{Name: "bar"},
+			RunAfter:         []string{"deploy"},
+			RunAfterTriggers: []RunAfterTrigger{{Task: "deploy", Condition: RunAfterOnFailure}},
+		},
+		wantErr: apis.ErrGeneric(`runAfterTriggers requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "invalid triggers",
+		pt: PipelineTask{
+			Name:     "foo",
+			TaskRef:  &TaskRef{Name: "bar"},
+			RunAfter: []string{"deploy"},
+			RunAfterTriggers: []RunAfterTrigger{
+				{Condition: RunAfterOnFailure},
+				{Task: "deploy", Condition: RunAfterOnFailure},
+				{Task: "deploy", Condition: RunAfterAlways},
+				{Task: "test", Condition: RunAfterOnFailure},
+				{Task: "deploy", Condition: "onCancel"},
+			},
+		},
+		wantErr: apis.ErrMissingField("runAfterTriggers[0].task").Also(
+			apis.ErrInvalidValue(`task "deploy" appears more than once`, "runAfterTriggers[2].task")).Also(
+			apis.ErrInvalidValue(`task "test" is not listed in runAfter`, "runAfterTriggers[3].task")).Also(
+			apis.ErrInvalidValue(`task "deploy" appears more than once`, "runAfterTriggers[4].task")).Also(
+			apis.ErrInvalidValue(`"onCancel" should be onSuccess, onFailure or always`, "runAfterTriggers[4].condition")),
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.wc != nil {
+				ctx = tc.wc(ctx)
+			}
+			err := tc.pt.validateRunAfterTriggers(ctx)
+			if d := cmp.Diff(tc.wantErr.Error(), err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
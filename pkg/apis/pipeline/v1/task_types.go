@@ -125,6 +125,13 @@ type TaskSpec struct {
 	// Results are values that this Task can output
 	// +listType=atomic
 	Results []TaskResult `json:"results,omitempty"`
+
+	// Extends references a base Task which this Task extends: the steps, params, results,
+	// sidecars and stepTemplate of this Task are merged into the ones of the base Task.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	Extends *TaskExtends `json:"extends,omitempty"`
 }
 
 // TaskList contains a list of Task
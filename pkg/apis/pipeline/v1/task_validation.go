@@ -71,6 +71,11 @@ func (t *Task) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ts *TaskSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if ts.Extends != nil {
+		// The steps of a Task extending a base Task may be partial, and may use the params,
+		// results and workspaces of the base Task, so the TaskSpec is validated once merged.
+		return ts.validateExtends(ctx)
+	}
 	if len(ts.Steps) == 0 {
 		errs = errs.Also(apis.ErrMissingField("steps"))
 	}
@@ -88,6 +93,7 @@ func (ts *TaskSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	}
 
 	errs = errs.Also(StepList(mergedSteps).Validate(ctx).ViaField("steps"))
+	errs = errs.Also(validateStepParallelGroups(ctx, ts.Steps).ViaField("steps"))
 	errs = errs.Also(SidecarList(ts.Sidecars).Validate(ctx).ViaField("sidecars"))
 	errs = errs.Also(ValidateParameterTypes(ctx, ts.Params).ViaField("params"))
 	errs = errs.Also(ValidateParameterVariables(ctx, ts.Steps, ts.Params))
@@ -126,6 +132,25 @@ func validateResults(ctx context.Context, results []TaskResult) (errs *apis.Fiel
 	return errs
 }
 
+// validateStepParallelGroups validates that the Steps of a parallel group are consecutive.
+func validateStepParallelGroups(ctx context.Context, steps []Step) (errs *apis.FieldError) {
+	seen := sets.NewString()
+	for idx, s := range steps {
+		if s.ParallelGroup == "" {
+			continue
+		}
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "parallelGroup", config.AlphaAPIFields).ViaIndex(idx))
+		if idx > 0 && steps[idx-1].ParallelGroup == s.ParallelGroup {
+			continue
+		}
+		if seen.Has(s.ParallelGroup) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("the Steps of parallel group %q must be consecutive", s.ParallelGroup), "parallelGroup").ViaIndex(idx))
+		}
+		seen.Insert(s.ParallelGroup)
+	}
+	return errs
+}
+
 // a mount path which conflicts with any other declared workspaces, with the explicitly
 // declared volume mounts, or with the stepTemplate. The names must also be unique.
 func validateDeclaredWorkspaces(workspaces []WorkspaceDeclaration, steps []Step, stepTemplate *StepTemplate) (errs *apis.FieldError) {
@@ -341,6 +366,8 @@ func ValidateParameterVariables(ctx context.Context, steps []Step, params ParamS
 	var errs *apis.FieldError
 	errs = errs.Also(params.ValidateNoDuplicateNames())
 	errs = errs.Also(params.validateParamEnums(ctx).ViaField("params"))
+	errs = errs.Also(params.ValidateParamSchemas(ctx).ViaField("params"))
+	errs = errs.Also(params.ValidateSensitiveParams(ctx).ViaField("params"))
 	stringParams, arrayParams, objectParams := params.SortByType()
 	stringParameterNames := sets.NewString(stringParams.GetNames()...)
 	arrayParameterNames := sets.NewString(arrayParams.GetNames()...)
@@ -2110,6 +2110,148 @@ func TestParamEnum_Failure(t *testing.T) {
 	}
 }
 
+func TestParamSchema_Success(t *testing.T) {
+	minLength := int64(1)
+	tcs := []struct {
+		name   string
+		params v1.ParamSpecs
+	}{{
+		name: "string param with schema and matching default - success",
+		params: []v1.ParamSpec{{
+			Name:    "param1",
+			Type:    v1.ParamTypeString,
+			Schema:  &v1.JSONSchema{Type: v1.JSONSchemaTypeInteger},
+			Default: v1.NewStructuredValues("3"),
+		}},
+	}, {
+		name: "default referencing a variable is not validated - success",
+		params: []v1.ParamSpec{{
+			Name:    "param1",
+			Type:    v1.ParamTypeString,
+			Schema:  &v1.JSONSchema{Type: v1.JSONSchemaTypeInteger},
+			Default: v1.NewStructuredValues("$(context.taskRun.name)"),
+		}},
+	}, {
+		name: "array param with schema - success",
+		params: []v1.ParamSpec{{
+			Name:   "param1",
+			Type:   v1.ParamTypeArray,
+			Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeArray, Items: &v1.JSONSchema{MinLength: &minLength}},
+		}},
+	}}
+
+	for _, tc := range tcs {
+		ctx := cfgtesting.EnableAlphaAPIFields(t.Context())
+
+		err := v1.ValidateParameterVariables(ctx, []v1.Step{{Image: "foo"}}, tc.params)
+		if err != nil {
+			t.Errorf("No error expected from ValidateParameterVariables() but got = %v", err)
+		}
+	}
+}
+
+func TestParamSchema_Failure(t *testing.T) {
+	tcs := []struct {
+		name        string
+		params      v1.ParamSpecs
+		alpha       bool
+		expectedErr error
+	}{{
+		name: "param default value does not match the schema - failure",
+		params: []v1.ParamSpec{{
+			Name:    "param1",
+			Type:    v1.ParamTypeString,
+			Schema:  &v1.JSONSchema{Type: v1.JSONSchemaTypeInteger},
+			Default: v1.NewStructuredValues("three"),
+		}},
+		alpha:       true,
+		expectedErr: errors.New(`param default value does not match the schema: "three" is not a valid integer: params[param1]`),
+	}, {
+		name: "param schema type does not match the param type - failure",
+		params: []v1.ParamSpec{{
+			Name:   "param1",
+			Type:   v1.ParamTypeObject,
+			Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeArray},
+		}},
+		alpha:       true,
+		expectedErr: errors.New("invalid value: array: params[param1].schema.type\nthe schema of an object must be of type object"),
+	}, {
+		name: "param schema without alpha feature gate - failure",
+		params: []v1.ParamSpec{{
+			Name:   "param1",
+			Type:   v1.ParamTypeString,
+			Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString},
+		}},
+		expectedErr: errors.New(`param schema requires "enable-api-fields" feature gate to be "alpha" but it is "beta": `),
+	}}
+
+	for _, tc := range tcs {
+		ctx := t.Context()
+		if tc.alpha {
+			ctx = cfgtesting.EnableAlphaAPIFields(ctx)
+		}
+
+		err := v1.ValidateParameterVariables(ctx, []v1.Step{{Image: "foo"}}, tc.params)
+
+		if err == nil {
+			t.Errorf("Expected an error from ValidateParameterVariables() but got none")
+		} else if d := cmp.Diff(tc.expectedErr.Error(), err.Error()); d != "" {
+			t.Errorf("Returned error from ValidateParameterVariables() does not match with the expected error: %s", diff.PrintWantGot(d))
+		}
+	}
+}
+
+func TestSensitiveParams_Failure(t *testing.T) {
+	tcs := []struct {
+		name        string
+		params      v1.ParamSpecs
+		alpha       bool
+		expectedErr error
+	}{{
+		name: "sensitive param with array type - failure",
+		params: []v1.ParamSpec{{
+			Name:      "token",
+			Type:      v1.ParamTypeArray,
+			Sensitive: true,
+		}},
+		alpha:       true,
+		expectedErr: errors.New("sensitive can only be set with string type param: params[token]"),
+	}, {
+		name: "sensitive param with default value - failure",
+		params: []v1.ParamSpec{{
+			Name:      "token",
+			Type:      v1.ParamTypeString,
+			Sensitive: true,
+			Default:   v1.NewStructuredValues("s3cr3t"),
+		}},
+		alpha:       true,
+		expectedErr: errors.New("must not set the field(s): params[token].default"),
+	}, {
+		name: "sensitive param without alpha feature gate - failure",
+		params: []v1.ParamSpec{{
+			Name:      "token",
+			Type:      v1.ParamTypeString,
+			Sensitive: true,
+		}},
+		expectedErr: errors.New(`sensitive params requires "enable-api-fields" feature gate to be "alpha" but it is "beta": `),
+	}}
+
+	for _, tc := range tcs {
+		ctx := t.Context()
+		if tc.alpha {
+			ctx = cfgtesting.EnableAlphaAPIFields(ctx)
+		}
+
+		err := v1.ValidateParameterVariables(ctx, []v1.Step{{Image: "foo"}}, tc.params)
+
+		if err == nil {
+			t.Errorf("Expected an error from ValidateParameterVariables() but got none")
+		} else if d := cmp.Diff(tc.expectedErr.Error(), err.Error()); d != "" {
+			t.Errorf("Returned error from ValidateParameterVariables() does not match with the expected error: %s", diff.PrintWantGot(d))
+		}
+	}
+}
+
 func TestTaskSpecValidate_StepResults(t *testing.T) {
 	type fields struct {
 		Image   string
@@ -2298,3 +2440,77 @@ func TestTaskSpecValidate_StepWhen_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskSpecValidate_StepParallelGroup(t *testing.T) {
+	ts := &v1.TaskSpec{
+		Steps: []v1.Step{{
+			Name:          "lint",
+			Image:         "my-image",
+			ParallelGroup: "checks",
+		}, {
+			Name:          "test",
+			Image:         "my-image",
+			ParallelGroup: "checks",
+		}, {
+			Name:  "build",
+			Image: "my-image",
+		}},
+	}
+	ctx := cfgtesting.EnableAlphaAPIFields(t.Context())
+	ts.SetDefaults(ctx)
+	if err := ts.Validate(ctx); err != nil {
+		t.Errorf("TaskSpec.Validate() = %v", err)
+	}
+}
+
+func TestTaskSpecValidate_StepParallelGroup_Error(t *testing.T) {
+	tests := []struct {
+		name          string
+		steps         []v1.Step
+		alpha         bool
+		expectedError apis.FieldError
+	}{{
+		name: "parallel group requires alpha",
+		steps: []v1.Step{{
+			Name:          "lint",
+			Image:         "my-image",
+			ParallelGroup: "checks",
+		}},
+		expectedError: apis.FieldError{
+			Message: `parallelGroup requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`,
+		},
+	}, {
+		name: "steps of a parallel group are not consecutive",
+		steps: []v1.Step{{
+			Name:          "lint",
+			Image:         "my-image",
+			ParallelGroup: "checks",
+		}, {
+			Name:  "build",
+			Image: "my-image",
+		}, {
+			Name:          "test",
+			Image:         "my-image",
+			ParallelGroup: "checks",
+		}},
+		alpha: true,
+		expectedError: apis.FieldError{
+			Message: `the Steps of parallel group "checks" must be consecutive`,
+			Paths:   []string{"steps[2].parallelGroup"},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &v1.TaskSpec{Steps: tt.steps}
+			ctx := t.Context()
+			if tt.alpha {
+				ctx = cfgtesting.EnableAlphaAPIFields(ctx)
+			}
+			ts.SetDefaults(ctx)
+			err := ts.Validate(ctx)
+			if d := cmp.Diff(tt.expectedError.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
+				t.Errorf("TaskSpec.Validate() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// TaskExtends references the base Task extended by a Task. The steps of the Task replace the steps
+// of the base Task with the same name, and the other steps are appended to the steps of the base
+// Task unless they are inserted next to one of its steps.
+type TaskExtends struct {
+	// TaskRef is a reference to the base Task, either by name or through a resolver
+	TaskRef `json:",inline"`
+	// InsertSteps inserts steps of the Task next to steps of the base Task,
+	// instead of appending them
+	// +optional
+	// +listType=atomic
+	InsertSteps []StepInsertion `json:"insertSteps,omitempty"`
+}
+
+// StepInsertion inserts a step of a Task next to a step of the base Task it extends
+type StepInsertion struct {
+	// Step is the name of the inserted step of the Task
+	Step string `json:"step"`
+	// Before is the name of the step before which the step is inserted
+	// +optional
+	Before string `json:"before,omitempty"`
+	// After is the name of the step after which the step is inserted
+	// +optional
+	After string `json:"after,omitempty"`
+}
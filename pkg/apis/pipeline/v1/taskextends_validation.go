@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
+)
+
+// validateExtends validates a TaskSpec extending a base Task: the base Task must be referenced,
+// and the inserted steps must be steps of the TaskSpec inserted either before or after another step.
+func (ts *TaskSpec) validateExtends(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "extends", config.AlphaAPIFields))
+	errs = errs.Also(ts.Extends.TaskRef.Validate(ctx).ViaField("extends"))
+	steps := sets.NewString()
+	for _, step := range ts.Steps {
+		steps.Insert(step.Name)
+	}
+	inserted := sets.NewString()
+	for i, insertion := range ts.Extends.InsertSteps {
+		switch {
+		case insertion.Step == "":
+			errs = errs.Also(apis.ErrMissingField("step").ViaFieldIndex("insertSteps", i).ViaField("extends"))
+		case inserted.Has(insertion.Step):
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("step %q appears more than once", insertion.Step), "step").ViaFieldIndex("insertSteps", i).ViaField("extends"))
+		case !steps.Has(insertion.Step):
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("step %q is not a step of the Task", insertion.Step), "step").ViaFieldIndex("insertSteps", i).ViaField("extends"))
+		}
+		inserted.Insert(insertion.Step)
+		if (insertion.Before == "") == (insertion.After == "") {
+			errs = errs.Also(apis.ErrMissingOneOf("before", "after").ViaFieldIndex("insertSteps", i).ViaField("extends"))
+		}
+	}
+	errs = errs.Also(ValidateVolumes(ts.Volumes).ViaField("volumes"))
+	errs = errs.Also(validateResults(ctx, ts.Results).ViaField("results"))
+	return errs
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
+	"github.com/tektoncd/pipeline/test/diff"
+	"knative.dev/pkg/apis"
+)
+
+func TestTaskSpec_ValidateExtends(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		ts      *TaskSpec
+		wantErr *apis.FieldError
+		wc      func(context.Context) context.Context
+	}{{
+		name: "partial steps using the params of the base task",
+		ts: &TaskSpec{
+			Extends: &TaskExtends{
+				TaskRef:     TaskRef{Name: "build"},
+				InsertSteps: []StepInsertion{{Step: "lint", Before: "compile"}},
+			},
+			Params: ParamSpecs{{Name: "go-version", Default: NewStructuredValues("1.24")}},
+			Steps: []Step{{
+				Name:   "compile",
+				Script: "go build -o $(params.output) ./...",
+			}, {
+				Name:   "lint",
+				Image:  "golangci/golangci-lint",
+				Script: "golangci-lint run",
+			}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "base task through a resolver",
+		ts: &TaskSpec{
+			Extends: &TaskExtends{TaskRef: TaskRef{ResolverRef: ResolverRef{Resolver: "git"}}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "extends requires alpha api fields",
+		ts: &TaskSpec{
+			Extends: &TaskExtends{TaskRef: TaskRef{Name: "build"}},
+		},
+		wantErr: apis.ErrGeneric(`extends requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
+	}, {
+		name: "invalid extends",
+		ts: &TaskSpec{
+			Extends: &TaskExtends{
+				InsertSteps: []StepInsertion{
+					{Before: "compile"},
+					{Step: "lint", Before: "compile"},
+					{Step: "lint", After: "compile"},
+					{Step: "test", Before: "compile", After: "compile"},
+					{Step: "vet"},
+				},
+			},
+			Steps: []Step{{Name: "lint"}, {Name: "vet"}},
+		},
+		wantErr: apis.ErrMissingField("extends.name").Also(
+			apis.ErrMissingField("extends.insertSteps[0].step")).Also(
+			apis.ErrInvalidValue(`step "lint" appears more than once`, "extends.insertSteps[2].step")).Also(
+			apis.ErrInvalidValue(`step "test" is not a step of the Task`, "extends.insertSteps[3].step")).Also(
+			apis.ErrMissingOneOf("extends.insertSteps[3].before", "extends.insertSteps[3].after")).Also(
+			apis.ErrMissingOneOf("extends.insertSteps[4].before", "extends.insertSteps[4].after")),
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.wc != nil {
+				ctx = tc.wc(ctx)
+			}
+			err := tc.ts.Validate(ctx)
+			if d := cmp.Diff(tc.wantErr.Error(), err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
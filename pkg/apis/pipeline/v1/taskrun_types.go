@@ -59,10 +59,20 @@ type TaskRunSpec struct {
 	// Retries represents how many times this TaskRun should be retried in the event of task failure.
 	// +optional
 	Retries int `json:"retries,omitempty"`
+	// RetryPolicy configures the delay between retries and which failures are retried.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 	// Time after which one retry attempt times out. Defaults to 1 hour.
 	// Refer Go's ParseDuration documentation for expected format: https://golang.org/pkg/time/#ParseDuration
 	// +optional
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Timeouts bounds the phases of the TaskRun more granularly than Timeout.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	Timeouts *TaskRunTimeouts `json:"timeouts,omitempty"`
 	// PodTemplate holds pod specific configuration
 	PodTemplate *pod.PodTemplate `json:"podTemplate,omitempty"`
 	// Workspaces is a list of WorkspaceBindings from volumes to workspaces.
@@ -93,6 +103,16 @@ type TaskRunSpec struct {
 	ManagedBy *string `json:"managedBy,omitempty"`
 }
 
+// TaskRunTimeouts allows more granular timeouts of the TaskRun than Timeout.
+type TaskRunTimeouts struct {
+	// Queue sets the maximum allowed duration between the start of the TaskRun and the start
+	// of its first Step, while its Pod is pending, unschedulable or waiting for quota. When set,
+	// Timeout counts down from the start of the first Step instead of the start of the TaskRun.
+	// A queue timeout of 0 does not bound the time the TaskRun waits.
+	// +optional
+	Queue *metav1.Duration `json:"queue,omitempty"`
+}
+
 // TaskRunSpecStatus defines the TaskRun spec status the user can provide
 type TaskRunSpecStatus string
 
@@ -207,6 +227,8 @@ const (
 	TaskRunReasonCancelled TaskRunReason = "TaskRunCancelled"
 	// TaskRunReasonTimedOut is the reason set when one TaskRun execution has timed out
 	TaskRunReasonTimedOut TaskRunReason = "TaskRunTimeout"
+	// TaskRunReasonQueueTimedOut is the reason set when the first Step of the TaskRun did not start within its queue timeout
+	TaskRunReasonQueueTimedOut TaskRunReason = "TaskRunQueueTimeout"
 	// TaskRunReasonResolvingTaskRef indicates that the TaskRun is waiting for
 	// its taskRef to be asynchronously resolved.
 	TaskRunReasonResolvingTaskRef = "ResolvingTaskRef"
@@ -321,6 +343,11 @@ type TaskRunStatusFields struct {
 	// StartTime is the time the build is actually started.
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
+	// ExecutionStartTime is the time the first Step of the TaskRun started, once its Pod was
+	// scheduled and its init containers completed.
+	// +optional
+	ExecutionStartTime *metav1.Time `json:"executionStartTime,omitempty"`
+
 	// CompletionTime is the time the build completed.
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
@@ -359,6 +386,11 @@ type TaskRunStatusFields struct {
 
 	// SpanContext contains tracing span context fields
 	SpanContext map[string]string `json:"spanContext,omitempty"`
+
+	// WorkspaceCaches reports how the cache workspaces of the TaskRun were restored.
+	// +optional
+	// +listType=atomic
+	WorkspaceCaches []WorkspaceCacheStatus `json:"workspaceCaches,omitempty"`
 }
 
 // TaskRunStepSpec is used to override the values of a Step in the corresponding Task.
@@ -429,6 +461,11 @@ type StepState struct {
 	TerminationReason     string                `json:"terminationReason,omitempty"`
 	Inputs                []TaskRunStepArtifact `json:"inputs,omitempty"`
 	Outputs               []TaskRunStepArtifact `json:"outputs,omitempty"`
+	// Attempts is the number of times the Step ran, when the Step has retries.
+	Attempts int32 `json:"attempts,omitempty"`
+	// AttemptExitCodes are the exit codes of the attempts of the Step, when the Step has retries.
+	// +listType=atomic
+	AttemptExitCodes []int32 `json:"attemptExitCodes,omitempty"`
 }
 
 // SidecarState reports the results of running a sidecar in a Task.
@@ -525,14 +562,16 @@ func (tr *TaskRun) IsPending() bool {
 	return tr.Spec.Status == TaskRunSpecStatusPending
 }
 
-// IsRetriable returns true if the TaskRun's Retries is not exhausted.
+// IsRetriable returns true if the TaskRun's Retries is not exhausted and
+// the failure matches the TaskRun's RetryPolicy.
 func (tr *TaskRun) IsRetriable() bool {
-	return len(tr.Status.RetriesStatus) < tr.Spec.Retries
+	return len(tr.Status.RetriesStatus) < tr.Spec.Retries && tr.Spec.RetryPolicy.ShouldRetry(&tr.Status)
 }
 
 // HasTimedOut returns true if the TaskRun runtime is beyond the allowed timeout
 func (tr *TaskRun) HasTimedOut(ctx context.Context, c clock.PassiveClock) bool {
-	if tr.Status.StartTime.IsZero() {
+	startTime := tr.TimeoutStartTime()
+	if startTime.IsZero() {
 		return false
 	}
 	timeout := tr.GetTimeout(ctx)
@@ -540,10 +579,32 @@ func (tr *TaskRun) HasTimedOut(ctx context.Context, c clock.PassiveClock) bool {
 	if timeout == apisconfig.NoTimeoutDuration {
 		return false
 	}
-	runtime := c.Since(tr.Status.StartTime.Time)
+	runtime := c.Since(startTime.Time)
 	return runtime > timeout
 }
 
+// TimeoutStartTime returns the time the timeout of the TaskRun counts down from: the time its first
+// Step started when it has a queue timeout, and the time it started otherwise.
+func (tr *TaskRun) TimeoutStartTime() *metav1.Time {
+	if tr.Spec.Timeouts != nil && tr.Spec.Timeouts.Queue != nil {
+		return tr.Status.ExecutionStartTime
+	}
+	return tr.Status.StartTime
+}
+
+// IsQueued returns true if the TaskRun has a queue timeout, started, and is waiting for its first Step to start.
+func (tr *TaskRun) IsQueued() bool {
+	return tr.Spec.Timeouts != nil && tr.Spec.Timeouts.Queue != nil && !tr.Status.StartTime.IsZero() && tr.Status.ExecutionStartTime == nil
+}
+
+// HasQueueTimedOut returns true if the TaskRun waited for its first Step to start longer than its queue timeout
+func (tr *TaskRun) HasQueueTimedOut(c clock.PassiveClock) bool {
+	if !tr.IsQueued() || tr.Spec.Timeouts.Queue.Duration == apisconfig.NoTimeoutDuration {
+		return false
+	}
+	return c.Since(tr.Status.StartTime.Time) > tr.Spec.Timeouts.Queue.Duration
+}
+
 // GetTimeout returns the timeout for the TaskRun, or the default if not specified
 func (tr *TaskRun) GetTimeout(ctx context.Context) time.Duration {
 	// Use the platform default is no timeout is set
@@ -374,6 +374,68 @@ func TestHasTimedOut(t *testing.T) {
 	}
 }
 
+func TestTaskRunHasQueueTimedOut(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		queue              *metav1.Duration
+		executionStartTime *metav1.Time
+		expectedQueued     bool
+		expectedQueueTO    bool
+		expectedTimedOut   bool
+	}{{
+		name:             "no queue timeout",
+		expectedTimedOut: true,
+	}, {
+		name:            "queue timeout exceeded",
+		queue:           &metav1.Duration{Duration: 10 * time.Minute},
+		expectedQueued:  true,
+		expectedQueueTO: true,
+	}, {
+		name:           "queue timeout not exceeded",
+		queue:          &metav1.Duration{Duration: 30 * time.Minute},
+		expectedQueued: true,
+	}, {
+		name:           "no limit on queue time",
+		queue:          &metav1.Duration{Duration: 0},
+		expectedQueued: true,
+	}, {
+		name:               "started executing within the queue timeout",
+		queue:              &metav1.Duration{Duration: 10 * time.Minute},
+		executionStartTime: &metav1.Time{Time: now.Add(-5 * time.Minute)},
+	}, {
+		name:               "execution timeout counts from the execution start time",
+		queue:              &metav1.Duration{Duration: 10 * time.Minute},
+		executionStartTime: &metav1.Time{Time: now.Add(-11 * time.Minute)},
+		expectedTimedOut:   true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			tr := &v1.TaskRun{
+				Spec: v1.TaskRunSpec{
+					Timeout: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+				Status: v1.TaskRunStatus{
+					TaskRunStatusFields: v1.TaskRunStatusFields{
+						StartTime:          &metav1.Time{Time: now.Add(-15 * time.Minute)},
+						ExecutionStartTime: tc.executionStartTime,
+					},
+				},
+			}
+			if tc.queue != nil {
+				tr.Spec.Timeouts = &v1.TaskRunTimeouts{Queue: tc.queue}
+			}
+			if got := tr.IsQueued(); got != tc.expectedQueued {
+				t.Errorf("Expected IsQueued to be %t, got %t", tc.expectedQueued, got)
+			}
+			if got := tr.HasQueueTimedOut(testClock); got != tc.expectedQueueTO {
+				t.Errorf("Expected HasQueueTimedOut to be %t, got %t", tc.expectedQueueTO, got)
+			}
+			if got := tr.HasTimedOut(t.Context(), testClock); got != tc.expectedTimedOut {
+				t.Errorf("Expected HasTimedOut to be %t, got %t", tc.expectedTimedOut, got)
+			}
+		})
+	}
+}
+
 func TestInitializeTaskRunConditions(t *testing.T) {
 	tr := &v1.TaskRun{
 		ObjectMeta: metav1.ObjectMeta{
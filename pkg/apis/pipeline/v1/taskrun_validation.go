@@ -114,6 +114,7 @@ func (ts *TaskRunSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	if ts.Retries < 0 {
 		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 0", ts.Retries), "retries"))
 	}
+	errs = errs.Also(ts.RetryPolicy.Validate(ctx).ViaField("retryPolicy"))
 
 	if ts.PodTemplate != nil {
 		errs = errs.Also(validatePodTemplateEnv(ctx, *ts.PodTemplate))
@@ -123,6 +124,13 @@ func (ts *TaskRunSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 		errs = errs.Also(apis.ErrInvalidValue(ts.Timeout.Duration.String()+" should be >= 0", "timeout"))
 	}
 
+	if ts.Timeouts != nil && ts.Timeouts.Queue != nil {
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "timeouts.queue", config.AlphaAPIFields))
+		if ts.Timeouts.Queue.Duration < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(ts.Timeouts.Queue.Duration.String()+" should be >= 0", "timeouts.queue"))
+		}
+	}
+
 	return errs
 }
 
@@ -166,6 +174,8 @@ func (ts *TaskRunSpec) ValidateUpdate(ctx context.Context) (errs *apis.FieldErro
 	old.Status = ts.Status
 	old.StatusMessage = ts.StatusMessage
 	old.ManagedBy = ts.ManagedBy // Already tested before
+	// The controller moves the values of sensitive params to a Secret once the Task is resolved
+	old.Params, _ = old.Params.ReplaceMovedSensitiveParams(ts.Params)
 	if !equality.Semantic.DeepEqual(old, ts) {
 		errs = errs.Also(apis.ErrInvalidValue("Once the TaskRun has started, only status and statusMessage updates are allowed", ""))
 	}
@@ -310,8 +320,11 @@ func ValidateWorkspaceBindings(ctx context.Context, wb []WorkspaceBinding) (errs
 // ValidateParameters makes sure the params for the Task are valid.
 func ValidateParameters(ctx context.Context, params Params) (errs *apis.FieldError) {
 	var names []string
-	for _, p := range params {
+	for i, p := range params {
 		names = append(names, p.Name)
+		if p.ValueFrom != nil {
+			errs = errs.Also(p.ValueFrom.Validate(ctx).ViaField("valueFrom").ViaIndex(i))
+		}
 	}
 	return errs.Also(validateNoDuplicateNames(names, false))
 }
@@ -547,6 +547,34 @@ func TestTaskRunSpec_Invalidate(t *testing.T) {
 		name:    "invalid taskspec",
 		spec:    v1.TaskRunSpec{},
 		wantErr: apis.ErrMissingOneOf("taskRef", "taskSpec"),
+	}, {
+		name: "param valueFrom without secret key",
+		spec: v1.TaskRunSpec{
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{{
+				Name:  "token",
+				Value: *v1.NewStructuredValues(v1.SensitiveParamRedactedValue),
+				ValueFrom: &v1.ParamValueSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+				}},
+			}},
+		},
+		wc:      cfgtesting.EnableAlphaAPIFields,
+		wantErr: apis.ErrMissingField("params[0].valueFrom.secretKeyRef.key"),
+	}, {
+		name: "param valueFrom without alpha feature gate",
+		spec: v1.TaskRunSpec{
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{{
+				Name:  "token",
+				Value: *v1.NewStructuredValues(v1.SensitiveParamRedactedValue),
+				ValueFrom: &v1.ParamValueSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+					Key:                  "token",
+				}},
+			}},
+		},
+		wantErr: apis.ErrGeneric(`param valueFrom requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`),
 	}, {
 		name: "PodTmplate with forbidden env",
 		spec: v1.TaskRunSpec{
@@ -624,6 +652,29 @@ func TestTaskRunSpec_Invalidate(t *testing.T) {
 			Timeout: &metav1.Duration{Duration: -48 * time.Hour},
 		},
 		wantErr: apis.ErrInvalidValue("-48h0m0s should be >= 0", "timeout"),
+	}, {
+		name: "negative queue timeout",
+		spec: v1.TaskRunSpec{
+			TaskRef: &v1.TaskRef{
+				Name: "taskrefname",
+			},
+			Timeouts: &v1.TaskRunTimeouts{
+				Queue: &metav1.Duration{Duration: -5 * time.Minute},
+			},
+		},
+		wc:      cfgtesting.EnableAlphaAPIFields,
+		wantErr: apis.ErrInvalidValue("-5m0s should be >= 0", "timeouts.queue"),
+	}, {
+		name: "queue timeout without alpha feature gate",
+		spec: v1.TaskRunSpec{
+			TaskRef: &v1.TaskRef{
+				Name: "taskrefname",
+			},
+			Timeouts: &v1.TaskRunTimeouts{
+				Queue: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+		},
+		wantErr: apis.ErrGeneric("timeouts.queue requires \"enable-api-fields\" feature gate to be \"alpha\" but it is \"beta\""),
 	}, {
 		name: "negative pipeline retries",
 		spec: v1.TaskRunSpec{
@@ -921,6 +972,20 @@ func TestTaskRunSpec_Validate(t *testing.T) {
 				}},
 			},
 		},
+	}, {
+		name: "queue timeout",
+		spec: v1.TaskRunSpec{
+			Timeouts: &v1.TaskRunTimeouts{
+				Queue: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			TaskSpec: &v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name:  "mystep",
+					Image: "myimage",
+				}},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
 	}, {
 		name: "no timeout",
 		spec: v1.TaskRunSpec{
@@ -1111,6 +1176,60 @@ func TestTaskRunSpec_ValidateUpdate(t *testing.T) {
 				Message: `invalid value: Once the TaskRun has started, only status and statusMessage updates are allowed`,
 				Paths:   []string{""},
 			},
+		}, {
+			name: "is update ctx, baseline is unknown, sensitive param moved to a Secret",
+			baselineTaskRun: &v1.TaskRun{
+				Spec: v1.TaskRunSpec{
+					Params: v1.Params{{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")}},
+				},
+				Status: v1.TaskRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+				},
+			},
+			taskRun: &v1.TaskRun{
+				Spec: v1.TaskRunSpec{
+					Params: v1.Params{{
+						Name:  "token",
+						Value: *v1.NewStructuredValues(v1.SensitiveParamRedactedValue),
+						ValueFrom: &v1.ParamValueSource{SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "tr-sensitive-params"},
+							Key:                  "token",
+						}},
+					}},
+				},
+			},
+			isCreate:      false,
+			isUpdate:      true,
+			expectedError: apis.FieldError{},
+		}, {
+			name: "is update ctx, baseline is unknown, param value changes",
+			baselineTaskRun: &v1.TaskRun{
+				Spec: v1.TaskRunSpec{
+					Params: v1.Params{{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")}},
+				},
+				Status: v1.TaskRunStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown},
+						},
+					},
+				},
+			},
+			taskRun: &v1.TaskRun{
+				Spec: v1.TaskRunSpec{
+					Params: v1.Params{{Name: "token", Value: *v1.NewStructuredValues("other")}},
+				},
+			},
+			isCreate: false,
+			isUpdate: true,
+			expectedError: apis.FieldError{
+				Message: `invalid value: Once the TaskRun has started, only status and statusMessage updates are allowed`,
+				Paths:   []string{""},
+			},
 		}, {
 			name: "is update ctx, baseline is done, status changes",
 			baselineTaskRun: &v1.TaskRun{
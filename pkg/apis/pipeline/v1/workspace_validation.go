@@ -18,11 +18,23 @@ package v1
 
 import (
 	"context"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"knative.dev/pkg/apis"
 )
 
+// CacheHashVariable is replaced in the key of a cache workspace by the digest of the files matching its HashFiles.
+const CacheHashVariable = "$(hash)"
+
+// cacheKeyRegex matches the characters allowed in the keys of cache workspaces.
+var cacheKeyRegex = regexp.MustCompile(`^[A-Za-z0-9._-]*$`)
+
 // allVolumeSourceFields is a list of all the volume source field paths that a
 // WorkspaceBinding may include.
 var allVolumeSourceFields = []string{
@@ -80,6 +92,57 @@ func (b *WorkspaceBinding) Validate(ctx context.Context) (errs *apis.FieldError)
 		}
 	}
 
+	// Ephemeral volumes are an alpha feature, and need the template of the claim to provision.
+	if b.Ephemeral != nil {
+		if err := config.ValidateEnabledAPIFields(ctx, "ephemeral workspace", config.AlphaAPIFields).ViaField("ephemeral"); err != nil {
+			return err
+		}
+		if b.Ephemeral.VolumeClaimTemplate == nil {
+			return apis.ErrMissingField("ephemeral.volumeClaimTemplate")
+		}
+	}
+
+	// Image volumes are an alpha feature, and need the reference of the image to mount.
+	if b.Image != nil {
+		if err := config.ValidateEnabledAPIFields(ctx, "image workspace", config.AlphaAPIFields).ViaField("image"); err != nil {
+			return err
+		}
+		if b.Image.Reference == "" {
+			return apis.ErrMissingField("image.reference")
+		}
+		switch b.Image.PullPolicy {
+		case "", corev1.PullAlways, corev1.PullNever, corev1.PullIfNotPresent:
+		default:
+			return apis.ErrInvalidValue(b.Image.PullPolicy, "image.pullPolicy")
+		}
+	}
+
+	// Retaining the claim created from volumeClaimTemplate once the run completes is an alpha feature.
+	if b.RetentionPolicy != nil {
+		if err := config.ValidateEnabledAPIFields(ctx, "volumeClaimTemplate retention policy", config.AlphaAPIFields).ViaField("retentionPolicy"); err != nil {
+			return err
+		}
+		if b.VolumeClaimTemplate == nil {
+			return apis.ErrGeneric("retentionPolicy can only be set with volumeClaimTemplate", "retentionPolicy")
+		}
+		if err := b.RetentionPolicy.validate(); err != nil {
+			return err.ViaField("retentionPolicy")
+		}
+	}
+
+	// Moving the content of a workspace through object storage is an alpha feature.
+	if b.Transfer != nil {
+		return config.ValidateEnabledAPIFields(ctx, "transfer workspace", config.AlphaAPIFields).ViaField("transfer")
+	}
+
+	// Restoring and saving the content of a workspace across TaskRuns is an alpha feature.
+	if b.Cache != nil {
+		if err := config.ValidateEnabledAPIFields(ctx, "cache workspace", config.AlphaAPIFields).ViaField("cache"); err != nil {
+			return err
+		}
+		return b.Cache.validate().ViaField("cache")
+	}
+
 	return nil
 }
 
@@ -108,5 +171,64 @@ func (b *WorkspaceBinding) numSources() int {
 	if b.CSI != nil {
 		n++
 	}
+	if b.Ephemeral != nil {
+		n++
+	}
+	if b.Image != nil {
+		n++
+	}
+	if b.Transfer != nil {
+		n++
+	}
+	if b.Cache != nil {
+		n++
+	}
 	return n
 }
+
+// validate checks that the keys of the cache can be used as the names of objects in the bucket,
+// and that the files hashed into the key are in other workspaces.
+func (c *WorkspaceCache) validate() (errs *apis.FieldError) {
+	if c.Key == "" {
+		return apis.ErrMissingField("key")
+	}
+	if !cacheKeyRegex.MatchString(strings.ReplaceAll(c.Key, CacheHashVariable, "")) {
+		errs = errs.Also(apis.ErrInvalidValue(c.Key+" should only contain alphanumeric characters, '.', '_', '-' and \"$(hash)\"", "key"))
+	}
+	if hashed := strings.Contains(c.Key, CacheHashVariable); hashed && len(c.HashFiles) == 0 {
+		errs = errs.Also(apis.ErrMissingField("hashFiles"))
+	} else if !hashed && len(c.HashFiles) > 0 {
+		errs = errs.Also(apis.ErrGeneric("hashFiles can only be set when the key contains \"$(hash)\"", "hashFiles"))
+	}
+	for i, f := range c.HashFiles {
+		workspace, pattern, _ := strings.Cut(f, "/")
+		if _, err := path.Match(pattern, ""); workspace == "" || pattern == "" || err != nil || slices.Contains(strings.Split(pattern, "/"), "..") {
+			errs = errs.Also(apis.ErrInvalidArrayValue(f+" should be <workspace>/<pattern>", "hashFiles", i))
+		}
+	}
+	for i, k := range c.RestoreKeys {
+		if k == "" || !cacheKeyRegex.MatchString(k) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(k+" should only contain alphanumeric characters, '.', '_' and '-'", "restoreKeys", i))
+		}
+	}
+	return errs
+}
+
+// validate checks that the policy is known, and that only retained claims have a TTL.
+func (p *VolumeClaimRetentionPolicy) validate() *apis.FieldError {
+	switch p.Policy {
+	case VolumeClaimRetentionDelete:
+		if p.TTL != nil {
+			return apis.ErrGeneric("ttl can only be set when the claim is retained", "ttl")
+		}
+	case VolumeClaimRetentionRetainOnFailure, VolumeClaimRetentionRetain:
+		if p.TTL != nil && p.TTL.Duration <= 0 {
+			return apis.ErrInvalidValue(p.TTL.Duration.String()+" should be greater than 0", "ttl")
+		}
+	case "":
+		return apis.ErrMissingField("policy")
+	default:
+		return apis.ErrInvalidValue(p.Policy, "policy")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Defaultable = (*ApprovalRequest)(nil)
+
+// SetDefaults implements apis.Defaultable
+func (ar *ApprovalRequest) SetDefaults(ctx context.Context) {
+	ar.Spec.SetDefaults(ctx)
+}
+
+// SetDefaults sets the type of the approvers to User when it is not set
+func (ars *ApprovalRequestSpec) SetDefaults(ctx context.Context) {
+	for i := range ars.Approvers {
+		if ars.Approvers[i].Type == "" {
+			ars.Approvers[i].Type = ApproverTypeUser
+		}
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ApprovalRequest blocks the CustomRun of a PipelineTask until enough approvers approve it,
+// or until one of them rejects it. The Tekton controller creates an ApprovalRequest for each
+// CustomRun referencing the ApprovalRequest kind, and approvers add their response to it.
+//
+// +k8s:openapi-gen=true
+type ApprovalRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec holds the approvers of the ApprovalRequest and their responses.
+	Spec ApprovalRequestSpec `json:"spec"`
+
+	// Status holds the decision on the ApprovalRequest.
+	// +optional
+	Status ApprovalRequestStatus `json:"status,omitempty"`
+}
+
+var _ kmeta.OwnerRefable = (*ApprovalRequest)(nil)
+
+// GetGroupVersionKind implements kmeta.OwnerRefable.
+func (*ApprovalRequest) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ApprovalRequest")
+}
+
+// ApprovalRequestList contains a list of ApprovalRequests
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ApprovalRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApprovalRequest `json:"items"`
+}
+
+// ApprovalRequestSpec defines who can approve an ApprovalRequest, and holds their responses.
+type ApprovalRequestSpec struct {
+	// Description is shown to the approvers.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Approvers are the users and groups allowed to respond to the ApprovalRequest.
+	// +listType=atomic
+	Approvers []Approver `json:"approvers"`
+	// NumberOfApprovalsRequired is the number of users who must approve the ApprovalRequest.
+	// Defaults to 1.
+	// +optional
+	NumberOfApprovalsRequired int `json:"numberOfApprovalsRequired,omitempty"`
+	// Responses are the responses of the approvers. Each approver can only add or change their own.
+	// +optional
+	// +listType=atomic
+	Responses []ApprovalResponse `json:"responses,omitempty"`
+}
+
+// ApproverType is the type of an Approver.
+type ApproverType string
+
+const (
+	// ApproverTypeUser is the type of an Approver naming a user.
+	ApproverTypeUser ApproverType = "User"
+	// ApproverTypeGroup is the type of an Approver naming a group, whose members can all respond.
+	ApproverTypeGroup ApproverType = "Group"
+)
+
+// Approver is a user or a group allowed to respond to an ApprovalRequest.
+type Approver struct {
+	// Name is the name of the user or of the group.
+	Name string `json:"name"`
+	// Type is either User or Group. Defaults to User.
+	// +optional
+	Type ApproverType `json:"type,omitempty"`
+}
+
+// ApprovalDecision is the decision of an approver.
+type ApprovalDecision string
+
+const (
+	// ApprovalDecisionApprove approves an ApprovalRequest.
+	ApprovalDecisionApprove ApprovalDecision = "approve"
+	// ApprovalDecisionReject rejects an ApprovalRequest.
+	ApprovalDecisionReject ApprovalDecision = "reject"
+)
+
+// ApprovalResponse is the response of a user to an ApprovalRequest.
+type ApprovalResponse struct {
+	// Name is the name of the user responding.
+	Name string `json:"name"`
+	// Decision is either approve or reject.
+	Decision ApprovalDecision `json:"decision"`
+	// Comment explains the decision.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// ApprovalState is the state of an ApprovalRequest.
+type ApprovalState string
+
+const (
+	// ApprovalStatePending is the state of an ApprovalRequest waiting for responses.
+	ApprovalStatePending ApprovalState = "pending"
+	// ApprovalStateApproved is the state of an ApprovalRequest approved by enough users.
+	ApprovalStateApproved ApprovalState = "approved"
+	// ApprovalStateRejected is the state of an ApprovalRequest rejected by a user.
+	ApprovalStateRejected ApprovalState = "rejected"
+	// ApprovalStateTimedOut is the state of an ApprovalRequest which did not get enough responses in time.
+	ApprovalStateTimedOut ApprovalState = "timedout"
+	// ApprovalStateCancelled is the state of an ApprovalRequest whose CustomRun was cancelled.
+	ApprovalStateCancelled ApprovalState = "cancelled"
+)
+
+// ApprovalRequestStatus holds the decision on an ApprovalRequest.
+type ApprovalRequestStatus struct {
+	// State is pending until the ApprovalRequest is approved, rejected, timed out or cancelled.
+	// +optional
+	State ApprovalState `json:"state,omitempty"`
+	// Approvers are the users who approved the ApprovalRequest.
+	// +optional
+	// +listType=atomic
+	Approvers []string `json:"approvers,omitempty"`
+	// Rejecters are the users who rejected the ApprovalRequest.
+	// +optional
+	// +listType=atomic
+	Rejecters []string `json:"rejecters,omitempty"`
+}
+
+// GetNumberOfApprovalsRequired returns the number of users who must approve the ApprovalRequest.
+func (ars *ApprovalRequestSpec) GetNumberOfApprovalsRequired() int {
+	if ars.NumberOfApprovalsRequired < 1 {
+		return 1
+	}
+	return ars.NumberOfApprovalsRequired
+}
+
+// IsApprover returns true if the user, member of the given groups, can respond to the ApprovalRequest.
+func (ars *ApprovalRequestSpec) IsApprover(user string, groups []string) bool {
+	for _, approver := range ars.Approvers {
+		switch approver.Type {
+		case ApproverTypeGroup:
+			if slices.Contains(groups, approver.Name) {
+				return true
+			}
+		default:
+			if approver.Name == user {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Decide returns the state of the ApprovalRequest given the responses of the approvers, with the
+// users who approved and rejected it. It is rejected as soon as one approver rejects it.
+func (ars *ApprovalRequestSpec) Decide() (state ApprovalState, approvers, rejecters []string) {
+	for _, response := range ars.Responses {
+		switch response.Decision {
+		case ApprovalDecisionApprove:
+			approvers = append(approvers, response.Name)
+		case ApprovalDecisionReject:
+			rejecters = append(rejecters, response.Name)
+		}
+	}
+	switch {
+	case len(rejecters) > 0:
+		return ApprovalStateRejected, approvers, rejecters
+	case len(approvers) >= ars.GetNumberOfApprovalsRequired():
+		return ApprovalStateApproved, approvers, rejecters
+	default:
+		return ApprovalStatePending, approvers, rejecters
+	}
+}
+
+// IsDone returns true if the ApprovalRequest is no longer pending.
+func (ar *ApprovalRequest) IsDone() bool {
+	return ar.Status.State != "" && ar.Status.State != ApprovalStatePending
+}
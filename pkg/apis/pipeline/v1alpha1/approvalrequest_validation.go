@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/validate"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
+)
+
+var _ apis.Validatable = (*ApprovalRequest)(nil)
+
+// Validate implements apis.Validatable
+func (ar *ApprovalRequest) Validate(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(validate.ObjectMetadata(ar.GetObjectMeta()).ViaField("metadata"))
+	errs = errs.Also(ar.Spec.Validate(ctx).ViaField("spec"))
+	errs = errs.Also(ar.validateUpdate(ctx).ViaField("spec"))
+	return errs
+}
+
+// Validate ApprovalRequestSpec, which requires at least one approver and one response per user at most.
+func (ars *ApprovalRequestSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if len(ars.Approvers) == 0 {
+		errs = errs.Also(apis.ErrMissingField("approvers"))
+	}
+	users, groups := 0, 0
+	for i, approver := range ars.Approvers {
+		if approver.Type == ApproverTypeGroup {
+			groups++
+		} else {
+			users++
+		}
+		if approver.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("approvers", i))
+		}
+		switch approver.Type {
+		case "", ApproverTypeUser, ApproverTypeGroup:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("available values are: %s, %s, but got: %s", ApproverTypeUser, ApproverTypeGroup, approver.Type), "type").ViaFieldIndex("approvers", i))
+		}
+	}
+	if ars.NumberOfApprovalsRequired < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 1", ars.NumberOfApprovalsRequired), "numberOfApprovalsRequired"))
+	} else if groups == 0 && users > 0 && ars.NumberOfApprovalsRequired > users {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be <= the number of approvers %d", ars.NumberOfApprovalsRequired, users), "numberOfApprovalsRequired"))
+	}
+	names := sets.NewString()
+	for i, response := range ars.Responses {
+		if response.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("responses", i))
+		} else if names.Has(response.Name) {
+			errs = errs.Also(apis.ErrMultipleOneOf(fmt.Sprintf("responses[%s]", response.Name)))
+		}
+		names.Insert(response.Name)
+		if response.Decision != ApprovalDecisionApprove && response.Decision != ApprovalDecisionReject {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("available values are: %s, %s, but got: %s", ApprovalDecisionApprove, ApprovalDecisionReject, response.Decision), "decision").ViaFieldIndex("responses", i))
+		}
+	}
+	return errs
+}
+
+// validateUpdate validates that only the responses of the ApprovalRequest change once it is created, and
+// that the user making the request only adds, changes or removes their own response, as an approver.
+func (ar *ApprovalRequest) validateUpdate(ctx context.Context) (errs *apis.FieldError) {
+	old := &ApprovalRequest{}
+	if apis.IsInUpdate(ctx) {
+		baseline, ok := apis.GetBaseline(ctx).(*ApprovalRequest)
+		if !ok || baseline == nil {
+			return errs
+		}
+		old = baseline
+		if !equality.Semantic.DeepEqual(old.Spec.Approvers, ar.Spec.Approvers) ||
+			old.Spec.NumberOfApprovalsRequired != ar.Spec.NumberOfApprovalsRequired || old.Spec.Description != ar.Spec.Description {
+			errs = errs.Also(apis.ErrInvalidValue("Once the ApprovalRequest is created, only responses updates are allowed", ""))
+		}
+	}
+
+	changed := sets.NewString()
+	oldResponses := map[string]ApprovalResponse{}
+	for _, response := range old.Spec.Responses {
+		oldResponses[response.Name] = response
+	}
+	for _, response := range ar.Spec.Responses {
+		if oldResponse, ok := oldResponses[response.Name]; !ok || oldResponse != response {
+			changed.Insert(response.Name)
+		}
+		delete(oldResponses, response.Name)
+	}
+	for name := range oldResponses {
+		changed.Insert(name)
+	}
+	if changed.Len() == 0 {
+		return errs
+	}
+
+	if old.IsDone() {
+		return errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Once the ApprovalRequest is %s, no responses updates are allowed", old.Status.State), "responses"))
+	}
+	userInfo := apis.GetUserInfo(ctx)
+	if userInfo == nil {
+		return errs
+	}
+	for _, name := range changed.List() {
+		if name != userInfo.Username {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s cannot respond on behalf of %s", userInfo.Username, name), "responses"))
+		}
+	}
+	if !ar.Spec.IsApprover(userInfo.Username, userInfo.Groups) {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s is not an approver", userInfo.Username), "responses"))
+	}
+	return errs
+}
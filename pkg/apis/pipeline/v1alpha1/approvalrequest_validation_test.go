@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/test/diff"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestApprovalRequest_Valid(t *testing.T) {
+	ar := &v1alpha1.ApprovalRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "approve"},
+		Spec: v1alpha1.ApprovalRequestSpec{
+			Approvers: []v1alpha1.Approver{
+				{Name: "alice"},
+				{Name: "release-managers", Type: v1alpha1.ApproverTypeGroup},
+			},
+			NumberOfApprovalsRequired: 2,
+			Responses: []v1alpha1.ApprovalResponse{
+				{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove, Comment: "LGTM"},
+			},
+		},
+	}
+	if err := ar.Validate(context.Background()); err != nil {
+		t.Errorf("ApprovalRequest.Validate() = %v", err)
+	}
+}
+
+func TestApprovalRequest_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec v1alpha1.ApprovalRequestSpec
+		want *apis.FieldError
+	}{{
+		name: "missing approvers",
+		spec: v1alpha1.ApprovalRequestSpec{},
+		want: apis.ErrMissingField("spec.approvers"),
+	}, {
+		name: "invalid approver",
+		spec: v1alpha1.ApprovalRequestSpec{
+			Approvers: []v1alpha1.Approver{{Type: "Team"}},
+		},
+		want: apis.ErrMissingField("spec.approvers[0].name").Also(
+			apis.ErrInvalidValue("available values are: User, Group, but got: Team", "spec.approvers[0].type")),
+	}, {
+		name: "more approvals required than users",
+		spec: v1alpha1.ApprovalRequestSpec{
+			Approvers:                 []v1alpha1.Approver{{Name: "alice"}},
+			NumberOfApprovalsRequired: 2,
+		},
+		want: apis.ErrInvalidValue("2 should be <= the number of approvers 1", "spec.numberOfApprovalsRequired"),
+	}, {
+		name: "invalid responses",
+		spec: v1alpha1.ApprovalRequestSpec{
+			Approvers: []v1alpha1.Approver{{Name: "alice"}},
+			Responses: []v1alpha1.ApprovalResponse{
+				{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove},
+				{Name: "alice", Decision: "maybe"},
+			},
+		},
+		want: apis.ErrMultipleOneOf("spec.responses[alice]").Also(
+			apis.ErrInvalidValue("available values are: approve, reject, but got: maybe", "spec.responses[1].decision")),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ar := &v1alpha1.ApprovalRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "approve"},
+				Spec:       tt.spec,
+			}
+			err := ar.Validate(context.Background())
+			if d := cmp.Diff(tt.want.Error(), err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApprovalRequest_ValidateUpdate(t *testing.T) {
+	pending := &v1alpha1.ApprovalRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "approve"},
+		Spec: v1alpha1.ApprovalRequestSpec{
+			Approvers: []v1alpha1.Approver{
+				{Name: "alice"},
+				{Name: "release-managers", Type: v1alpha1.ApproverTypeGroup},
+			},
+		},
+		Status: v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStatePending},
+	}
+	approved := pending.DeepCopy()
+	approved.Spec.Responses = []v1alpha1.ApprovalResponse{{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove}}
+	approved.Status.State = v1alpha1.ApprovalStateApproved
+
+	tests := []struct {
+		name     string
+		baseline *v1alpha1.ApprovalRequest
+		user     *authenticationv1.UserInfo
+		update   func(*v1alpha1.ApprovalRequest)
+		want     *apis.FieldError
+	}{{
+		name:     "user approves",
+		baseline: pending,
+		user:     &authenticationv1.UserInfo{Username: "alice"},
+		update: func(ar *v1alpha1.ApprovalRequest) {
+			ar.Spec.Responses = []v1alpha1.ApprovalResponse{{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove}}
+		},
+	}, {
+		name:     "group member rejects",
+		baseline: pending,
+		user:     &authenticationv1.UserInfo{Username: "bob", Groups: []string{"release-managers"}},
+		update: func(ar *v1alpha1.ApprovalRequest) {
+			ar.Spec.Responses = []v1alpha1.ApprovalResponse{{Name: "bob", Decision: v1alpha1.ApprovalDecisionReject, Comment: "not yet"}}
+		},
+	}, {
+		name:     "user responds on behalf of another user",
+		baseline: pending,
+		user:     &authenticationv1.UserInfo{Username: "bob", Groups: []string{"release-managers"}},
+		update: func(ar *v1alpha1.ApprovalRequest) {
+			ar.Spec.Responses = []v1alpha1.ApprovalResponse{{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove}}
+		},
+		want: apis.ErrInvalidValue("bob cannot respond on behalf of alice", "spec.responses"),
+	}, {
+		name:     "user is not an approver",
+		baseline: pending,
+		user:     &authenticationv1.UserInfo{Username: "mallory"},
+		update: func(ar *v1alpha1.ApprovalRequest) {
+			ar.Spec.Responses = []v1alpha1.ApprovalResponse{{Name: "mallory", Decision: v1alpha1.ApprovalDecisionApprove}}
+		},
+		want: apis.ErrInvalidValue("mallory is not an approver", "spec.responses"),
+	}, {
+		name:     "approvers are immutable",
+		baseline: pending,
+		user:     &authenticationv1.UserInfo{Username: "alice"},
+		update: func(ar *v1alpha1.ApprovalRequest) {
+			ar.Spec.Approvers = append(ar.Spec.Approvers, v1alpha1.Approver{Name: "mallory"})
+		},
+		want: apis.ErrInvalidValue("Once the ApprovalRequest is created, only responses updates are allowed", "spec"),
+	}, {
+		name:     "responses are immutable once approved",
+		baseline: approved,
+		user:     &authenticationv1.UserInfo{Username: "alice"},
+		update: func(ar *v1alpha1.ApprovalRequest) {
+			ar.Spec.Responses = []v1alpha1.ApprovalResponse{{Name: "alice", Decision: v1alpha1.ApprovalDecisionReject}}
+		},
+		want: apis.ErrInvalidValue("Once the ApprovalRequest is approved, no responses updates are allowed", "spec.responses"),
+	}, {
+		name:     "status update by the controller",
+		baseline: pending,
+		user:     &authenticationv1.UserInfo{Username: "system:serviceaccount:tekton-pipelines:tekton-pipelines-controller"},
+		update: func(ar *v1alpha1.ApprovalRequest) {
+			ar.Status.State = v1alpha1.ApprovalStateTimedOut
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ar := tt.baseline.DeepCopy()
+			tt.update(ar)
+			ctx := apis.WithinUpdate(context.Background(), tt.baseline)
+			ctx = apis.WithUserInfo(ctx, tt.user)
+			err := ar.Validate(ctx)
+			if d := cmp.Diff(tt.want.Error(), err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApprovalRequestSpec_Decide(t *testing.T) {
+	tests := []struct {
+		name          string
+		required      int
+		responses     []v1alpha1.ApprovalResponse
+		wantState     v1alpha1.ApprovalState
+		wantApprovers []string
+		wantRejecters []string
+	}{{
+		name:      "no responses",
+		wantState: v1alpha1.ApprovalStatePending,
+	}, {
+		name:     "not enough approvals",
+		required: 2,
+		responses: []v1alpha1.ApprovalResponse{
+			{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove},
+		},
+		wantState:     v1alpha1.ApprovalStatePending,
+		wantApprovers: []string{"alice"},
+	}, {
+		name:     "enough approvals",
+		required: 2,
+		responses: []v1alpha1.ApprovalResponse{
+			{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove},
+			{Name: "bob", Decision: v1alpha1.ApprovalDecisionApprove},
+		},
+		wantState:     v1alpha1.ApprovalStateApproved,
+		wantApprovers: []string{"alice", "bob"},
+	}, {
+		name: "rejected despite approvals",
+		responses: []v1alpha1.ApprovalResponse{
+			{Name: "alice", Decision: v1alpha1.ApprovalDecisionApprove},
+			{Name: "bob", Decision: v1alpha1.ApprovalDecisionReject},
+		},
+		wantState:     v1alpha1.ApprovalStateRejected,
+		wantApprovers: []string{"alice"},
+		wantRejecters: []string{"bob"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := v1alpha1.ApprovalRequestSpec{NumberOfApprovalsRequired: tt.required, Responses: tt.responses}
+			state, approvers, rejecters := spec.Decide()
+			if state != tt.wantState {
+				t.Errorf("Decide() state = %s, want %s", state, tt.wantState)
+			}
+			if d := cmp.Diff(tt.wantApprovers, approvers); d != "" {
+				t.Errorf("Decide() approvers %s", diff.PrintWantGot(d))
+			}
+			if d := cmp.Diff(tt.wantRejecters, rejecters); d != "" {
+				t.Errorf("Decide() rejecters %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
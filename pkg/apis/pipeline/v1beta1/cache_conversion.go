@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+func (tc TaskCache) convertTo(ctx context.Context, sink *v1.TaskCache) {
+	sink.Workspaces = nil
+	for _, cw := range tc.Workspaces {
+		sink.Workspaces = append(sink.Workspaces, v1.CacheWorkspace{Name: cw.Name, Digest: cw.Digest})
+	}
+}
+
+func (tc *TaskCache) convertFrom(ctx context.Context, source v1.TaskCache) {
+	tc.Workspaces = nil
+	for _, cw := range source.Workspaces {
+		tc.Workspaces = append(tc.Workspaces, CacheWorkspace{Name: cw.Name, Digest: cw.Digest})
+	}
+}
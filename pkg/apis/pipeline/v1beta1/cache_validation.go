@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
+)
+
+// validateCache validates the Cache field of a PipelineTask. Only Tasks run as a single
+// TaskRun can be cached, so custom tasks, child pipelines and matrixed tasks are rejected.
+func (pt PipelineTask) validateCache(ctx context.Context) (errs *apis.FieldError) {
+	if pt.Cache == nil {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "cache", config.AlphaAPIFields))
+	switch {
+	case pt.IsMatrixed():
+		errs = errs.Also(apis.ErrMultipleOneOf("cache", "matrix"))
+	case pt.PipelineRef != nil || pt.PipelineSpec != nil:
+		errs = errs.Also(apis.ErrInvalidValue("cache is not supported for child pipelines", "cache"))
+	case pt.TaskRef.IsCustomTask() || pt.TaskSpec.IsCustomTask():
+		errs = errs.Also(apis.ErrInvalidValue("cache is not supported for custom tasks", "cache"))
+	}
+
+	bound := sets.NewString()
+	for _, ws := range pt.Workspaces {
+		bound.Insert(ws.Name)
+	}
+	seen := sets.NewString()
+	for i, cw := range pt.Cache.Workspaces {
+		switch {
+		case cw.Name == "":
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("workspaces", i).ViaField("cache"))
+		case seen.Has(cw.Name):
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("workspace %q appears more than once", cw.Name), "name").ViaFieldIndex("workspaces", i).ViaField("cache"))
+		case !bound.Has(cw.Name):
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("workspace %q is not bound in the PipelineTask", cw.Name), "name").ViaFieldIndex("workspaces", i).ViaField("cache"))
+		}
+		seen.Insert(cw.Name)
+		if cw.Digest == "" {
+			errs = errs.Also(apis.ErrMissingField("digest").ViaFieldIndex("workspaces", i).ViaField("cache"))
+		}
+	}
+	return errs
+}
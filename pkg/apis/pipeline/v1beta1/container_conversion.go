@@ -78,6 +78,9 @@ func (s Step) convertTo(ctx context.Context, sink *v1.Step) {
 		w.convertTo(ctx, &new)
 		sink.When = append(sink.When, new)
 	}
+	sink.ParallelGroup = s.ParallelGroup
+	sink.Retries = s.Retries
+	sink.RetryDelay = s.RetryDelay
 }
 
 func (s *Step) convertFrom(ctx context.Context, source v1.Step) {
@@ -123,6 +126,9 @@ func (s *Step) convertFrom(ctx context.Context, source v1.Step) {
 		new.convertFrom(ctx, w)
 		s.When = append(s.When, new)
 	}
+	s.ParallelGroup = source.ParallelGroup
+	s.Retries = source.Retries
+	s.RetryDelay = source.RetryDelay
 }
 
 func (s StepTemplate) convertTo(ctx context.Context, sink *v1.StepTemplate) {
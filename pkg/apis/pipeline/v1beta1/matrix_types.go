@@ -19,8 +19,11 @@ import (
 	"maps"
 	"math"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/strings/slices"
 	"knative.dev/pkg/apis"
@@ -37,6 +40,21 @@ type Matrix struct {
 	// Include is a list of IncludeParams which allows passing in specific combinations of Parameters into the Matrix.
 	// +optional
 	Include IncludeParamsList `json:"include,omitempty"`
+
+	// MaxConcurrency is the maximum number of Combinations of the Matrix running at once.
+	// The next Combinations are started as earlier ones finish. When it is not set, the
+	// default-max-matrix-concurrency is used, and all Combinations start at once if there is no default.
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+
+	// Exclude is a list of ExcludeParams removing Combinations generated from the Matrix Params.
+	// +optional
+	Exclude ExcludeParamsList `json:"exclude,omitempty"`
+
+	// FailurePolicy defines when the failure of some Combinations fails the PipelineTask.
+	// All Combinations must succeed when it is not set.
+	// +optional
+	FailurePolicy *MatrixFailurePolicy `json:"failurePolicy,omitempty"`
 }
 
 // IncludeParamsList is a list of IncludeParams which allows passing in specific combinations of Parameters into the Matrix.
@@ -53,6 +71,29 @@ type IncludeParams struct {
 	Params Params `json:"params,omitempty"`
 }
 
+// ExcludeParamsList is a list of ExcludeParams which allows removing specific Combinations from the Matrix.
+// +listType=atomic
+type ExcludeParamsList []ExcludeParams
+
+// ExcludeParams removes the Combinations generated from the Matrix Params matching all of its Parameters.
+type ExcludeParams struct {
+	// Params takes only `Parameters` of type `"string"`
+	// The names of the `params` must match the names of the `params` in the Matrix
+	Params Params `json:"params,omitempty"`
+}
+
+// MatrixFailurePolicy defines when the failure of some Combinations of a Matrix fails the PipelineTask.
+type MatrixFailurePolicy struct {
+	// FailFast cancels the remaining Combinations as soon as the PipelineTask can no longer succeed.
+	// +optional
+	FailFast bool `json:"failFast,omitempty"`
+
+	// MinSuccessful is the number, or the percentage, of Combinations that must succeed for the
+	// PipelineTask to succeed. A percentage is rounded up to the next number of Combinations.
+	// +optional
+	MinSuccessful *intstr.IntOrString `json:"minSuccessful,omitempty"`
+}
+
 // Combination is a map, mainly defined to hold a single combination from a Matrix with key as param.Name and value as param.Value
 type Combination map[string]string
 
@@ -71,11 +112,43 @@ func (m *Matrix) FanOut() []Params {
 	for _, parameter := range m.Params {
 		combinations = combinations.fanOutMatrixParams(parameter)
 	}
+	combinations = combinations.removeExcludedCombinations(m.Exclude)
 	combinations.overwriteCombinations(includeCombinations)
 	combinations = combinations.addNewCombinations(includeCombinations)
 	return combinations.toParams()
 }
 
+// removeExcludedCombinations returns the combinations not matching any of the exclude parameters
+func (cs Combinations) removeExcludedCombinations(excludes ExcludeParamsList) Combinations {
+	if len(excludes) == 0 {
+		return cs
+	}
+	var combinations Combinations
+	for _, paramCombination := range cs {
+		if !excludes.match(paramCombination) {
+			combinations = append(combinations, paramCombination)
+		}
+	}
+	return combinations
+}
+
+// match returns true if the combination has all the parameter names and values of any of the exclude parameters
+func (excludes ExcludeParamsList) match(c Combination) bool {
+	for _, exclude := range excludes {
+		matched := len(exclude.Params) > 0
+		for _, param := range exclude.Params {
+			if val, exist := c[param.Name]; !exist || val != param.Value.StringVal {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 // overwriteCombinations replaces any missing include params in the initial
 // matrix params combinations by overwriting the initial combinations with the
 // include combinations
@@ -218,6 +291,16 @@ func (c Combination) sortCombination() ([]string, Combination) {
 
 // CountCombinations returns the count of Combinations of Parameters generated from the Matrix in PipelineTask.
 func (m *Matrix) CountCombinations() int {
+	if m.HasExclude() {
+		// The Combinations matching the Matrix Exclude Parameters are only known once generated
+		return len(m.FanOut())
+	}
+	return m.countCombinationsBeforeExclude()
+}
+
+// countCombinationsBeforeExclude returns the count of Combinations of Parameters generated from the Matrix,
+// including the Combinations matching the Matrix Exclude Parameters.
+func (m *Matrix) countCombinationsBeforeExclude() int {
 	// Iterate over Matrix Parameters and compute count of all generated Combinations
 	count := m.countGeneratedCombinationsFromParams()
 
@@ -284,6 +367,11 @@ func (m *Matrix) HasInclude() bool {
 	return m != nil && m.Include != nil && len(m.Include) > 0
 }
 
+// HasExclude returns true if the Matrix has Exclude Parameters
+func (m *Matrix) HasExclude() bool {
+	return m != nil && len(m.Exclude) > 0
+}
+
 // HasParams returns true if the Matrix has Parameters
 func (m *Matrix) HasParams() bool {
 	return m != nil && m.Params != nil && len(m.Params) > 0
@@ -304,7 +392,8 @@ func (m *Matrix) GetAllParams() Params {
 }
 
 func (m *Matrix) validateCombinationsCount(ctx context.Context) (errs *apis.FieldError) {
-	matrixCombinationsCount := m.CountCombinations()
+	// The Combinations are not generated to be counted here, so the maximum count applies before excluding any
+	matrixCombinationsCount := m.countCombinationsBeforeExclude()
 	maxMatrixCombinationsCount := config.FromContextOrDefaults(ctx).Defaults.DefaultMaxMatrixCombinationsCount
 	if matrixCombinationsCount > maxMatrixCombinationsCount {
 		errs = errs.Also(apis.ErrOutOfBoundsValue(matrixCombinationsCount, 0, maxMatrixCombinationsCount, "matrix"))
@@ -312,6 +401,69 @@ func (m *Matrix) validateCombinationsCount(ctx context.Context) (errs *apis.Fiel
 	return errs
 }
 
+func (m *Matrix) validateMaxConcurrency(ctx context.Context) (errs *apis.FieldError) {
+	if m.MaxConcurrency != 0 {
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "matrix.maxConcurrency", config.AlphaAPIFields))
+		if m.MaxConcurrency < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 1", m.MaxConcurrency), "matrix.maxConcurrency"))
+		}
+	}
+	return errs
+}
+
+// validateExclude validates that Matrix.Exclude.Params only contain string values for the Matrix.Params
+func (m *Matrix) validateExclude(ctx context.Context) (errs *apis.FieldError) {
+	if !m.HasExclude() {
+		return errs
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "matrix.exclude", config.AlphaAPIFields))
+	matrixParamNames := m.Params.ExtractNames()
+	for i, exclude := range m.Exclude {
+		path := fmt.Sprintf("matrix.exclude[%d].params", i)
+		if len(exclude.Params) == 0 {
+			errs = errs.Also(apis.ErrMissingField(path))
+		}
+		errs = errs.Also(exclude.Params.validateDuplicateParameters().ViaField(path))
+		for j, param := range exclude.Params {
+			if !matrixParamNames.Has(param.Name) {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%q is not a parameter of matrix.params", param.Name), "name").ViaFieldIndex(path, j))
+			}
+			if param.Value.Type != ParamTypeString {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("parameters of type string only are allowed, but got param type %s", string(param.Value.Type)), "value").ViaFieldIndex(path, j))
+			}
+		}
+	}
+	return errs
+}
+
+func (m *Matrix) validateFailurePolicy(ctx context.Context) (errs *apis.FieldError) {
+	if m.FailurePolicy == nil {
+		return errs
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "matrix.failurePolicy", config.AlphaAPIFields))
+	if minSuccessful := m.FailurePolicy.MinSuccessful; minSuccessful != nil {
+		switch {
+		case minSuccessful.Type == intstr.Int && minSuccessful.IntVal < 1:
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 1", minSuccessful.IntVal), "matrix.failurePolicy.minSuccessful"))
+		case minSuccessful.Type == intstr.String:
+			percent, err := strconv.Atoi(strings.TrimSuffix(minSuccessful.StrVal, "%"))
+			if err != nil || !strings.HasSuffix(minSuccessful.StrVal, "%") || percent < 1 || percent > 100 {
+				errs = errs.Also(apis.ErrInvalidValue(minSuccessful.StrVal+" should be a number or a percentage between 1% and 100%", "matrix.failurePolicy.minSuccessful"))
+			}
+		}
+	}
+	return errs
+}
+
+// validateChildPipelineFanOut validates that a Matrix fanning out child PipelineRuns does not set a
+// failurePolicy, only supported for TaskRuns and CustomRuns
+func (m *Matrix) validateChildPipelineFanOut() (errs *apis.FieldError) {
+	if m.FailurePolicy != nil {
+		errs = errs.Also(apis.ErrDisallowedFields("matrix.failurePolicy"))
+	}
+	return errs
+}
+
 // validateUniqueParams validates Matrix.Params for a unique list of params
 // and a unique list of params in each Matrix.Include.Params specification
 func (m *Matrix) validateUniqueParams() (errs *apis.FieldError) {
@@ -340,6 +492,12 @@ func (m *Matrix) validatePipelineParametersVariablesInMatrixParameters(prefix st
 			}
 		}
 	}
+	for _, exclude := range m.Exclude {
+		for idx, param := range exclude.Params {
+			// Matrix Exclude Params must be of type string
+			errs = errs.Also(validateStringVariable(param.Value.StringVal, prefix, paramNames, arrayParamNames, objectParamNameKeys).ViaFieldIndex("", idx).ViaField("matrix.exclude.params", ""))
+		}
+	}
 	if m.HasParams() {
 		for _, param := range m.Params {
 			for idx, arrayElement := range param.Value.ArrayVal {
@@ -31,6 +31,8 @@ func (p ParamSpec) convertTo(ctx context.Context, sink *v1.ParamSpec) {
 	}
 	sink.Description = p.Description
 	sink.Enum = p.Enum
+	sink.Schema = p.Schema
+	sink.Sensitive = p.Sensitive
 	var properties map[string]v1.PropertySpec
 	if p.Properties != nil {
 		properties = make(map[string]v1.PropertySpec)
@@ -56,6 +58,8 @@ func (p *ParamSpec) convertFrom(ctx context.Context, source v1.ParamSpec) {
 	}
 	p.Description = source.Description
 	p.Enum = source.Enum
+	p.Schema = source.Schema
+	p.Sensitive = source.Sensitive
 	var properties map[string]PropertySpec
 	if source.Properties != nil {
 		properties = make(map[string]PropertySpec)
@@ -77,6 +81,7 @@ func (p Param) convertTo(ctx context.Context, sink *v1.Param) {
 	newValue := v1.ParamValue{}
 	p.Value.convertTo(ctx, &newValue)
 	sink.Value = newValue
+	sink.ValueFrom = p.ValueFrom
 }
 
 // ConvertFrom converts v1beta1 Param from v1 Param
@@ -85,6 +90,7 @@ func (p *Param) ConvertFrom(ctx context.Context, source v1.Param) {
 	newValue := ParamValue{}
 	newValue.convertFrom(ctx, source.Value)
 	p.Value = newValue
+	p.ValueFrom = source.ValueFrom
 }
 
 func (v ParamValue) convertTo(ctx context.Context, sink *v1.ParamValue) {
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/substitution"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -61,6 +62,16 @@ type ParamSpec struct {
 	// If Enum is not set, no input validation is performed for the param.
 	// +optional
 	Enum []string `json:"enum,omitempty"`
+	// Schema is the JSON Schema the value of the parameter must match.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Schema *v1.JSONSchema `json:"schema,omitempty"`
+	// Sensitive marks the parameter as holding a secret value, such as a token. The value supplied
+	// by a TaskRun or a PipelineRun is moved to a Secret and injected in the Steps through an
+	// environment variable, so that it never appears in the run, its status or the Pod spec.
+	// +optional
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // ParamSpecs is a list of ParamSpec
@@ -165,6 +176,25 @@ func (ps ParamSpecs) validateParamEnums(ctx context.Context) *apis.FieldError {
 	return errs
 }
 
+// validateParamSchemas validates feature flag, JSON Schema and default value for Param Schema
+func (ps ParamSpecs) validateParamSchemas(ctx context.Context) *apis.FieldError {
+	return ps.toV1(ctx).ValidateParamSchemas(ctx)
+}
+
+// validateSensitiveParams validates feature flag, type and allowed fields for sensitive params
+func (ps ParamSpecs) validateSensitiveParams(ctx context.Context) *apis.FieldError {
+	return ps.toV1(ctx).ValidateSensitiveParams(ctx)
+}
+
+// toV1 converts the ParamSpecs to v1 ParamSpecs
+func (ps ParamSpecs) toV1(ctx context.Context) v1.ParamSpecs {
+	v1ParamSpecs := make(v1.ParamSpecs, len(ps))
+	for i, p := range ps {
+		p.convertTo(ctx, &v1ParamSpecs[i])
+	}
+	return v1ParamSpecs
+}
+
 // findDups returns the duplicate element in the given slice
 func findDups(vals []string) sets.String {
 	seen := sets.String{}
@@ -193,6 +223,10 @@ type Param struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value ParamValue `json:"value"`
+	// ValueFrom references the Secret holding the value of a sensitive parameter. When it is set,
+	// Value holds a redacted placeholder.
+	// +optional
+	ValueFrom *v1.ParamValueSource `json:"valueFrom,omitempty"`
 }
 
 // Params is a list of Param
@@ -86,6 +86,12 @@ func (ps *PipelineSpec) ConvertTo(ctx context.Context, sink *v1.PipelineSpec, me
 		}
 		sink.Finally = append(sink.Finally, new)
 	}
+	sink.Imports = nil
+	for _, imp := range ps.Imports {
+		new := v1.PipelineImport{}
+		imp.convertTo(ctx, &new)
+		sink.Imports = append(sink.Imports, new)
+	}
 	return nil
 }
 
@@ -143,6 +149,12 @@ func (ps *PipelineSpec) ConvertFrom(ctx context.Context, source *v1.PipelineSpec
 		}
 		ps.Finally = append(ps.Finally, new)
 	}
+	ps.Imports = nil
+	for _, imp := range source.Imports {
+		new := PipelineImport{}
+		new.convertFrom(ctx, imp)
+		ps.Imports = append(ps.Imports, new)
+	}
 	return nil
 }
 
@@ -169,7 +181,15 @@ func (pt PipelineTask) convertTo(ctx context.Context, sink *v1.PipelineTask, met
 	}
 	sink.OnError = (v1.PipelineTaskOnErrorType)(pt.OnError)
 	sink.Retries = pt.Retries
+	if pt.RetryPolicy != nil {
+		sink.RetryPolicy = &v1.RetryPolicy{}
+		pt.RetryPolicy.convertTo(ctx, sink.RetryPolicy)
+	}
 	sink.RunAfter = pt.RunAfter
+	sink.RunAfterTriggers = nil
+	for _, trigger := range pt.RunAfterTriggers {
+		sink.RunAfterTriggers = append(sink.RunAfterTriggers, v1.RunAfterTrigger{Task: trigger.Task, Condition: v1.RunAfterTriggerCondition(trigger.Condition)})
+	}
 	sink.Params = nil
 	for _, p := range pt.Params {
 		new := v1.Param{}
@@ -190,6 +210,10 @@ func (pt PipelineTask) convertTo(ctx context.Context, sink *v1.PipelineTask, met
 	}
 
 	sink.Timeout = pt.Timeout
+	if pt.Cache != nil {
+		sink.Cache = &v1.TaskCache{}
+		pt.Cache.convertTo(ctx, sink.Cache)
+	}
 	return nil
 }
 
@@ -218,7 +242,16 @@ func (pt *PipelineTask) convertFrom(ctx context.Context, source v1.PipelineTask,
 	}
 	pt.OnError = (PipelineTaskOnErrorType)(source.OnError)
 	pt.Retries = source.Retries
+	if source.RetryPolicy != nil {
+		newRetryPolicy := RetryPolicy{}
+		newRetryPolicy.convertFrom(ctx, *source.RetryPolicy)
+		pt.RetryPolicy = &newRetryPolicy
+	}
 	pt.RunAfter = source.RunAfter
+	pt.RunAfterTriggers = nil
+	for _, trigger := range source.RunAfterTriggers {
+		pt.RunAfterTriggers = append(pt.RunAfterTriggers, RunAfterTrigger{Task: trigger.Task, Condition: RunAfterTriggerCondition(trigger.Condition)})
+	}
 	pt.Params = nil
 	for _, p := range source.Params {
 		new := Param{}
@@ -239,6 +272,11 @@ func (pt *PipelineTask) convertFrom(ctx context.Context, source v1.PipelineTask,
 	}
 
 	pt.Timeout = source.Timeout
+	if source.Cache != nil {
+		newCache := TaskCache{}
+		newCache.convertFrom(ctx, *source.Cache)
+		pt.Cache = &newCache
+	}
 	return nil
 }
 
@@ -286,6 +324,21 @@ func (m *Matrix) convertTo(ctx context.Context, sink *v1.Matrix) {
 			sink.Include[i].Params = append(sink.Include[i].Params, newIncludeParam)
 		}
 	}
+	sink.MaxConcurrency = m.MaxConcurrency
+	for i, exclude := range m.Exclude {
+		sink.Exclude = append(sink.Exclude, v1.ExcludeParams{})
+		for _, param := range exclude.Params {
+			newExcludeParam := v1.Param{}
+			param.convertTo(ctx, &newExcludeParam)
+			sink.Exclude[i].Params = append(sink.Exclude[i].Params, newExcludeParam)
+		}
+	}
+	if m.FailurePolicy != nil {
+		sink.FailurePolicy = &v1.MatrixFailurePolicy{
+			FailFast:      m.FailurePolicy.FailFast,
+			MinSuccessful: m.FailurePolicy.MinSuccessful,
+		}
+	}
 }
 
 func (m *Matrix) convertFrom(ctx context.Context, source v1.Matrix) {
@@ -303,6 +356,21 @@ func (m *Matrix) convertFrom(ctx context.Context, source v1.Matrix) {
 			m.Include[i].Params = append(m.Include[i].Params, new)
 		}
 	}
+	m.MaxConcurrency = source.MaxConcurrency
+	for i, exclude := range source.Exclude {
+		m.Exclude = append(m.Exclude, ExcludeParams{})
+		for _, p := range exclude.Params {
+			new := Param{}
+			new.ConvertFrom(ctx, p)
+			m.Exclude[i].Params = append(m.Exclude[i].Params, new)
+		}
+	}
+	if source.FailurePolicy != nil {
+		m.FailurePolicy = &MatrixFailurePolicy{
+			FailFast:      source.FailurePolicy.FailFast,
+			MinSuccessful: source.FailurePolicy.MinSuccessful,
+		}
+	}
 }
 
 func (pr PipelineResult) convertTo(ctx context.Context, sink *v1.PipelineResult) {
@@ -312,6 +380,7 @@ func (pr PipelineResult) convertTo(ctx context.Context, sink *v1.PipelineResult)
 	newValue := v1.ParamValue{}
 	pr.Value.convertTo(ctx, &newValue)
 	sink.Value = newValue
+	sink.Schema = pr.Schema
 }
 
 func (pr *PipelineResult) convertFrom(ctx context.Context, source v1.PipelineResult) {
@@ -321,6 +390,7 @@ func (pr *PipelineResult) convertFrom(ctx context.Context, source v1.PipelineRes
 	newValue := ParamValue{}
 	newValue.convertFrom(ctx, source.Value)
 	pr.Value = newValue
+	pr.Schema = source.Schema
 }
 
 func (ptm PipelineTaskMetadata) convertTo(ctx context.Context, sink *v1.PipelineTaskMetadata) {
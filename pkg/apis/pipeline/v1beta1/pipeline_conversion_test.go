@@ -28,6 +28,7 @@ import (
 	"github.com/tektoncd/pipeline/test/diff"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/pkg/apis"
 )
 
@@ -44,6 +45,7 @@ func TestPipelineConversionBadType(t *testing.T) {
 }
 
 func TestPipelineConversion(t *testing.T) {
+	minSuccessful := intstr.FromString("50%")
 	for _, test := range []struct {
 		name string
 		in   *v1beta1.Pipeline
@@ -168,8 +170,24 @@ func TestPipelineConversion(t *testing.T) {
 						Operator: selection.In,
 						Values:   []string{"foo", "bar"},
 					}},
-					Retries:  1,
+					Retries: 1,
+					RetryPolicy: &v1beta1.RetryPolicy{
+						Backoff: &v1beta1.RetryBackoff{
+							Strategy: v1beta1.RetryBackoffExponential,
+							Delay:    &metav1.Duration{Duration: 10 * time.Second},
+							MaxDelay: &metav1.Duration{Duration: time.Minute},
+							Jitter:   10,
+						},
+						RetryOn: []v1beta1.RetryCondition{{
+							Reason:    "TaskRunTimeout",
+							ExitCodes: []int32{137},
+						}},
+					},
 					RunAfter: []string{"task-1"},
+					RunAfterTriggers: []v1beta1.RunAfterTrigger{{
+						Task:      "task-1",
+						Condition: v1beta1.RunAfterAlways,
+					}},
 					Params: v1beta1.Params{{
 						Name: "param-task-1",
 						Value: v1beta1.ParamValue{
@@ -192,12 +210,28 @@ func TestPipelineConversion(t *testing.T) {
 							}, {
 								Name: "flags", Value: v1beta1.ParamValue{Type: v1beta1.ParamTypeString, StringVal: "-cover -v"}}},
 						}},
+						MaxConcurrency: 2,
+						Exclude: v1beta1.ExcludeParamsList{{
+							Params: v1beta1.Params{{
+								Name: "a-param", Value: v1beta1.ParamValue{Type: v1beta1.ParamTypeString, StringVal: "and"},
+							}},
+						}},
+						FailurePolicy: &v1beta1.MatrixFailurePolicy{
+							FailFast:      true,
+							MinSuccessful: &minSuccessful,
+						},
 					},
 					Workspaces: []v1beta1.WorkspacePipelineTaskBinding{{
 						Name:      "my-task-workspace",
 						Workspace: "source",
 					}},
 					Timeout: &metav1.Duration{Duration: 5 * time.Minute},
+					Cache: &v1beta1.TaskCache{
+						Workspaces: []v1beta1.CacheWorkspace{{
+							Name:   "my-task-workspace",
+							Digest: "$(tasks.task-1.results.commit)",
+						}},
+					},
 				},
 				},
 				Params: []v1beta1.ParamSpec{{
@@ -224,6 +258,19 @@ func TestPipelineConversion(t *testing.T) {
 					Description: "final-task-description",
 					TaskRef:     &v1beta1.TaskRef{Name: "foo-task"},
 				}},
+				Imports: []v1beta1.PipelineImport{{
+					Name:        "ci",
+					PipelineRef: &v1beta1.PipelineRef{ResolverRef: v1beta1.ResolverRef{Resolver: "git"}},
+					Params: v1beta1.Params{{
+						Name:  "image",
+						Value: *v1beta1.NewStructuredValues("$(params.param-1)"),
+					}},
+					Workspaces: []v1beta1.WorkspacePipelineTaskBinding{{
+						Name:      "shared",
+						Workspace: "workspace",
+						SubPath:   "ci",
+					}},
+				}},
 			},
 		},
 	}} {
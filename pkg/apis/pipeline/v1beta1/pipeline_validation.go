@@ -23,6 +23,7 @@ import (
 
 	"github.com/tektoncd/pipeline/internal/artifactref"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/validate"
 	"github.com/tektoncd/pipeline/pkg/internal/resultref"
 	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
@@ -77,24 +78,33 @@ func (ps *PipelineSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	if len(ps.Resources) > 0 {
 		errs = errs.Also(apis.ErrDisallowedFields("resources"))
 	}
+	// The PipelineTasks of the imported Pipelines are only known once the imports are inlined at
+	// reconcile time, so the references between PipelineTasks are validated then.
+	imported := len(ps.Imports) > 0
+	errs = errs.Also(ps.validatePipelineImports(ctx))
 	// Validate the pipeline task graph
-	errs = errs.Also(validateGraph(ps.Tasks))
+	if !imported {
+		errs = errs.Also(validateGraph(ps.Tasks))
+	}
 	// The parameter variables should be valid
 	errs = errs.Also(ValidatePipelineParameterVariables(ctx, ps.Tasks, ps.Params).ViaField("tasks"))
 	errs = errs.Also(ValidatePipelineParameterVariables(ctx, ps.Finally, ps.Params).ViaField("finally"))
 	errs = errs.Also(validatePipelineContextVariables(ps.Tasks).ViaField("tasks"))
 	errs = errs.Also(validatePipelineContextVariables(ps.Finally).ViaField("finally"))
-	errs = errs.Also(validateExecutionStatusVariables(ps.Tasks, ps.Finally))
+	if !imported {
+		errs = errs.Also(validateExecutionStatusVariables(ps.Tasks, ps.Finally))
+	}
 	// Validate the pipeline's workspaces.
 	errs = errs.Also(validatePipelineWorkspacesDeclarations(ps.Workspaces))
-	// Validate the pipeline's results
-	errs = errs.Also(validatePipelineResults(ps.Results, ps.Tasks, ps.Finally))
-	// Reject result references to PipelineTasks that use pipelineRef or pipelineSpec.
-	// Result propagation from a child Pipeline is not implemented in the initial
-	// Pipelines-in-Pipelines alpha; see docs/pipelines-in-pipelines.md#limitations.
-	errs = errs.Also(validatePipelineRefResultReferencesDisallowed(ps.Tasks, ps.Finally))
-	errs = errs.Also(validateTasksAndFinallySection(ps))
-	errs = errs.Also(validateFinalTasks(ps.Tasks, ps.Finally))
+	for idx, result := range ps.Results {
+		errs = errs.Also(v1.ValidateResultSchema(ctx, result.Schema, v1.ResultsType(result.Type)).ViaFieldIndex("results", idx))
+	}
+	if !imported {
+		// Validate the pipeline's results
+		errs = errs.Also(validatePipelineResults(ps.Results, ps.Tasks, ps.Finally))
+		errs = errs.Also(validateTasksAndFinallySection(ps))
+		errs = errs.Also(validateFinalTasks(ps.Tasks, ps.Finally))
+	}
 	errs = errs.Also(validateWhenExpressions(ctx, ps.Tasks, ps.Finally))
 	errs = errs.Also(validateArtifactReference(ctx, ps.Tasks, ps.Finally))
 	errs = errs.Also(validateMatrix(ctx, ps.Tasks).ViaField("tasks"))
@@ -203,6 +213,12 @@ func (pt PipelineTask) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 	errs = errs.Also(pt.ValidateOnError(ctx))
 
+	errs = errs.Also(pt.validateRetryPolicy(ctx))
+
+	errs = errs.Also(pt.validateCache(ctx))
+
+	errs = errs.Also(pt.validateRunAfterTriggers(ctx))
+
 	// Pipeline task having taskRef/taskSpec with APIVersion is classified as custom task
 	switch {
 	case pt.TaskRef != nil && !taskKinds[pt.TaskRef.Kind]:
@@ -257,7 +273,13 @@ func (pt *PipelineTask) validateMatrix(ctx context.Context) (errs *apis.FieldErr
 		// when the enable-api-fields feature gate is set to "stable".
 		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "matrix", config.BetaAPIFields))
 		errs = errs.Also(pt.Matrix.validateCombinationsCount(ctx))
+		errs = errs.Also(pt.Matrix.validateMaxConcurrency(ctx))
+		errs = errs.Also(pt.Matrix.validateExclude(ctx))
+		errs = errs.Also(pt.Matrix.validateFailurePolicy(ctx))
 		errs = errs.Also(pt.Matrix.validateUniqueParams())
+		if pt.PipelineRef != nil || pt.PipelineSpec != nil {
+			errs = errs.Also(pt.Matrix.validateChildPipelineFanOut())
+		}
 	}
 	errs = errs.Also(pt.Matrix.validateParameterInOneOfMatrixOrParams(pt.Params))
 	return errs
@@ -447,6 +469,7 @@ func validatePipelineTaskParameterUsage(tasks []PipelineTask, params ParamSpecs)
 func (ps *PipelineSpec) validatePipelineWorkspacesUsage() (errs *apis.FieldError) {
 	errs = errs.Also(validatePipelineTasksWorkspacesUsage(ps.Workspaces, ps.Tasks).ViaField("tasks"))
 	errs = errs.Also(validatePipelineTasksWorkspacesUsage(ps.Workspaces, ps.Finally).ViaField("finally"))
+	errs = errs.Also(validatePipelineImportsWorkspacesUsage(ps.Workspaces, ps.Imports).ViaField("imports"))
 	return errs
 }
 
@@ -472,6 +495,8 @@ func ValidatePipelineParameterVariables(ctx context.Context, tasks []PipelineTas
 	errs = errs.Also(ValidateParameterTypes(ctx, params).ViaField("params"))
 	errs = errs.Also(params.validateNoDuplicateNames())
 	errs = errs.Also(params.validateParamEnums(ctx).ViaField("params"))
+	errs = errs.Also(params.validateParamSchemas(ctx).ViaField("params"))
+	errs = errs.Also(params.validateSensitiveParams(ctx).ViaField("params"))
 	for i, task := range tasks {
 		errs = errs.Also(task.Params.validateDuplicateParameters().ViaField("params").ViaIndex(i))
 	}
@@ -737,43 +762,6 @@ func validateTasksAndFinallySection(ps *PipelineSpec) *apis.FieldError {
 	return nil
 }
 
-// validatePipelineRefResultReferencesDisallowed rejects any result reference
-// (`$(tasks.<name>.results.*)` or `$(finally.<name>.results.*)`) whose target
-// PipelineTask is a Pipeline-in-Pipeline (uses pipelineRef or pipelineSpec).
-//
-// Result propagation from a child Pipeline is not yet implemented: the
-// reconciler's convertToResultRefs path reads from ResolvedPipelineTask.TaskRuns
-// which is empty for a child-pipeline task, so without this guard such a
-// reference would panic the controller at runtime. Once propagation lands,
-// this guard can be removed.
-func validatePipelineRefResultReferencesDisallowed(tasks []PipelineTask, finally []PipelineTask) (errs *apis.FieldError) {
-	pipelineRefTasks := sets.NewString()
-	for _, t := range tasks {
-		if t.PipelineRef != nil || t.PipelineSpec != nil {
-			pipelineRefTasks.Insert(t.Name)
-		}
-	}
-	if pipelineRefTasks.Len() == 0 {
-		return nil
-	}
-
-	check := func(pts []PipelineTask, field string) {
-		for idx, pt := range pts {
-			for _, ref := range PipelineTaskResultRefs(&pt) {
-				if pipelineRefTasks.Has(ref.PipelineTask) {
-					errs = errs.Also(apis.ErrInvalidValue(
-						fmt.Sprintf("result reference to pipelineTask %q is not supported: referenced task uses pipelineRef or pipelineSpec and result propagation from child Pipelines is not yet implemented",
-							ref.PipelineTask),
-						"").ViaFieldIndex(field, idx))
-				}
-			}
-		}
-	}
-	check(tasks, "tasks")
-	check(finally, "finally")
-	return errs
-}
-
 func validateFinalTasks(tasks []PipelineTask, finalTasks []PipelineTask) (errs *apis.FieldError) {
 	for idx, f := range finalTasks {
 		if len(f.RunAfter) != 0 {
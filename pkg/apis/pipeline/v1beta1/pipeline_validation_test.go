@@ -3930,7 +3930,7 @@ func TestContextInvalid(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			err := validatePipelineContextVariables(tt.tasks)
 			if err == nil {
-				t.Errorf("Pipeline.validatePipelineContextVariables() did not return error for invalid pipeline parameters: %s", tt.tasks[0].Params)
+				t.Errorf("Pipeline.validatePipelineContextVariables() did not return error for invalid pipeline parameters: %v", tt.tasks[0].Params)
 			}
 			if d := cmp.Diff(tt.expectedError.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
 				t.Errorf("PipelineSpec.Validate() errors diff %s", diff.PrintWantGot(d))
@@ -4186,7 +4186,7 @@ func TestPipelineTasksExecutionStatus(t *testing.T) {
 				}
 			} else {
 				if err == nil {
-					t.Errorf("Pipeline.validateExecutionStatusVariables() did not return error for invalid pipeline parameters accessing execution status: %s, %s", tt.name, tt.tasks[0].Params)
+					t.Errorf("Pipeline.validateExecutionStatusVariables() did not return error for invalid pipeline parameters accessing execution status: %s, %v", tt.name, tt.tasks[0].Params)
 				}
 				if d := cmp.Diff(tt.expectedError.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
 					t.Errorf("PipelineSpec.Validate() errors diff %s", diff.PrintWantGot(d))
@@ -5007,96 +5007,104 @@ func TestGetIndexingReferencesToArrayParams(t *testing.T) {
 	}
 }
 
-func TestValidatePipelineRefResultReferencesDisallowed(t *testing.T) {
-	// happy path: a PinP task that is not consumed by any result reference is allowed.
-	t.Run("pipelineRef task not referenced", func(t *testing.T) {
-		tasks := []PipelineTask{
-			{
+func TestPipelineSpec_ValidateChildPipelines(t *testing.T) {
+	childMatrix := func() *Matrix {
+		return &Matrix{Params: Params{{
+			Name: "region", Value: *NewStructuredValues("us-east", "eu-west"),
+		}}}
+	}
+	tests := []struct {
+		name    string
+		ps      *PipelineSpec
+		wantErr *apis.FieldError
+	}{{
+		name: "task params reference a pipelineRef task result",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
 				Name:        "child",
 				PipelineRef: &PipelineRef{Name: "child-pipeline"},
-			},
-			{
+			}, {
 				Name:    "consumer",
 				TaskRef: &TaskRef{Name: "echo"},
 				Params: Params{{
-					Name:  "msg",
-					Value: *NewStructuredValues("hello"),
+					Name: "msg", Value: *NewStructuredValues("$(tasks.child.results.out)"),
 				}},
-			},
-		}
-		if err := validatePipelineRefResultReferencesDisallowed(tasks, nil); err != nil {
-			t.Errorf("unexpected error for non-referencing pipeline: %v", err)
-		}
-	})
-
-	tests := []struct {
-		desc    string
-		tasks   []PipelineTask
-		finally []PipelineTask
-		wantMsg string
-	}{{
-		desc: "task params reference a pipelineRef task result",
-		tasks: []PipelineTask{
-			{
+			}},
+		},
+	}, {
+		name: "finally task references a pipelineRef task result",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
 				Name:        "child",
 				PipelineRef: &PipelineRef{Name: "child-pipeline"},
-			},
-			{
-				Name:    "consumer",
-				TaskRef: &TaskRef{Name: "echo"},
+			}},
+			Finally: []PipelineTask{{
+				Name:    "notify",
+				TaskRef: &TaskRef{Name: "notify"},
 				Params: Params{{
-					Name:  "msg",
-					Value: *NewStructuredValues("$(tasks.child.results.out)"),
+					Name: "msg", Value: *NewStructuredValues("$(tasks.child.results.out)"),
 				}},
-			},
+			}},
 		},
-		wantMsg: `invalid value: result reference to pipelineTask "child" is not supported: referenced task uses pipelineRef or pipelineSpec and result propagation from child Pipelines is not yet implemented: tasks[1]`,
 	}, {
-		desc: "task when expression references a pipelineSpec task result",
-		tasks: []PipelineTask{
-			{
-				Name:         "child",
-				PipelineSpec: &PipelineSpec{},
-			},
-			{
+		name: "task params reference the aggregated results of a matrixed pipelineRef task",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name:        "child",
+				PipelineRef: &PipelineRef{Name: "child-pipeline"},
+				Matrix:      childMatrix(),
+			}, {
 				Name:    "consumer",
 				TaskRef: &TaskRef{Name: "echo"},
-				WhenExpressions: WhenExpressions{{
-					Input:    "$(tasks.child.results.out)",
-					Operator: "in",
-					Values:   []string{"go"},
+				Params: Params{{
+					Name: "urls", Value: *NewStructuredValues("$(tasks.child.results.url[*])"),
 				}},
-			},
+			}},
 		},
-		wantMsg: `invalid value: result reference to pipelineTask "child" is not supported: referenced task uses pipelineRef or pipelineSpec and result propagation from child Pipelines is not yet implemented: tasks[1]`,
 	}, {
-		desc: "finally task references a pipelineRef task result",
-		tasks: []PipelineTask{
-			{
+		name: "matrixed pipelineRef task with maxConcurrency",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
 				Name:        "child",
 				PipelineRef: &PipelineRef{Name: "child-pipeline"},
-			},
+				Matrix: &Matrix{
+					Params:         childMatrix().Params,
+					MaxConcurrency: 1,
+				},
+			}},
 		},
-		finally: []PipelineTask{
-			{
-				Name:    "notify",
-				TaskRef: &TaskRef{Name: "notify"},
-				Params: Params{{
-					Name:  "msg",
-					Value: *NewStructuredValues("$(tasks.child.results.out)"),
-				}},
-			},
+	}, {
+		name: "matrixed pipelineRef task with failurePolicy",
+		ps: &PipelineSpec{
+			Tasks: []PipelineTask{{
+				Name:        "child",
+				PipelineRef: &PipelineRef{Name: "child-pipeline"},
+				Matrix: &Matrix{
+					Params:        childMatrix().Params,
+					FailurePolicy: &MatrixFailurePolicy{FailFast: true},
+				},
+			}},
 		},
-		wantMsg: `invalid value: result reference to pipelineTask "child" is not supported: referenced task uses pipelineRef or pipelineSpec and result propagation from child Pipelines is not yet implemented: finally[0]`,
+		wantErr: apis.ErrDisallowedFields("tasks[0].matrix.failurePolicy"),
 	}}
-	for _, tc := range tests {
-		t.Run(tc.desc, func(t *testing.T) {
-			err := validatePipelineRefResultReferencesDisallowed(tc.tasks, tc.finally)
-			if err == nil {
-				t.Fatalf("expected error, got nil")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureFlags, _ := config.NewFeatureFlagsFromMap(map[string]string{
+				"enable-api-fields": config.AlphaAPIFields,
+			})
+			ctx := config.ToContext(t.Context(), &config.Config{
+				Defaults:     &config.Defaults{DefaultMaxMatrixCombinationsCount: 4},
+				FeatureFlags: featureFlags,
+			})
+			err := tt.ps.Validate(ctx)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("PipelineSpec.Validate() returned error for valid PipelineSpec: %v", err)
+				}
+				return
 			}
-			if d := cmp.Diff(tc.wantMsg, err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
-				t.Errorf("unexpected error diff %s", diff.PrintWantGot(d))
+			if d := cmp.Diff(tt.wantErr.Error(), err.Error()); d != "" {
+				t.Errorf("PipelineSpec.Validate() errors diff %s", diff.PrintWantGot(d))
 			}
 		})
 	}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+func (imp PipelineImport) convertTo(ctx context.Context, sink *v1.PipelineImport) {
+	sink.Name = imp.Name
+	sink.PipelineRef = nil
+	if imp.PipelineRef != nil {
+		sink.PipelineRef = &v1.PipelineRef{}
+		imp.PipelineRef.convertTo(ctx, sink.PipelineRef)
+	}
+	sink.Params = nil
+	for _, p := range imp.Params {
+		new := v1.Param{}
+		p.convertTo(ctx, &new)
+		sink.Params = append(sink.Params, new)
+	}
+	sink.Workspaces = nil
+	for _, w := range imp.Workspaces {
+		new := v1.WorkspacePipelineTaskBinding{}
+		w.convertTo(ctx, &new)
+		sink.Workspaces = append(sink.Workspaces, new)
+	}
+}
+
+func (imp *PipelineImport) convertFrom(ctx context.Context, source v1.PipelineImport) {
+	imp.Name = source.Name
+	imp.PipelineRef = nil
+	if source.PipelineRef != nil {
+		imp.PipelineRef = &PipelineRef{}
+		imp.PipelineRef.convertFrom(ctx, *source.PipelineRef)
+	}
+	imp.Params = nil
+	for _, p := range source.Params {
+		new := Param{}
+		new.ConvertFrom(ctx, p)
+		imp.Params = append(imp.Params, new)
+	}
+	imp.Workspaces = nil
+	for _, w := range source.Workspaces {
+		new := WorkspacePipelineTaskBinding{}
+		new.convertFrom(ctx, w)
+		imp.Workspaces = append(imp.Workspaces, new)
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// PipelineImport declares a Pipeline whose Tasks and Finally tasks are inlined into the
+// importing Pipeline. The imported PipelineTasks are renamed to "<name>-<task>", and the
+// references between them are updated accordingly.
+type PipelineImport struct {
+	// Name is the prefix of the PipelineTasks inlined from the imported Pipeline
+	Name string `json:"name"`
+	// PipelineRef is a reference to the imported Pipeline, either by name or through a resolver
+	PipelineRef *PipelineRef `json:"pipelineRef"`
+	// Params binds the parameters of the imported Pipeline. Parameters which aren't bound
+	// take their default value.
+	// +optional
+	Params Params `json:"params,omitempty"`
+	// Workspaces binds the workspaces of the imported Pipeline to the workspaces of the
+	// importing Pipeline. Workspaces which aren't bound are mapped to the workspace of the
+	// same name of the importing Pipeline.
+	// +optional
+	// +listType=atomic
+	Workspaces []WorkspacePipelineTaskBinding `json:"workspaces,omitempty"`
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+// validatePipelineImports validates the Imports of a PipelineSpec: each import must have a unique
+// name which can prefix the names of the imported PipelineTasks, and reference a Pipeline.
+func (ps *PipelineSpec) validatePipelineImports(ctx context.Context) (errs *apis.FieldError) {
+	if len(ps.Imports) == 0 {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "imports", config.AlphaAPIFields))
+	names := sets.NewString()
+	for i, imp := range ps.Imports {
+		errs = errs.Also(imp.validate(ctx, names).ViaFieldIndex("imports", i))
+		names.Insert(imp.Name)
+	}
+	return errs
+}
+
+func (imp PipelineImport) validate(ctx context.Context, names sets.String) (errs *apis.FieldError) {
+	switch {
+	case imp.Name == "":
+		errs = errs.Also(apis.ErrMissingField("name"))
+	case names.Has(imp.Name):
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("import %q appears more than once", imp.Name), "name"))
+	default:
+		if err := validation.IsDNS1123Label(imp.Name); len(err) > 0 {
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("invalid value %q", imp.Name),
+				Paths:   []string{"name"},
+				Details: "Import name must be a valid DNS Label. For more info refer to https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+			})
+		}
+	}
+	if imp.PipelineRef == nil {
+		errs = errs.Also(apis.ErrMissingField("pipelineRef"))
+	} else {
+		errs = errs.Also(imp.PipelineRef.Validate(ctx).ViaField("pipelineRef"))
+	}
+	errs = errs.Also(imp.Params.validateDuplicateParameters().ViaField("params"))
+	for i, ws := range imp.Workspaces {
+		if ws.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("workspaces", i))
+		}
+	}
+	return errs
+}
+
+// validatePipelineImportsWorkspacesUsage validates that the workspaces bound to the imported Pipelines
+// are declared by the importing Pipeline.
+func validatePipelineImportsWorkspacesUsage(wss []PipelineWorkspaceDeclaration, imports []PipelineImport) (errs *apis.FieldError) {
+	workspaceNames := sets.NewString()
+	for _, ws := range wss {
+		workspaceNames.Insert(ws.Name)
+	}
+	for i, imp := range imports {
+		for j, ws := range imp.Workspaces {
+			if ws.Workspace != "" && !workspaceNames.Has(ws.Workspace) {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("import %q expects workspace %q to be provided by pipeline", imp.Name, ws.Workspace), "workspace").ViaFieldIndex("workspaces", j).ViaIndex(i))
+			}
+		}
+	}
+	return errs
+}
@@ -92,6 +92,13 @@ func (prs PipelineRunSpec) ConvertTo(ctx context.Context, sink *v1.PipelineRunSp
 		ptrs.convertTo(ctx, &new)
 		sink.TaskRunSpecs = append(sink.TaskRunSpecs, new)
 	}
+	if prs.Concurrency != nil {
+		sink.Concurrency = &v1.Concurrency{}
+		prs.Concurrency.convertTo(ctx, sink.Concurrency)
+	}
+	if prs.RerunOf != nil {
+		sink.RerunOf = &v1.PipelineRunRef{Name: prs.RerunOf.Name}
+	}
 	return nil
 }
 
@@ -153,6 +160,14 @@ func (prs *PipelineRunSpec) ConvertFrom(ctx context.Context, source *v1.Pipeline
 		new.convertFrom(ctx, trs)
 		prs.TaskRunSpecs = append(prs.TaskRunSpecs, new)
 	}
+	if source.Concurrency != nil {
+		newConcurrency := Concurrency{}
+		newConcurrency.convertFrom(ctx, *source.Concurrency)
+		prs.Concurrency = &newConcurrency
+	}
+	if source.RerunOf != nil {
+		prs.RerunOf = &PipelineRunRef{Name: source.RerunOf.Name}
+	}
 	return nil
 }
 
@@ -160,12 +175,14 @@ func (tf TimeoutFields) convertTo(ctx context.Context, sink *v1.TimeoutFields) {
 	sink.Pipeline = tf.Pipeline
 	sink.Tasks = tf.Tasks
 	sink.Finally = tf.Finally
+	sink.Queue = tf.Queue
 }
 
 func (tf *TimeoutFields) convertFrom(ctx context.Context, source v1.TimeoutFields) {
 	tf.Pipeline = source.Pipeline
 	tf.Tasks = source.Tasks
 	tf.Finally = source.Finally
+	tf.Queue = source.Queue
 }
 
 func (ptrs PipelineTaskRunSpec) convertTo(ctx context.Context, sink *v1.PipelineTaskRunSpec) {
@@ -220,6 +237,7 @@ func (ptrs *PipelineTaskRunSpec) convertFrom(ctx context.Context, source v1.Pipe
 func (prs *PipelineRunStatus) convertTo(ctx context.Context, sink *v1.PipelineRunStatus, meta *metav1.ObjectMeta) error {
 	sink.Status = prs.Status
 	sink.StartTime = prs.StartTime
+	sink.ExecutionStartTime = prs.ExecutionStartTime
 	sink.CompletionTime = prs.CompletionTime
 	sink.Results = nil
 	for _, pr := range prs.PipelineResults {
@@ -240,6 +258,14 @@ func (prs *PipelineRunStatus) convertTo(ctx context.Context, sink *v1.PipelineRu
 		st.convertTo(ctx, &new)
 		sink.SkippedTasks = append(sink.SkippedTasks, new)
 	}
+	sink.CachedTasks = nil
+	for _, ct := range prs.CachedTasks {
+		sink.CachedTasks = append(sink.CachedTasks, v1.CachedTask{Name: ct.Name, TaskRunName: ct.TaskRunName, CacheKey: ct.CacheKey})
+	}
+	sink.CarriedOverTasks = nil
+	for _, ct := range prs.CarriedOverTasks {
+		sink.CarriedOverTasks = append(sink.CarriedOverTasks, v1.CarriedOverTask{Name: ct.Name, PipelineRunName: ct.PipelineRunName, Skipped: ct.Skipped})
+	}
 	sink.ChildReferences = nil
 	for _, cr := range prs.ChildReferences {
 		new := v1.ChildStatusReference{}
@@ -247,6 +273,8 @@ func (prs *PipelineRunStatus) convertTo(ctx context.Context, sink *v1.PipelineRu
 		sink.ChildReferences = append(sink.ChildReferences, new)
 	}
 	sink.FinallyStartTime = prs.FinallyStartTime
+	sink.PausedTime = prs.PausedTime
+	sink.PausedDuration = prs.PausedDuration
 	if prs.Provenance != nil {
 		new := v1.Provenance{}
 		prs.Provenance.convertTo(ctx, &new)
@@ -258,6 +286,7 @@ func (prs *PipelineRunStatus) convertTo(ctx context.Context, sink *v1.PipelineRu
 func (prs *PipelineRunStatus) convertFrom(ctx context.Context, source *v1.PipelineRunStatus, meta *metav1.ObjectMeta) error {
 	prs.Status = source.Status
 	prs.StartTime = source.StartTime
+	prs.ExecutionStartTime = source.ExecutionStartTime
 	prs.CompletionTime = source.CompletionTime
 	prs.PipelineResults = nil
 	for _, pr := range source.Results {
@@ -279,6 +308,14 @@ func (prs *PipelineRunStatus) convertFrom(ctx context.Context, source *v1.Pipeli
 		new.convertFrom(ctx, st)
 		prs.SkippedTasks = append(prs.SkippedTasks, new)
 	}
+	prs.CachedTasks = nil
+	for _, ct := range source.CachedTasks {
+		prs.CachedTasks = append(prs.CachedTasks, CachedTask{Name: ct.Name, TaskRunName: ct.TaskRunName, CacheKey: ct.CacheKey})
+	}
+	prs.CarriedOverTasks = nil
+	for _, ct := range source.CarriedOverTasks {
+		prs.CarriedOverTasks = append(prs.CarriedOverTasks, CarriedOverTask{Name: ct.Name, PipelineRunName: ct.PipelineRunName, Skipped: ct.Skipped})
+	}
 	prs.ChildReferences = nil
 	for _, cr := range source.ChildReferences {
 		new := ChildStatusReference{}
@@ -287,6 +324,8 @@ func (prs *PipelineRunStatus) convertFrom(ctx context.Context, source *v1.Pipeli
 	}
 
 	prs.FinallyStartTime = source.FinallyStartTime
+	prs.PausedTime = source.PausedTime
+	prs.PausedDuration = source.PausedDuration
 	if source.Provenance != nil {
 		new := Provenance{}
 		new.convertFrom(ctx, *source.Provenance)
@@ -336,6 +375,8 @@ func (csr ChildStatusReference) convertTo(ctx context.Context, sink *v1.ChildSta
 	sink.Name = csr.Name
 	sink.DisplayName = csr.DisplayName
 	sink.PipelineTaskName = csr.PipelineTaskName
+	sink.Cached = csr.Cached
+	sink.CarriedOver = csr.CarriedOver
 	sink.WhenExpressions = nil
 	for _, we := range csr.WhenExpressions {
 		new := v1.WhenExpression{}
@@ -349,6 +390,8 @@ func (csr *ChildStatusReference) convertFrom(ctx context.Context, source v1.Chil
 	csr.Name = source.Name
 	csr.DisplayName = source.DisplayName
 	csr.PipelineTaskName = source.PipelineTaskName
+	csr.Cached = source.Cached
+	csr.CarriedOver = source.CarriedOver
 	csr.WhenExpressions = nil
 	for _, we := range source.WhenExpressions {
 		new := WhenExpression{}
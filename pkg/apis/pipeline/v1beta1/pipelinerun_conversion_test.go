@@ -227,6 +227,7 @@ func TestPipelineRunConversion(t *testing.T) {
 					Pipeline: &metav1.Duration{Duration: 25 * time.Minute},
 					Finally:  &metav1.Duration{Duration: 1 * time.Hour},
 					Tasks:    &metav1.Duration{Duration: 1 * time.Hour},
+					Queue:    &metav1.Duration{Duration: 5 * time.Minute},
 				},
 				PodTemplate: &pod.Template{
 					NodeSelector: map[string]string{
@@ -286,6 +287,12 @@ func TestPipelineRunConversion(t *testing.T) {
 						},
 					},
 				},
+				Concurrency: &v1beta1.Concurrency{
+					Group:       "deploy-$(params.environment)",
+					MaxInFlight: 2,
+					Strategy:    v1beta1.ConcurrencyStrategyCancelOlder,
+				},
+				RerunOf: &v1beta1.PipelineRunRef{Name: "previous-run"},
 			},
 			Status: v1beta1.PipelineRunStatus{
 				Status: duckv1.Status{
@@ -298,8 +305,9 @@ func TestPipelineRunConversion(t *testing.T) {
 					ObservedGeneration: 1,
 				},
 				PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
-					StartTime:      &metav1.Time{Time: time.Now()},
-					CompletionTime: &metav1.Time{Time: time.Now().Add(1 * time.Minute)},
+					StartTime:          &metav1.Time{Time: time.Now()},
+					ExecutionStartTime: &metav1.Time{Time: time.Now().Add(10 * time.Second)},
+					CompletionTime:     &metav1.Time{Time: time.Now().Add(1 * time.Minute)},
 					PipelineResults: []v1beta1.PipelineRunResult{{
 						Name: "pipeline-result-1",
 						Value: *v1beta1.NewObject(map[string]string{
@@ -335,6 +343,19 @@ func TestPipelineRunConversion(t *testing.T) {
 							Reason: v1beta1.MissingResultsSkip,
 						},
 					},
+					CachedTasks: []v1beta1.CachedTask{{
+						Name:        "task-3",
+						TaskRunName: "previous-run-task-3",
+						CacheKey:    "0123456789abcdef",
+					}},
+					CarriedOverTasks: []v1beta1.CarriedOverTask{{
+						Name:            "task-4",
+						PipelineRunName: "previous-run",
+					}, {
+						Name:            "skipped-1",
+						PipelineRunName: "previous-run",
+						Skipped:         true,
+					}},
 					ChildReferences: []v1beta1.ChildStatusReference{
 						{
 							TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
@@ -351,14 +372,30 @@ func TestPipelineRunConversion(t *testing.T) {
 							Name:             "t2",
 							PipelineTaskName: "task-2",
 						},
+						{
+							TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+							Name:             "previous-run-task-3",
+							PipelineTaskName: "task-3",
+							Cached:           true,
+						},
+						{
+							TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+							Name:             "previous-run-task-4",
+							PipelineTaskName: "task-4",
+							CarriedOver:      true,
+						},
 					},
 					FinallyStartTime: &metav1.Time{Time: time.Now()},
+					PausedTime:       &metav1.Time{Time: time.Now()},
+					PausedDuration:   &metav1.Duration{Duration: time.Minute},
 					Provenance: &v1beta1.Provenance{
 						RefSource: &v1beta1.RefSource{
 							URI:    "test-uri",
 							Digest: map[string]string{"sha256": "digest"},
 						},
-						FeatureFlags: config.DefaultFeatureFlags.DeepCopy(),
+						Attestation:      "test-provenance",
+						AttestationError: "test-error",
+						FeatureFlags:     config.DefaultFeatureFlags.DeepCopy(),
 					},
 				},
 			},
@@ -56,6 +56,10 @@ func (pr *PipelineRun) Validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(apis.ErrInvalidValue("PipelineRun cannot be Pending after it is started", "spec.status"))
 	}
 
+	if pr.Spec.RerunOf != nil && pr.Spec.RerunOf.Name == pr.Name {
+		errs = errs.Also(apis.ErrInvalidValue("a PipelineRun cannot rerun itself", "spec.rerunOf.name"))
+	}
+
 	return errs.Also(pr.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
 }
 
@@ -116,6 +120,12 @@ func (ps *PipelineRunSpec) Validate(ctx context.Context) (errs *apis.FieldError)
 		// pipeline timeout should be a valid duration of at least 0.
 		errs = errs.Also(validateTimeoutDuration("pipeline", ps.Timeouts.Pipeline))
 
+		// queue timeout is an alpha feature, and should be a valid duration of at least 0.
+		if ps.Timeouts.Queue != nil {
+			errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "timeouts.queue", config.AlphaAPIFields))
+			errs = errs.Also(validateTimeoutDuration("queue", ps.Timeouts.Queue))
+		}
+
 		if ps.Timeouts.Pipeline != nil {
 			errs = errs.Also(ps.validatePipelineTimeout(ps.Timeouts.Pipeline.Duration, "should be <= pipeline duration"))
 		} else {
@@ -124,7 +134,7 @@ func (ps *PipelineRunSpec) Validate(ctx context.Context) (errs *apis.FieldError)
 		}
 	}
 
-	errs = errs.Also(validateSpecStatus(ps.Status))
+	errs = errs.Also(validateSpecStatus(ctx, ps.Status))
 
 	if ps.Workspaces != nil {
 		wsNames := make(map[string]int)
@@ -146,6 +156,15 @@ func (ps *PipelineRunSpec) Validate(ctx context.Context) (errs *apis.FieldError)
 		errs = errs.Also(apis.ErrDisallowedFields("resources"))
 	}
 
+	errs = errs.Also(ps.Concurrency.Validate(ctx, ps.Params).ViaField("concurrency"))
+
+	if ps.RerunOf != nil {
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "rerunOf", config.AlphaAPIFields))
+		if ps.RerunOf.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("rerunOf.name"))
+		}
+	}
+
 	return errs
 }
 
@@ -316,23 +335,26 @@ func appendPipelineTaskParams(paramSpecForValidation map[string]ParamSpec, param
 	return paramSpecForValidation
 }
 
-func validateSpecStatus(status PipelineRunSpecStatus) *apis.FieldError {
+func validateSpecStatus(ctx context.Context, status PipelineRunSpecStatus) *apis.FieldError {
 	switch status {
 	case "":
 		return nil
 	case PipelineRunSpecStatusPending:
 		return nil
+	case PipelineRunSpecStatusPaused:
+		return config.ValidateEnabledAPIFields(ctx, PipelineRunSpecStatusPaused, config.AlphaAPIFields)
 	case PipelineRunSpecStatusCancelled,
 		PipelineRunSpecStatusCancelledRunFinally,
 		PipelineRunSpecStatusStoppedRunFinally:
 		return nil
 	}
 
-	return apis.ErrInvalidValue(fmt.Sprintf("%s should be %s, %s, %s or %s", status,
+	return apis.ErrInvalidValue(fmt.Sprintf("%s should be %s, %s, %s, %s or %s", status,
 		PipelineRunSpecStatusCancelled,
 		PipelineRunSpecStatusCancelledRunFinally,
 		PipelineRunSpecStatusStoppedRunFinally,
-		PipelineRunSpecStatusPending), "status")
+		PipelineRunSpecStatusPending,
+		PipelineRunSpecStatusPaused), "status")
 }
 
 func validateTimeoutDuration(field string, d *metav1.Duration) (errs *apis.FieldError) {
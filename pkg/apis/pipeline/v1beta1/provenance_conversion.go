@@ -25,9 +25,16 @@ func (p Provenance) convertTo(ctx context.Context, sink *v1.Provenance) {
 		p.RefSource.convertTo(ctx, &new)
 		sink.RefSource = &new
 	}
+	if p.BaseRefSource != nil {
+		new := v1.RefSource{}
+		p.BaseRefSource.convertTo(ctx, &new)
+		sink.BaseRefSource = &new
+	}
 	if p.FeatureFlags != nil {
 		sink.FeatureFlags = p.FeatureFlags
 	}
+	sink.Attestation = p.Attestation
+	sink.AttestationError = p.AttestationError
 }
 
 func (p *Provenance) convertFrom(ctx context.Context, source v1.Provenance) {
@@ -36,9 +43,16 @@ func (p *Provenance) convertFrom(ctx context.Context, source v1.Provenance) {
 		new.convertFrom(ctx, *source.RefSource)
 		p.RefSource = &new
 	}
+	if source.BaseRefSource != nil {
+		new := RefSource{}
+		new.convertFrom(ctx, *source.BaseRefSource)
+		p.BaseRefSource = &new
+	}
 	if source.FeatureFlags != nil {
 		p.FeatureFlags = source.FeatureFlags
 	}
+	p.Attestation = source.Attestation
+	p.AttestationError = source.AttestationError
 }
 
 func (cs RefSource) convertTo(ctx context.Context, sink *v1.RefSource) {
@@ -37,6 +37,7 @@ func (r TaskResult) convertTo(ctx context.Context, sink *v1.TaskResult) {
 		sink.Value = &v1.ParamValue{}
 		r.Value.convertTo(ctx, sink.Value)
 	}
+	sink.Schema = r.Schema
 }
 
 func (r *TaskResult) convertFrom(ctx context.Context, source v1.TaskResult) {
@@ -54,4 +55,5 @@ func (r *TaskResult) convertFrom(ctx context.Context, source v1.TaskResult) {
 		r.Value = &ParamValue{}
 		r.Value.convertFrom(ctx, *source.Value)
 	}
+	r.Schema = source.Schema
 }
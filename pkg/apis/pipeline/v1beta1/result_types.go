@@ -13,7 +13,11 @@ limitations under the License.
 
 package v1beta1
 
-import "strings"
+import (
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
 
 // TaskResult used to describe the results of a task
 type TaskResult struct {
@@ -38,6 +42,12 @@ type TaskResult struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value *ResultValue `json:"value,omitempty"`
+
+	// Schema is the JSON Schema the value of the result must match.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Schema *v1.JSONSchema `json:"schema,omitempty"`
 }
 
 // TaskRunResult used to describe the results of a task
@@ -54,6 +64,11 @@ type TaskRunResult struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	Value ResultValue `json:"value"`
+
+	// URI is the digest-addressed location of the value of the result when it is
+	// stored in object storage rather than in the status, in which case Value is empty.
+	// +optional
+	URI string `json:"uri,omitempty"`
 }
 
 // TaskRunStepResult is a type alias of TaskRunResult
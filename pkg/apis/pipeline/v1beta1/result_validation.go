@@ -39,6 +39,7 @@ func (tr TaskResult) Validate(ctx context.Context) (errs *apis.FieldError) {
 	case tr.Type != ResultsTypeString:
 		errs = errs.Also(apis.ErrInvalidValue(tr.Type, "type", "type must be string"))
 	}
+	errs = errs.Also(v1.ValidateResultSchema(ctx, tr.Schema, v1.ResultsType(tr.Type)))
 	return errs.Also(tr.validateValue(ctx))
 }
 
@@ -165,5 +165,6 @@ func PipelineTaskResultRefs(pt *PipelineTask) []*ResultRef {
 		expressions, _ := whenExpression.GetVarSubstitutionExpressions()
 		refs = append(refs, NewResultRefs(expressions)...)
 	}
+	refs = append(refs, NewResultRefs(pt.Cache.GetVarSubstitutionExpressions())...)
 	return refs
 }
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+func (rp RetryPolicy) convertTo(ctx context.Context, sink *v1.RetryPolicy) {
+	if rp.Backoff != nil {
+		sink.Backoff = &v1.RetryBackoff{
+			Strategy: v1.RetryBackoffStrategy(rp.Backoff.Strategy),
+			Delay:    rp.Backoff.Delay,
+			MaxDelay: rp.Backoff.MaxDelay,
+			Jitter:   rp.Backoff.Jitter,
+		}
+	}
+	sink.RetryOn = nil
+	for _, rc := range rp.RetryOn {
+		sink.RetryOn = append(sink.RetryOn, v1.RetryCondition{Reason: rc.Reason, ExitCodes: rc.ExitCodes})
+	}
+}
+
+func (rp *RetryPolicy) convertFrom(ctx context.Context, source v1.RetryPolicy) {
+	if source.Backoff != nil {
+		rp.Backoff = &RetryBackoff{
+			Strategy: RetryBackoffStrategy(source.Backoff.Strategy),
+			Delay:    source.Backoff.Delay,
+			MaxDelay: source.Backoff.MaxDelay,
+			Jitter:   source.Backoff.Jitter,
+		}
+	}
+	rp.RetryOn = nil
+	for _, rc := range source.RetryOn {
+		rp.RetryOn = append(rp.RetryOn, RetryCondition{Reason: rc.Reason, ExitCodes: rc.ExitCodes})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// RetryBackoffStrategy defines how the delay between two retries of a TaskRun is computed
+type RetryBackoffStrategy string
+
+const (
+	// RetryBackoffFixed waits the same delay before every retry
+	RetryBackoffFixed RetryBackoffStrategy = "fixed"
+	// RetryBackoffExponential doubles the delay after every retry
+	RetryBackoffExponential RetryBackoffStrategy = "exponential"
+)
+
+// RetryPolicy configures how a failed TaskRun is retried.
+// The number of retries is still set by the `retries` field.
+type RetryPolicy struct {
+	// Backoff configures the delay to wait after a failure before the next retry is started.
+	// If not set, the next retry is started immediately.
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// RetryOn restricts retries to failures matching at least one of the listed conditions.
+	// If empty, every failure except cancellation is retried.
+	// +optional
+	// +listType=atomic
+	RetryOn []RetryCondition `json:"retryOn,omitempty"`
+}
+
+// RetryBackoff defines the delay between two retries of a TaskRun
+type RetryBackoff struct {
+	// Strategy is the strategy used to compute the delay, either "fixed" or "exponential".
+	// Defaults to "fixed".
+	// +optional
+	Strategy RetryBackoffStrategy `json:"strategy,omitempty"`
+
+	// Delay is the delay before the first retry. With the "exponential" strategy,
+	// the delay is doubled for every subsequent retry.
+	// Refer Go's ParseDuration documentation for expected format: https://golang.org/pkg/time/#ParseDuration
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+
+	// MaxDelay caps the delay between two retries.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+
+	// Jitter is the maximum percentage (0-100) of the computed delay that is randomly
+	// added to it, so that TaskRuns failing at the same time are not all retried at once.
+	// +optional
+	Jitter int `json:"jitter,omitempty"`
+}
+
+// RetryCondition describes a TaskRun failure that should be retried.
+// When both Reason and ExitCodes are set, a failure must match both.
+type RetryCondition struct {
+	// Reason matches the reason of the failed TaskRun's Succeeded condition,
+	// e.g. "TaskRunTimeout", "PodEvicted" or "TaskRunImagePullFailed".
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ExitCodes matches when a Step of the failed TaskRun terminated with one of the listed exit codes.
+	// +optional
+	// +listType=atomic
+	ExitCodes []int32 `json:"exitCodes,omitempty"`
+}
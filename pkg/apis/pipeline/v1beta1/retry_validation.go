@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"knative.dev/pkg/apis"
+)
+
+// Validate validates the RetryPolicy of a PipelineTask or TaskRun
+func (rp *RetryPolicy) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if rp == nil {
+		return nil
+	}
+	errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "retryPolicy", config.AlphaAPIFields))
+	if rp.Backoff != nil {
+		errs = errs.Also(rp.Backoff.validate().ViaField("backoff"))
+	}
+	for i, rc := range rp.RetryOn {
+		errs = errs.Also(rc.validate().ViaFieldIndex("retryOn", i))
+	}
+	return errs
+}
+
+// validateRetryPolicy validates the RetryPolicy of a PipelineTask. Only TaskRuns follow a retry policy,
+// so it is rejected for custom tasks, whose CustomRuns are retried right away.
+func (pt PipelineTask) validateRetryPolicy(ctx context.Context) (errs *apis.FieldError) {
+	if pt.RetryPolicy == nil {
+		return nil
+	}
+	errs = errs.Also(pt.RetryPolicy.Validate(ctx).ViaField("retryPolicy"))
+	if pt.TaskRef.IsCustomTask() || pt.TaskSpec.IsCustomTask() {
+		errs = errs.Also(apis.ErrInvalidValue("retryPolicy is not supported for custom tasks", "retryPolicy"))
+	}
+	return errs
+}
+
+func (rb *RetryBackoff) validate() (errs *apis.FieldError) {
+	switch rb.Strategy {
+	case "", RetryBackoffFixed, RetryBackoffExponential:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s should be %s or %s", rb.Strategy, RetryBackoffFixed, RetryBackoffExponential), "strategy"))
+	}
+	if rb.Delay == nil {
+		errs = errs.Also(apis.ErrMissingField("delay"))
+	} else if rb.Delay.Duration < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(rb.Delay.Duration.String()+" should be >= 0", "delay"))
+	}
+	if rb.MaxDelay != nil {
+		if rb.MaxDelay.Duration < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(rb.MaxDelay.Duration.String()+" should be >= 0", "maxDelay"))
+		} else if rb.Delay != nil && rb.MaxDelay.Duration < rb.Delay.Duration {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s should be >= delay %s", rb.MaxDelay.Duration, rb.Delay.Duration), "maxDelay"))
+		}
+	}
+	if rb.Jitter < 0 || rb.Jitter > 100 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(rb.Jitter, 0, 100, "jitter"))
+	}
+	return errs
+}
+
+func (rc RetryCondition) validate() *apis.FieldError {
+	if rc.Reason == "" && len(rc.ExitCodes) == 0 {
+		return apis.ErrMissingOneOf("reason", "exitCodes")
+	}
+	if rc.Reason == TaskRunReasonCancelled.String() {
+		return apis.ErrInvalidValue(fmt.Sprintf("%s is never retried", rc.Reason), "reason")
+	}
+	return nil
+}
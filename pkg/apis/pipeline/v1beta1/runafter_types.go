@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// RunAfterTriggerCondition is the outcome of a PipelineTask of runAfter which triggers a PipelineTask
+type RunAfterTriggerCondition string
+
+const (
+	// RunAfterOnSuccess triggers the PipelineTask when the PipelineTask of runAfter succeeded
+	RunAfterOnSuccess RunAfterTriggerCondition = "onSuccess"
+	// RunAfterOnFailure triggers the PipelineTask only when the PipelineTask of runAfter failed
+	RunAfterOnFailure RunAfterTriggerCondition = "onFailure"
+	// RunAfterAlways triggers the PipelineTask once the PipelineTask of runAfter is done, whatever its outcome
+	RunAfterAlways RunAfterTriggerCondition = "always"
+)
+
+// RunAfterTrigger sets the outcome of a PipelineTask of runAfter which triggers a PipelineTask
+type RunAfterTrigger struct {
+	// Task is the name of a PipelineTask listed in runAfter
+	Task string `json:"task"`
+	// Condition is the outcome of Task which triggers the PipelineTask: onSuccess, onFailure or always
+	Condition RunAfterTriggerCondition `json:"condition"`
+}
@@ -34,4 +34,9 @@ func (ss *StepActionSpec) SetDefaults(ctx context.Context) {
 	for i := range ss.Results {
 		ss.Results[i].SetDefaults(ctx)
 	}
+	for i := range ss.Steps {
+		for j := range ss.Steps[i].Results {
+			ss.Steps[i].Results[j].SetDefaults(ctx)
+		}
+	}
 }
@@ -172,6 +172,27 @@ type StepActionSpec struct {
 	// +patchStrategy=merge
 	// +listType=atomic
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty" patchMergeKey:"mountPath" patchStrategy:"merge" protobuf:"bytes,9,rep,name=volumeMounts"`
+	// Steps makes this a composite StepAction: a Step referencing it is expanded
+	// into these Steps, which run in order. The Params of the StepAction are
+	// substituted in the Steps, and its Results are written by the last Step.
+	// A composite StepAction cannot set the Image, Command, Args, Env, Script,
+	// WorkingDir, SecurityContext or VolumeMounts of a single Step.
+	// +optional
+	// +listType=atomic
+	Steps []v1.Step `json:"steps,omitempty"`
+	// Sidecars are added to the Task of a Step referencing this composite StepAction.
+	// +optional
+	// +listType=atomic
+	Sidecars []v1.Sidecar `json:"sidecars,omitempty"`
+	// Volumes are added to the Task of a Step referencing this composite StepAction.
+	// +optional
+	// +listType=atomic
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+}
+
+// IsComposite returns true if the StepAction expands into several Steps.
+func (ss *StepActionSpec) IsComposite() bool {
+	return len(ss.Steps) > 0
 }
 
 // ToStep converts the StepActionSpec to a Step struct
@@ -15,6 +15,7 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
@@ -47,6 +48,15 @@ func (s *StepAction) Validate(ctx context.Context) (errs *apis.FieldError) {
 
 // Validate implements apis.Validatable
 func (ss *StepActionSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if ss.IsComposite() {
+		return ss.validateComposite(ctx)
+	}
+	if len(ss.Sidecars) > 0 {
+		errs = errs.Also(apis.ErrGeneric("sidecars can only be used with steps", "sidecars"))
+	}
+	if len(ss.Volumes) > 0 {
+		errs = errs.Also(apis.ErrGeneric("volumes can only be used with steps", "volumes"))
+	}
 	if ss.Image == "" {
 		errs = errs.Also(apis.ErrMissingField("Image"))
 	}
@@ -74,6 +84,93 @@ func (ss *StepActionSpec) Validate(ctx context.Context) (errs *apis.FieldError)
 	return errs
 }
 
+// validateComposite validates a StepAction that expands into several Steps.
+// The Params of the StepAction may be used in its Steps the same way they are
+// used in a single Step StepAction.
+func (ss *StepActionSpec) validateComposite(ctx context.Context) (errs *apis.FieldError) {
+	errs = config.ValidateEnabledAPIFields(ctx, "composite StepActions", config.AlphaAPIFields)
+
+	var disallowed []string
+	if ss.Image != "" {
+		disallowed = append(disallowed, "image")
+	}
+	if len(ss.Command) > 0 {
+		disallowed = append(disallowed, "command")
+	}
+	if len(ss.Args) > 0 {
+		disallowed = append(disallowed, "args")
+	}
+	if ss.Env != nil {
+		disallowed = append(disallowed, "env")
+	}
+	if ss.Script != "" {
+		disallowed = append(disallowed, "script")
+	}
+	if ss.WorkingDir != "" {
+		disallowed = append(disallowed, "workingDir")
+	}
+	if ss.SecurityContext != nil {
+		disallowed = append(disallowed, "securityContext")
+	}
+	if len(ss.VolumeMounts) > 0 {
+		disallowed = append(disallowed, "volumeMounts")
+	}
+	if len(disallowed) > 0 {
+		err := apis.ErrDisallowedFields(disallowed...)
+		err.Details = "cannot be used with steps"
+		errs = errs.Also(err)
+	}
+
+	errs = errs.Also(v1.ValidateParameterTypes(ctx, ss.Params).ViaField("params"))
+	errs = errs.Also(validateParameterVariables(ctx, *ss, ss.Params))
+	errs = errs.Also(v1.ValidateObjectParamsHaveProperties(ctx, ss.Params))
+	errs = errs.Also(v1.ValidateStepResults(ctx, ss.Results).ViaField("results"))
+
+	_, arrayParams, objectParams := ss.Params.SortByType()
+	allParameterNames := sets.NewString(ss.Params.GetNames()...)
+	arrayParameterNames := sets.NewString(arrayParams.GetNames()...)
+	names := sets.NewString()
+	for i, step := range ss.Steps {
+		if step.Ref != nil {
+			errs = errs.Also(apis.ErrGeneric("the steps of a composite StepAction cannot reference a StepAction", "ref").ViaFieldIndex("steps", i))
+		}
+		switch {
+		case step.Name == "":
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("steps", i))
+		case names.Has(step.Name):
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("step name %q must be unique", step.Name), "name").ViaFieldIndex("steps", i))
+		}
+		names.Insert(step.Name)
+		errs = errs.Also(step.Validate(ctx).ViaFieldIndex("steps", i))
+
+		results := step.Results
+		if i == len(ss.Steps)-1 {
+			results = append(append([]v1.StepResult{}, step.Results...), ss.Results...)
+		}
+		errs = errs.Also(v1.ValidateStepActionResultsVariables(ctx, results, step.Script).ViaFieldIndex("steps", i))
+		if step.Script != "" {
+			errs = errs.Also(validateNoParamSubstitutionsInScript(step.Script).ViaFieldIndex("steps", i))
+		}
+
+		// validate the usage of the StepAction params as if the step was a StepAction of its own
+		sas := StepActionSpec{
+			Image:        step.Image,
+			Command:      step.Command,
+			Args:         step.Args,
+			Env:          step.Env,
+			Script:       step.Script,
+			VolumeMounts: step.VolumeMounts,
+		}
+		errs = errs.Also(validateStepActionVariables(ctx, sas, "params", allParameterNames).ViaFieldIndex("steps", i))
+		errs = errs.Also(ValidateObjectUsage(ctx, sas, objectParams).ViaFieldIndex("steps", i))
+		errs = errs.Also(validateStepActionArrayUsage(sas, "params", arrayParameterNames).ViaFieldIndex("steps", i))
+	}
+	for i, sc := range ss.Sidecars {
+		errs = errs.Also(sc.Validate(ctx).ViaFieldIndex("sidecars", i))
+	}
+	return errs
+}
+
 // validateNoParamSubstitutionsInScript validates that param substitutions are not invoked in the script
 func validateNoParamSubstitutionsInScript(script string) *apis.FieldError {
 	_, present, errString := substitution.ExtractVariablesFromString(script, "params")
@@ -20,6 +20,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"github.com/tektoncd/pipeline/test/diff"
@@ -983,3 +984,143 @@ func TestStepActionSpecValidateError(t *testing.T) {
 		})
 	}
 }
+
+func TestStepActionSpecValidate_Composite(t *testing.T) {
+	sa := v1beta1.StepActionSpec{
+		Params: v1.ParamSpecs{{
+			Name: "package",
+		}},
+		Results: []v1.StepResult{{
+			Name: "binary",
+		}},
+		Steps: []v1.Step{{
+			Name:   "setup",
+			Image:  "golang",
+			Script: "go version",
+		}, {
+			Name:    "build",
+			Image:   "golang",
+			Command: []string{"go", "build", "-o", "$(step.results.binary.path)", "$(params.package)"},
+		}},
+		Sidecars: []v1.Sidecar{{
+			Name:  "registry",
+			Image: "registry",
+		}},
+		Volumes: []corev1.Volume{{
+			Name: "gocache",
+		}},
+	}
+	ctx := cfgtesting.EnableAlphaAPIFields(t.Context())
+	sa.SetDefaults(ctx)
+	if err := sa.Validate(ctx); err != nil {
+		t.Errorf("StepActionSpec.Validate() returned error for valid composite StepAction: %v", err)
+	}
+}
+
+func TestStepActionSpecValidateError_Composite(t *testing.T) {
+	tests := []struct {
+		name          string
+		sa            v1beta1.StepActionSpec
+		wc            func(context.Context) context.Context
+		expectedError apis.FieldError
+	}{{
+		name: "composite step action without alpha",
+		sa: v1beta1.StepActionSpec{
+			Steps: []v1.Step{{
+				Name:  "build",
+				Image: "golang",
+			}},
+		},
+		expectedError: apis.FieldError{
+			Message: `composite StepActions requires "enable-api-fields" feature gate to be "alpha" but it is "beta"`,
+		},
+	}, {
+		name: "single step fields used with steps",
+		sa: v1beta1.StepActionSpec{
+			Image:  "golang",
+			Script: "go build",
+			Steps: []v1.Step{{
+				Name:  "build",
+				Image: "golang",
+			}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+		expectedError: apis.FieldError{
+			Message: `must not set the field(s)`,
+			Paths:   []string{"image", "script"},
+			Details: "cannot be used with steps",
+		},
+	}, {
+		name: "sidecars without steps",
+		sa: v1beta1.StepActionSpec{
+			Image: "golang",
+			Sidecars: []v1.Sidecar{{
+				Name:  "registry",
+				Image: "registry",
+			}},
+		},
+		expectedError: apis.FieldError{
+			Message: `sidecars can only be used with steps`,
+			Paths:   []string{"sidecars"},
+		},
+	}, {
+		name: "unnamed and duplicate steps",
+		sa: v1beta1.StepActionSpec{
+			Steps: []v1.Step{{
+				Image: "golang",
+			}, {
+				Name:  "build",
+				Image: "golang",
+			}, {
+				Name:  "build",
+				Image: "golang",
+			}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+		expectedError: *apis.ErrMissingField("steps[0].name").Also(
+			apis.ErrGeneric(`step name "build" must be unique`, "steps[2].name")),
+	}, {
+		name: "step referencing a step action",
+		sa: v1beta1.StepActionSpec{
+			Steps: []v1.Step{{
+				Name: "build",
+				Ref:  &v1.Ref{Name: "go-build"},
+			}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+		expectedError: apis.FieldError{
+			Message: `the steps of a composite StepAction cannot reference a StepAction`,
+			Paths:   []string{"steps[0].ref"},
+		},
+	}, {
+		name: "step using an undeclared param",
+		sa: v1beta1.StepActionSpec{
+			Steps: []v1.Step{{
+				Name:    "build",
+				Image:   "golang",
+				Command: []string{"go", "build", "$(params.package)"},
+			}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+		expectedError: apis.FieldError{
+			Message: `non-existent variable in "$(params.package)"`,
+			Paths:   []string{"steps[0].command[2]"},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tt.wc != nil {
+				ctx = tt.wc(ctx)
+			}
+			tt.sa.SetDefaults(ctx)
+			err := tt.sa.Validate(ctx)
+			if err == nil {
+				t.Fatalf("Expected an error, got nothing for %v", tt.sa)
+			}
+			if d := cmp.Diff(tt.expectedError.Error(), err.Error(), cmpopts.IgnoreUnexported(apis.FieldError{})); d != "" {
+				t.Errorf("StepActionSpec.Validate() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -121,6 +121,11 @@ func (ts *TaskSpec) ConvertTo(ctx context.Context, sink *v1.TaskSpec, meta *meta
 	}
 	sink.DisplayName = ts.DisplayName
 	sink.Description = ts.Description
+	sink.Extends = nil
+	if ts.Extends != nil {
+		sink.Extends = &v1.TaskExtends{}
+		ts.Extends.convertTo(ctx, sink.Extends)
+	}
 	return nil
 }
 
@@ -184,6 +189,11 @@ func (ts *TaskSpec) ConvertFrom(ctx context.Context, source *v1.TaskSpec, meta *
 	}
 	ts.DisplayName = source.DisplayName
 	ts.Description = source.Description
+	ts.Extends = nil
+	if source.Extends != nil {
+		ts.Extends = &TaskExtends{}
+		ts.Extends.convertFrom(ctx, *source.Extends)
+	}
 	return nil
 }
 
@@ -155,6 +155,36 @@ spec:
           - name: revision
             value: main
 `
+	parallelStepsTaskYAML := `
+metadata:
+  name: foo
+  namespace: bar
+spec:
+  steps:
+    - name: lint
+      image: golangci/golangci-lint
+      parallelGroup: checks
+    - name: test
+      image: golang
+      parallelGroup: checks
+`
+	extendsTaskYAML := `
+metadata:
+  name: foo
+  namespace: bar
+spec:
+  extends:
+    resolver: "git"
+    params:
+      - name: url
+        value: https://github.com/tektoncd/catalog.git
+    insertSteps:
+      - step: lint
+        before: build
+  steps:
+    - name: lint
+      image: golangci/golangci-lint
+`
 
 	taskWithAllNoDeprecatedFieldsYAML := `
 metadata:
@@ -359,6 +389,12 @@ spec:
 	remoteStepActionTaskV1beta1 := parse.MustParseV1beta1Task(t, remoteStepActionTaskYAML)
 	remoteStepActionTaskV1 := parse.MustParseV1Task(t, remoteStepActionTaskYAML)
 
+	parallelStepsTaskV1beta1 := parse.MustParseV1beta1Task(t, parallelStepsTaskYAML)
+	parallelStepsTaskV1 := parse.MustParseV1Task(t, parallelStepsTaskYAML)
+
+	extendsTaskV1beta1 := parse.MustParseV1beta1Task(t, extendsTaskYAML)
+	extendsTaskV1 := parse.MustParseV1Task(t, extendsTaskYAML)
+
 	taskWithAllNoDeprecatedFieldsV1beta1 := parse.MustParseV1beta1Task(t, taskWithAllNoDeprecatedFieldsYAML)
 	taskWithAllNoDeprecatedFieldsV1 := parse.MustParseV1Task(t, taskWithAllNoDeprecatedFieldsYAML)
 
@@ -411,6 +447,14 @@ spec:
 		name:        "remote step action in task",
 		v1beta1Task: remoteStepActionTaskV1beta1,
 		v1Task:      remoteStepActionTaskV1,
+	}, {
+		name:        "parallel steps in task",
+		v1beta1Task: parallelStepsTaskV1beta1,
+		v1Task:      parallelStepsTaskV1,
+	}, {
+		name:        "task extending a base task",
+		v1beta1Task: extendsTaskV1beta1,
+		v1Task:      extendsTaskV1,
 	}, {
 		name:        "task conversion deprecated fields",
 		v1beta1Task: taskWithDeprecatedFieldsV1beta1,
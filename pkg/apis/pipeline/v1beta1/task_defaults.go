@@ -31,8 +31,12 @@ func (t *Task) SetDefaults(ctx context.Context) {
 
 // SetDefaults set any defaults for the task spec
 func (ts *TaskSpec) SetDefaults(ctx context.Context) {
-	for i := range ts.Params {
-		ts.Params[i].SetDefaults(ctx)
+	// The params of a Task extending a base Task may only override some fields of the params
+	// of the base Task, so they are defaulted once merged.
+	if ts.Extends == nil {
+		for i := range ts.Params {
+			ts.Params[i].SetDefaults(ctx)
+		}
 	}
 	for i := range ts.Results {
 		ts.Results[i].SetDefaults(ctx)
@@ -78,6 +78,11 @@ func (t *Task) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ts *TaskSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if ts.Extends != nil {
+		// The steps of a Task extending a base Task may be partial, and may use the params,
+		// results and workspaces of the base Task, so the TaskSpec is validated once merged.
+		return ts.validateExtends(ctx)
+	}
 	if len(ts.Steps) == 0 {
 		errs = errs.Also(apis.ErrMissingField("steps"))
 	}
@@ -95,6 +100,7 @@ func (ts *TaskSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	}
 
 	errs = errs.Also(validateSteps(ctx, mergedSteps).ViaField("steps"))
+	errs = errs.Also(validateStepParallelGroups(ctx, ts.Steps).ViaField("steps"))
 	errs = errs.Also(validateSidecarNames(ts.Sidecars))
 	errs = errs.Also(ValidateParameterTypes(ctx, ts.Params).ViaField("params"))
 	errs = errs.Also(ValidateParameterVariables(ctx, ts.Steps, ts.Params))
@@ -148,6 +154,25 @@ func validateResults(ctx context.Context, results []TaskResult) (errs *apis.Fiel
 	return errs
 }
 
+// validateStepParallelGroups validates that the Steps of a parallel group are consecutive.
+func validateStepParallelGroups(ctx context.Context, steps []Step) (errs *apis.FieldError) {
+	seen := sets.NewString()
+	for idx, s := range steps {
+		if s.ParallelGroup == "" {
+			continue
+		}
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "parallelGroup", config.AlphaAPIFields).ViaIndex(idx))
+		if idx > 0 && steps[idx-1].ParallelGroup == s.ParallelGroup {
+			continue
+		}
+		if seen.Has(s.ParallelGroup) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("the Steps of parallel group %q must be consecutive", s.ParallelGroup), "parallelGroup").ViaIndex(idx))
+		}
+		seen.Insert(s.ParallelGroup)
+	}
+	return errs
+}
+
 // a mount path which conflicts with any other declared workspaces, with the explicitly
 // declared volume mounts, or with the stepTemplate. The names must also be unique.
 func validateDeclaredWorkspaces(workspaces []WorkspaceDeclaration, steps []Step, stepTemplate *StepTemplate) (errs *apis.FieldError) {
@@ -472,6 +497,23 @@ func validateStep(ctx context.Context, s Step, names sets.String) (errs *apis.Fi
 		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "step stderr stream support", config.AlphaAPIFields).ViaField("stderrconfig"))
 	}
 
+	// Retries is an alpha feature and will fail validation if it's used in a task spec
+	// when the enable-api-fields feature gate is not "alpha".
+	if s.Retries != 0 || s.RetryDelay != nil {
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "step retries", config.AlphaAPIFields).ViaField("retries"))
+	}
+	if s.Retries < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%d should be >= 0", s.Retries), "retries"))
+	}
+	if s.RetryDelay != nil {
+		if s.RetryDelay.Duration < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(s.RetryDelay.Duration, "retryDelay", "negative retry delay"))
+		}
+		if s.Retries == 0 {
+			errs = errs.Also(apis.ErrGeneric("retryDelay can only be set when retries is set", "retryDelay"))
+		}
+	}
+
 	// Validate usage of step result reference.
 	// Referencing previous step's results are only allowed in `env`, `command` and `args`.
 	errs = errs.Also(validateStepResultReference(s))
@@ -562,6 +604,8 @@ func ValidateParameterVariables(ctx context.Context, steps []Step, params ParamS
 	var errs *apis.FieldError
 	errs = errs.Also(params.validateNoDuplicateNames())
 	errs = errs.Also(params.validateParamEnums(ctx).ViaField("params"))
+	errs = errs.Also(params.validateParamSchemas(ctx).ViaField("params"))
+	errs = errs.Also(params.validateSensitiveParams(ctx).ViaField("params"))
 	stringParams, arrayParams, objectParams := params.sortByType()
 	stringParameterNames := sets.NewString(stringParams.getNames()...)
 	arrayParameterNames := sets.NewString(arrayParams.getNames()...)
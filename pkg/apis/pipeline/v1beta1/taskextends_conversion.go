@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+func (te TaskExtends) convertTo(ctx context.Context, sink *v1.TaskExtends) {
+	te.TaskRef.convertTo(ctx, &sink.TaskRef)
+	sink.InsertSteps = nil
+	for _, insertion := range te.InsertSteps {
+		sink.InsertSteps = append(sink.InsertSteps, v1.StepInsertion{Step: insertion.Step, Before: insertion.Before, After: insertion.After})
+	}
+}
+
+func (te *TaskExtends) convertFrom(ctx context.Context, source v1.TaskExtends) {
+	te.TaskRef.ConvertFrom(ctx, source.TaskRef)
+	te.InsertSteps = nil
+	for _, insertion := range source.InsertSteps {
+		te.InsertSteps = append(te.InsertSteps, StepInsertion{Step: insertion.Step, Before: insertion.Before, After: insertion.After})
+	}
+}
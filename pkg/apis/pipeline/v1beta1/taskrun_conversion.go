@@ -90,7 +90,14 @@ func (trs *TaskRunSpec) ConvertTo(ctx context.Context, sink *v1.TaskRunSpec, met
 	sink.Status = v1.TaskRunSpecStatus(trs.Status)
 	sink.StatusMessage = v1.TaskRunSpecStatusMessage(trs.StatusMessage)
 	sink.Retries = trs.Retries
+	if trs.RetryPolicy != nil {
+		sink.RetryPolicy = &v1.RetryPolicy{}
+		trs.RetryPolicy.convertTo(ctx, sink.RetryPolicy)
+	}
 	sink.Timeout = trs.Timeout
+	if trs.Timeouts != nil {
+		sink.Timeouts = &v1.TaskRunTimeouts{Queue: trs.Timeouts.Queue}
+	}
 	sink.PodTemplate = trs.PodTemplate
 	sink.Workspaces = nil
 	for _, w := range trs.Workspaces {
@@ -173,7 +180,15 @@ func (trs *TaskRunSpec) ConvertFrom(ctx context.Context, source *v1.TaskRunSpec,
 	trs.Status = TaskRunSpecStatus(source.Status)
 	trs.StatusMessage = TaskRunSpecStatusMessage(source.StatusMessage)
 	trs.Retries = source.Retries
+	if source.RetryPolicy != nil {
+		newRetryPolicy := RetryPolicy{}
+		newRetryPolicy.convertFrom(ctx, *source.RetryPolicy)
+		trs.RetryPolicy = &newRetryPolicy
+	}
 	trs.Timeout = source.Timeout
+	if source.Timeouts != nil {
+		trs.Timeouts = &TaskRunTimeouts{Queue: source.Timeouts.Queue}
+	}
 	trs.PodTemplate = source.PodTemplate
 	trs.Workspaces = nil
 	for _, w := range source.Workspaces {
@@ -253,6 +268,7 @@ func (trs *TaskRunStatus) ConvertTo(ctx context.Context, sink *v1.TaskRunStatus,
 	sink.Status = trs.Status
 	sink.PodName = trs.PodName
 	sink.StartTime = trs.StartTime
+	sink.ExecutionStartTime = trs.ExecutionStartTime
 	sink.CompletionTime = trs.CompletionTime
 	sink.Steps = nil
 	for _, ss := range trs.Steps {
@@ -294,6 +310,12 @@ func (trs *TaskRunStatus) ConvertTo(ctx context.Context, sink *v1.TaskRunStatus,
 		trs.Provenance.convertTo(ctx, &new)
 		sink.Provenance = &new
 	}
+	sink.WorkspaceCaches = nil
+	for _, wc := range trs.WorkspaceCaches {
+		new := v1.WorkspaceCacheStatus{}
+		wc.convertTo(ctx, &new)
+		sink.WorkspaceCaches = append(sink.WorkspaceCaches, new)
+	}
 	return nil
 }
 
@@ -302,6 +324,7 @@ func (trs *TaskRunStatus) ConvertFrom(ctx context.Context, source v1.TaskRunStat
 	trs.Status = source.Status
 	trs.PodName = source.PodName
 	trs.StartTime = source.StartTime
+	trs.ExecutionStartTime = source.ExecutionStartTime
 	trs.CompletionTime = source.CompletionTime
 	trs.Steps = nil
 	for _, ss := range source.Steps {
@@ -343,6 +366,12 @@ func (trs *TaskRunStatus) ConvertFrom(ctx context.Context, source v1.TaskRunStat
 		new.convertFrom(ctx, *source.Provenance)
 		trs.Provenance = &new
 	}
+	trs.WorkspaceCaches = nil
+	for _, wc := range source.WorkspaceCaches {
+		new := WorkspaceCacheStatus{}
+		new.convertFrom(ctx, wc)
+		trs.WorkspaceCaches = append(trs.WorkspaceCaches, new)
+	}
 	return nil
 }
 
@@ -351,6 +380,8 @@ func (ss StepState) convertTo(ctx context.Context, sink *v1.StepState) {
 	sink.Name = ss.Name
 	sink.Container = ss.ContainerName
 	sink.ImageID = ss.ImageID
+	sink.Attempts = ss.Attempts
+	sink.AttemptExitCodes = ss.AttemptExitCodes
 	sink.Results = nil
 
 	if ss.Provenance != nil {
@@ -387,6 +418,8 @@ func (ss *StepState) convertFrom(ctx context.Context, source v1.StepState) {
 	ss.Name = source.Name
 	ss.ContainerName = source.Container
 	ss.ImageID = source.ImageID
+	ss.Attempts = source.Attempts
+	ss.AttemptExitCodes = source.AttemptExitCodes
 	ss.Results = nil
 	for _, r := range source.Results {
 		new := TaskRunStepResult{}
@@ -416,6 +449,7 @@ func (trr TaskRunResult) convertTo(ctx context.Context, sink *v1.TaskRunResult)
 	newValue := v1.ParamValue{}
 	trr.Value.convertTo(ctx, &newValue)
 	sink.Value = newValue
+	sink.URI = trr.URI
 }
 
 func (trr *TaskRunResult) convertFrom(ctx context.Context, source v1.TaskRunResult) {
@@ -424,6 +458,7 @@ func (trr *TaskRunResult) convertFrom(ctx context.Context, source v1.TaskRunResu
 	newValue := ParamValue{}
 	newValue.convertFrom(ctx, source.Value)
 	trr.Value = newValue
+	trr.URI = source.URI
 }
 
 func (t *TaskRunStepArtifact) convertFrom(ctx context.Context, source v1.TaskRunStepArtifact) {
@@ -178,6 +178,9 @@ func TestTaskRunConversion(t *testing.T) {
 					Status:        "test-task-run-spec-status",
 					StatusMessage: v1beta1.TaskRunSpecStatusMessage("test-status-message"),
 					Timeout:       &metav1.Duration{Duration: 5 * time.Second},
+					Timeouts: &v1beta1.TaskRunTimeouts{
+						Queue: &metav1.Duration{Duration: 1 * time.Minute},
+					},
 					PodTemplate: &pod.Template{
 						NodeSelector: map[string]string{
 							"label": "value",
@@ -236,6 +239,41 @@ func TestTaskRunConversion(t *testing.T) {
 								},
 								VolumeAttributes: map[string]string{"key": "attribute-val"},
 							},
+						}, {
+							Name: "workspace-transfer",
+							Transfer: &v1beta1.TransferWorkspace{
+								From: []string{"s3://results/workspaces/foo/pr-fetch/source.tar.gz"},
+								To:   "s3://results/workspaces/foo/pr-build/source.tar.gz",
+							},
+						}, {
+							Name: "workspace-cache",
+							Cache: &v1beta1.WorkspaceCache{
+								Key:         "go-mod-$(hash)",
+								HashFiles:   []string{"source/go.sum"},
+								RestoreKeys: []string{"go-mod-"},
+							},
+						}, {
+							Name: "workspace-ephemeral",
+							Ephemeral: &corev1.EphemeralVolumeSource{
+								VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+									Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+								},
+							},
+						}, {
+							Name: "workspace-image",
+							Image: &corev1.ImageVolumeSource{
+								Reference:  "ghcr.io/tektoncd/toolchain:v1",
+								PullPolicy: corev1.PullIfNotPresent,
+							},
+						}, {
+							Name: "workspace-retained",
+							VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+								Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+							},
+							RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{
+								Policy: v1beta1.VolumeClaimRetentionRetainOnFailure,
+								TTL:    &metav1.Duration{Duration: 24 * time.Hour},
+							},
 						},
 					},
 					StepOverrides: []v1beta1.TaskRunStepOverride{{
@@ -269,9 +307,10 @@ func TestTaskRunConversion(t *testing.T) {
 						ObservedGeneration: 1,
 					},
 					TaskRunStatusFields: v1beta1.TaskRunStatusFields{
-						PodName:        "pod-name",
-						StartTime:      &metav1.Time{Time: time.Now()},
-						CompletionTime: &metav1.Time{Time: time.Now().Add(1 * time.Minute)},
+						PodName:            "pod-name",
+						StartTime:          &metav1.Time{Time: time.Now()},
+						ExecutionStartTime: &metav1.Time{Time: time.Now().Add(10 * time.Second)},
+						CompletionTime:     &metav1.Time{Time: time.Now().Add(1 * time.Minute)},
 						Steps: []v1beta1.StepState{{
 							ContainerState: corev1.ContainerState{
 								Terminated: &corev1.ContainerStateTerminated{
@@ -304,6 +343,12 @@ func TestTaskRunConversion(t *testing.T) {
 							Type:  v1beta1.ResultsTypeObject,
 							Value: *v1beta1.NewObject(map[string]string{"hello": "world"}),
 						}},
+						WorkspaceCaches: []v1beta1.WorkspaceCacheStatus{{
+							Name:        "workspace-cache",
+							Key:         "go-mod-0123abcd",
+							RestoredKey: "go-mod-4567cdef",
+							Outcome:     v1beta1.WorkspaceCachePartialHit,
+						}},
 						TaskSpec: &v1beta1.TaskSpec{
 							Description: "test",
 							Steps: []v1beta1.Step{{
@@ -64,10 +64,20 @@ type TaskRunSpec struct {
 	// Retries represents how many times this TaskRun should be retried in the event of Task failure.
 	// +optional
 	Retries int `json:"retries,omitempty"`
+	// RetryPolicy configures the delay between retries and which failures are retried.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 	// Time after which one retry attempt times out. Defaults to 1 hour.
 	// Refer Go's ParseDuration documentation for expected format: https://golang.org/pkg/time/#ParseDuration
 	// +optional
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Timeouts bounds the phases of the TaskRun more granularly than Timeout.
+	// This is an alpha field. You must set the "enable-api-fields" feature flag to "alpha"
+	// for this field to be supported.
+	// +optional
+	Timeouts *TaskRunTimeouts `json:"timeouts,omitempty"`
 	// PodTemplate holds pod specific configuration
 	PodTemplate *pod.PodTemplate `json:"podTemplate,omitempty"`
 	// Workspaces is a list of WorkspaceBindings from volumes to workspaces.
@@ -98,6 +108,16 @@ type TaskRunSpec struct {
 	ManagedBy *string `json:"managedBy,omitempty"`
 }
 
+// TaskRunTimeouts allows more granular timeouts of the TaskRun than Timeout.
+type TaskRunTimeouts struct {
+	// Queue sets the maximum allowed duration between the start of the TaskRun and the start
+	// of its first Step, while its Pod is pending, unschedulable or waiting for quota. When set,
+	// Timeout counts down from the start of the first Step instead of the start of the TaskRun.
+	// A queue timeout of 0 does not bound the time the TaskRun waits.
+	// +optional
+	Queue *metav1.Duration `json:"queue,omitempty"`
+}
+
 // TaskRunSpecStatus defines the TaskRun spec status the user can provide
 type TaskRunSpecStatus string
 
@@ -218,6 +238,8 @@ const (
 	TaskRunReasonCancelled TaskRunReason = "TaskRunCancelled"
 	// TaskRunReasonTimedOut is the reason set when one TaskRun execution has timed out
 	TaskRunReasonTimedOut TaskRunReason = "TaskRunTimeout"
+	// TaskRunReasonQueueTimedOut is the reason set when the first Step of the TaskRun did not start within its queue timeout
+	TaskRunReasonQueueTimedOut TaskRunReason = "TaskRunQueueTimeout"
 	// TaskRunReasonResolvingTaskRef indicates that the TaskRun is waiting for
 	// its taskRef to be asynchronously resolved.
 	TaskRunReasonResolvingTaskRef = "ResolvingTaskRef"
@@ -291,6 +313,11 @@ type TaskRunStatusFields struct {
 	// StartTime is the time the build is actually started.
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
+	// ExecutionStartTime is the time the first Step of the TaskRun started, once its Pod was
+	// scheduled and its init containers completed.
+	// +optional
+	ExecutionStartTime *metav1.Time `json:"executionStartTime,omitempty"`
+
 	// CompletionTime is the time the build completed.
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 
@@ -347,6 +374,11 @@ type TaskRunStatusFields struct {
 
 	// SpanContext contains tracing span context fields
 	SpanContext map[string]string `json:"spanContext,omitempty"`
+
+	// WorkspaceCaches reports how the cache workspaces of the TaskRun were restored.
+	// +optional
+	// +listType=atomic
+	WorkspaceCaches []WorkspaceCacheStatus `json:"workspaceCaches,omitempty"`
 }
 
 // TaskRunStepOverride is used to override the values of a Step in the corresponding Task.
@@ -416,6 +448,11 @@ type StepState struct {
 	Provenance            *Provenance           `json:"provenance,omitempty"`
 	Inputs                []TaskRunStepArtifact `json:"inputs,omitempty"`
 	Outputs               []TaskRunStepArtifact `json:"outputs,omitempty"`
+	// Attempts is the number of times the Step ran, when the Step has retries.
+	Attempts int32 `json:"attempts,omitempty"`
+	// AttemptExitCodes are the exit codes of the attempts of the Step, when the Step has retries.
+	// +listType=atomic
+	AttemptExitCodes []int32 `json:"attemptExitCodes,omitempty"`
 }
 
 // SidecarState reports the results of running a sidecar in a Task.
@@ -116,10 +116,19 @@ func (ts *TaskRunSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 		errs = errs.Also(validatePodTemplateEnv(ctx, *ts.PodTemplate))
 	}
 
+	errs = errs.Also(ts.RetryPolicy.Validate(ctx).ViaField("retryPolicy"))
+
 	if ts.Timeout != nil && ts.Timeout.Duration < 0 {
 		errs = errs.Also(apis.ErrInvalidValue(ts.Timeout.Duration.String()+" should be >= 0", "timeout"))
 	}
 
+	if ts.Timeouts != nil && ts.Timeouts.Queue != nil {
+		errs = errs.Also(config.ValidateEnabledAPIFields(ctx, "timeouts.queue", config.AlphaAPIFields))
+		if ts.Timeouts.Queue.Duration < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(ts.Timeouts.Queue.Duration.String()+" should be >= 0", "timeouts.queue"))
+		}
+	}
+
 	if ts.Resources != nil {
 		errs = errs.Also(apis.ErrDisallowedFields("resources"))
 	}
@@ -311,8 +320,11 @@ func ValidateWorkspaceBindings(ctx context.Context, wb []WorkspaceBinding) (errs
 // ValidateParameters makes sure the params for the Task are valid.
 func ValidateParameters(ctx context.Context, params Params) (errs *apis.FieldError) {
 	var names []string
-	for _, p := range params {
+	for i, p := range params {
 		names = append(names, p.Name)
+		if p.ValueFrom != nil {
+			errs = errs.Also(p.ValueFrom.Validate(ctx).ViaField("valueFrom").ViaIndex(i))
+		}
 	}
 	return errs.Also(validateNoDuplicateNames(names, false))
 }
@@ -593,6 +593,18 @@ func TestTaskRunSpec_Invalidate(t *testing.T) {
 			Timeout: &metav1.Duration{Duration: -48 * time.Hour},
 		},
 		wantErr: apis.ErrInvalidValue("-48h0m0s should be >= 0", "timeout"),
+	}, {
+		name: "negative queue timeout",
+		spec: v1beta1.TaskRunSpec{
+			TaskRef: &v1beta1.TaskRef{
+				Name: "taskrefname",
+			},
+			Timeouts: &v1beta1.TaskRunTimeouts{
+				Queue: &metav1.Duration{Duration: -5 * time.Minute},
+			},
+		},
+		wc:      cfgtesting.EnableAlphaAPIFields,
+		wantErr: apis.ErrInvalidValue("-5m0s should be >= 0", "timeouts.queue"),
 	}, {
 		name: "wrong taskrun cancel",
 		spec: v1beta1.TaskRunSpec{
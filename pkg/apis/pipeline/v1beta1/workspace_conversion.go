@@ -82,6 +82,17 @@ func (w WorkspaceBinding) convertTo(ctx context.Context, sink *v1.WorkspaceBindi
 	sink.Secret = w.Secret
 	sink.Projected = w.Projected
 	sink.CSI = w.CSI
+	sink.Ephemeral = w.Ephemeral
+	sink.Image = w.Image
+	if w.Transfer != nil {
+		sink.Transfer = &v1.TransferWorkspace{From: w.Transfer.From, To: w.Transfer.To}
+	}
+	if w.Cache != nil {
+		sink.Cache = &v1.WorkspaceCache{Key: w.Cache.Key, HashFiles: w.Cache.HashFiles, RestoreKeys: w.Cache.RestoreKeys}
+	}
+	if w.RetentionPolicy != nil {
+		sink.RetentionPolicy = &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetention(w.RetentionPolicy.Policy), TTL: w.RetentionPolicy.TTL}
+	}
 }
 
 // ConvertFrom converts v1beta1 Param from v1 Param
@@ -95,4 +106,29 @@ func (w *WorkspaceBinding) ConvertFrom(ctx context.Context, source v1.WorkspaceB
 	w.Secret = source.Secret
 	w.Projected = source.Projected
 	w.CSI = source.CSI
+	w.Ephemeral = source.Ephemeral
+	w.Image = source.Image
+	if source.Transfer != nil {
+		w.Transfer = &TransferWorkspace{From: source.Transfer.From, To: source.Transfer.To}
+	}
+	if source.Cache != nil {
+		w.Cache = &WorkspaceCache{Key: source.Cache.Key, HashFiles: source.Cache.HashFiles, RestoreKeys: source.Cache.RestoreKeys}
+	}
+	if source.RetentionPolicy != nil {
+		w.RetentionPolicy = &VolumeClaimRetentionPolicy{Policy: VolumeClaimRetention(source.RetentionPolicy.Policy), TTL: source.RetentionPolicy.TTL}
+	}
+}
+
+func (s WorkspaceCacheStatus) convertTo(ctx context.Context, sink *v1.WorkspaceCacheStatus) {
+	sink.Name = s.Name
+	sink.Key = s.Key
+	sink.RestoredKey = s.RestoredKey
+	sink.Outcome = v1.WorkspaceCacheOutcome(s.Outcome)
+}
+
+func (s *WorkspaceCacheStatus) convertFrom(ctx context.Context, source v1.WorkspaceCacheStatus) {
+	s.Name = source.Name
+	s.Key = source.Key
+	s.RestoredKey = source.RestoredKey
+	s.Outcome = WorkspaceCacheOutcome(source.Outcome)
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // WorkspaceDeclaration is a declaration of a volume that a Task requires.
@@ -86,6 +87,109 @@ type WorkspaceBinding struct {
 	// CSI (Container Storage Interface) represents ephemeral storage that is handled by certain external CSI drivers.
 	// +optional
 	CSI *corev1.CSIVolumeSource `json:"csi,omitempty"`
+	// Ephemeral represents a volume provisioned from a claim template for the Pod of each TaskRun,
+	// which is deleted along with the Pod.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Ephemeral *corev1.EphemeralVolumeSource `json:"ephemeral,omitempty"`
+	// Image represents the read-only content of an OCI image or artifact. It is extracted by an init
+	// container into an emptyDir on clusters without the ImageVolume feature.
+	// +optional
+	Image *corev1.ImageVolumeSource `json:"image,omitempty"`
+	// Transfer represents an emptyDir in each TaskRun, whose content is moved between the
+	// TaskRuns of a PipelineRun through object storage instead of a shared volume.
+	// +optional
+	Transfer *TransferWorkspace `json:"transfer,omitempty"`
+	// Cache represents an emptyDir in each TaskRun, restored before the Steps run from an
+	// archive saved by an earlier TaskRun of the namespace, and saved once they complete.
+	// +optional
+	Cache *WorkspaceCache `json:"cache,omitempty"`
+	// RetentionPolicy describes whether the claim created from VolumeClaimTemplate is deleted
+	// or retained once the run completes. By default, the claim is deleted with the run.
+	// +optional
+	RetentionPolicy *VolumeClaimRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// VolumeClaimRetention is what happens to the claim created from a volumeClaimTemplate once the run completes.
+type VolumeClaimRetention string
+
+const (
+	// VolumeClaimRetentionDelete deletes the claim once the run completes.
+	VolumeClaimRetentionDelete VolumeClaimRetention = "Delete"
+	// VolumeClaimRetentionRetainOnFailure retains the claim when the run fails, and deletes it otherwise.
+	VolumeClaimRetentionRetainOnFailure VolumeClaimRetention = "RetainOnFailure"
+	// VolumeClaimRetentionRetain retains the claim once the run completes.
+	VolumeClaimRetentionRetain VolumeClaimRetention = "Retain"
+)
+
+// VolumeClaimRetentionPolicy describes what happens to the claim created from a volumeClaimTemplate
+// once the run completes. A retained claim no longer has the run as owner, and is labeled with
+// the name of the run and of the workspace so that other runs can bind it.
+type VolumeClaimRetentionPolicy struct {
+	// Policy is one of Delete, RetainOnFailure or Retain.
+	Policy VolumeClaimRetention `json:"policy"`
+	// TTL is how long a retained claim is kept before the controller deletes it.
+	// Retained claims are kept until deleted by hand when unset.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// TransferWorkspace describes the snapshots of a workspace bound with transfer. Both fields
+// are set by the PipelineRun controller on the TaskRuns it creates, following the order
+// of the PipelineTasks, and are left empty in PipelineRuns.
+type TransferWorkspace struct {
+	// From lists the URIs of the snapshots extracted, in order, into the workspace before the Steps run.
+	// +optional
+	// +listType=atomic
+	From []string `json:"from,omitempty"`
+	// To is the URI the snapshot of the workspace is uploaded to once the Steps complete.
+	// +optional
+	To string `json:"to,omitempty"`
+}
+
+// WorkspaceCache describes the archive a workspace bound with cache is restored from and saved to.
+// The archives of a namespace are kept in the bucket results are stored in.
+type WorkspaceCache struct {
+	// Key identifies the archive of the workspace. "$(hash)" in the key is replaced by the
+	// SHA-256 digest of the files matching HashFiles.
+	Key string `json:"key"`
+	// HashFiles are the glob patterns of the files hashed into the key, as <workspace>/<pattern>
+	// where <workspace> is another workspace of the TaskRun.
+	// +optional
+	// +listType=atomic
+	HashFiles []string `json:"hashFiles,omitempty"`
+	// RestoreKeys are the prefixes of the keys of the archives the workspace is restored from,
+	// tried in order, when there is no archive for the key. The most recently used archive
+	// matching a prefix is restored, and the workspace is saved under the key.
+	// +optional
+	// +listType=atomic
+	RestoreKeys []string `json:"restoreKeys,omitempty"`
+}
+
+// WorkspaceCacheOutcome is how a cache workspace was restored.
+type WorkspaceCacheOutcome string
+
+const (
+	// WorkspaceCacheHit means that the archive of the key was restored.
+	WorkspaceCacheHit WorkspaceCacheOutcome = "Hit"
+	// WorkspaceCachePartialHit means that an archive matching one of the restore keys was restored.
+	WorkspaceCachePartialHit WorkspaceCacheOutcome = "PartialHit"
+	// WorkspaceCacheMiss means that no archive was restored.
+	WorkspaceCacheMiss WorkspaceCacheOutcome = "Miss"
+)
+
+// WorkspaceCacheStatus reports how a cache workspace of a TaskRun was restored.
+type WorkspaceCacheStatus struct {
+	// Name is the name of the workspace.
+	Name string `json:"name"`
+	// Key is the key of the archive of the workspace, after "$(hash)" is replaced.
+	Key string `json:"key"`
+	// RestoredKey is the key of the archive the workspace was restored from, if any.
+	// +optional
+	RestoredKey string `json:"restoredKey,omitempty"`
+	// Outcome is how the workspace was restored.
+	Outcome WorkspaceCacheOutcome `json:"outcome"`
 }
 
 // WorkspacePipelineDeclaration creates a named slot in a Pipeline that a PipelineRun
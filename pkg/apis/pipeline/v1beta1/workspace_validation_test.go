@@ -19,7 +19,9 @@ package v1beta1_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -109,6 +111,58 @@ func TestWorkspaceBindingValidateValid(t *testing.T) {
 				Driver: "my-csi",
 			},
 		},
+	}, {
+		name: "Valid ephemeral",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			Ephemeral: &corev1.EphemeralVolumeSource{
+				VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+					Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+				},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Valid image",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			Image: &corev1.ImageVolumeSource{
+				Reference:  "ghcr.io/tektoncd/toolchain:v1",
+				PullPolicy: corev1.PullIfNotPresent,
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Valid transfer",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:     "beth",
+			Transfer: &v1beta1.TransferWorkspace{},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Valid cache",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			Cache: &v1beta1.WorkspaceCache{
+				Key:         "go-mod-$(hash)",
+				HashFiles:   []string{"source/go.sum", "source/*/go.sum"},
+				RestoreKeys: []string{"go-mod-"},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Valid volumeClaimTemplate retained on failure",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+			},
+			RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{
+				Policy: v1beta1.VolumeClaimRetentionRetainOnFailure,
+				TTL:    &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := t.Context()
@@ -176,6 +230,179 @@ func TestWorkspaceBindingValidateInvalid(t *testing.T) {
 				Driver: "",
 			},
 		},
+	}, {
+		name: "Provide ephemeral without alpha API fields",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:      "beth",
+			Ephemeral: &corev1.EphemeralVolumeSource{VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{}},
+		},
+	}, {
+		name: "Provide ephemeral without a claim template",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:      "beth",
+			Ephemeral: &corev1.EphemeralVolumeSource{},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide image without alpha API fields",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Image: &corev1.ImageVolumeSource{Reference: "ghcr.io/tektoncd/toolchain:v1"},
+		},
+	}, {
+		name: "Provide image without a reference",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Image: &corev1.ImageVolumeSource{},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide image with an invalid pull policy",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Image: &corev1.ImageVolumeSource{Reference: "ghcr.io/tektoncd/toolchain:v1", PullPolicy: "Sometimes"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide both image and emptydir",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:     "beth",
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+			Image:    &corev1.ImageVolumeSource{Reference: "ghcr.io/tektoncd/toolchain:v1"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide transfer without alpha API fields",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:     "beth",
+			Transfer: &v1beta1.TransferWorkspace{},
+		},
+	}, {
+		name: "Provide both transfer and emptydir",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:     "beth",
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+			Transfer: &v1beta1.TransferWorkspace{},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide cache without alpha API fields",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{Key: "go-mod"},
+		},
+	}, {
+		name: "Provide cache without key",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide cache with a key with a slash",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{Key: "go/mod"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide cache with $(hash) in key without hashFiles",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{Key: "go-mod-$(hash)"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide cache with hashFiles without $(hash) in key",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{Key: "go-mod", HashFiles: []string{"source/go.sum"}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide cache with hashFiles without workspace",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{Key: "go-mod-$(hash)", HashFiles: []string{"go.sum"}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide cache with hashFiles out of the workspace",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{Key: "go-mod-$(hash)", HashFiles: []string{"source/../go.sum"}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide cache with invalid restoreKeys",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:  "beth",
+			Cache: &v1beta1.WorkspaceCache{Key: "go-mod", RestoreKeys: []string{"go mod"}},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide retention policy without alpha API fields",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+			},
+			RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{Policy: v1beta1.VolumeClaimRetentionRetain},
+		},
+	}, {
+		name: "Provide retention policy without volumeClaimTemplate",
+		binding: &v1beta1.WorkspaceBinding{
+			Name:            "beth",
+			EmptyDir:        &corev1.EmptyDirVolumeSource{},
+			RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{Policy: v1beta1.VolumeClaimRetentionRetain},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide retention policy without policy",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+			},
+			RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide retention policy with an unknown policy",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+			},
+			RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{Policy: "Keep"},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide retention policy deleting the claim with a ttl",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+			},
+			RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{
+				Policy: v1beta1.VolumeClaimRetentionDelete,
+				TTL:    &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
+	}, {
+		name: "Provide retention policy with a negative ttl",
+		binding: &v1beta1.WorkspaceBinding{
+			Name: "beth",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{
+				Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+			},
+			RetentionPolicy: &v1beta1.VolumeClaimRetentionPolicy{
+				Policy: v1beta1.VolumeClaimRetentionRetain,
+				TTL:    &metav1.Duration{Duration: -time.Hour},
+			},
+		},
+		wc: cfgtesting.EnableAlphaAPIFields,
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := t.Context()
@@ -31,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -129,6 +130,54 @@ func (in *Artifacts) DeepCopy() *Artifacts {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheWorkspace) DeepCopyInto(out *CacheWorkspace) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheWorkspace.
+func (in *CacheWorkspace) DeepCopy() *CacheWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachedTask) DeepCopyInto(out *CachedTask) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachedTask.
+func (in *CachedTask) DeepCopy() *CachedTask {
+	if in == nil {
+		return nil
+	}
+	out := new(CachedTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CarriedOverTask) DeepCopyInto(out *CarriedOverTask) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CarriedOverTask.
+func (in *CarriedOverTask) DeepCopy() *CarriedOverTask {
+	if in == nil {
+		return nil
+	}
+	out := new(CarriedOverTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChildStatusReference) DeepCopyInto(out *ChildStatusReference) {
 	*out = *in
@@ -240,6 +289,22 @@ func (in Combinations) DeepCopy() Combinations {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Concurrency) DeepCopyInto(out *Concurrency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Concurrency.
+func (in *Concurrency) DeepCopy() *Concurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(Concurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigSource) DeepCopyInto(out *ConfigSource) {
 	*out = *in
@@ -408,6 +473,51 @@ func (in *EmbeddedTask) DeepCopy() *EmbeddedTask {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExcludeParams) DeepCopyInto(out *ExcludeParams) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(Params, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeParams.
+func (in *ExcludeParams) DeepCopy() *ExcludeParams {
+	if in == nil {
+		return nil
+	}
+	out := new(ExcludeParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ExcludeParamsList) DeepCopyInto(out *ExcludeParamsList) {
+	{
+		in := &in
+		*out = make(ExcludeParamsList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludeParamsList.
+func (in ExcludeParamsList) DeepCopy() ExcludeParamsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExcludeParamsList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IncludeParams) DeepCopyInto(out *IncludeParams) {
 	*out = *in
@@ -507,6 +617,18 @@ func (in *Matrix) DeepCopyInto(out *Matrix) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make(ExcludeParamsList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(MatrixFailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -520,10 +642,36 @@ func (in *Matrix) DeepCopy() *Matrix {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatrixFailurePolicy) DeepCopyInto(out *MatrixFailurePolicy) {
+	*out = *in
+	if in.MinSuccessful != nil {
+		in, out := &in.MinSuccessful, &out.MinSuccessful
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatrixFailurePolicy.
+func (in *MatrixFailurePolicy) DeepCopy() *MatrixFailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MatrixFailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Param) DeepCopyInto(out *Param) {
 	*out = *in
 	in.Value.DeepCopyInto(&out.Value)
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(pipelinev1.ParamValueSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -557,6 +705,11 @@ func (in *ParamSpec) DeepCopyInto(out *ParamSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(pipelinev1.JSONSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -685,6 +838,39 @@ func (in *PipelineDeclaredResource) DeepCopy() *PipelineDeclaredResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineImport) DeepCopyInto(out *PipelineImport) {
+	*out = *in
+	if in.PipelineRef != nil {
+		in, out := &in.PipelineRef, &out.PipelineRef
+		*out = new(PipelineRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(Params, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]WorkspacePipelineTaskBinding, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineImport.
+func (in *PipelineImport) DeepCopy() *PipelineImport {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineList) DeepCopyInto(out *PipelineList) {
 	*out = *in
@@ -781,6 +967,11 @@ func (in *PipelineResourceRef) DeepCopy() *PipelineResourceRef {
 func (in *PipelineResult) DeepCopyInto(out *PipelineResult) {
 	*out = *in
 	in.Value.DeepCopyInto(&out.Value)
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(pipelinev1.JSONSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -855,6 +1046,22 @@ func (in *PipelineRunList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineRunRef) DeepCopyInto(out *PipelineRunRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineRunRef.
+func (in *PipelineRunRef) DeepCopy() *PipelineRunRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineRunRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineRunResult) DeepCopyInto(out *PipelineRunResult) {
 	*out = *in
@@ -961,6 +1168,16 @@ func (in *PipelineRunSpec) DeepCopyInto(out *PipelineRunSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(Concurrency)
+		**out = **in
+	}
+	if in.RerunOf != nil {
+		in, out := &in.RerunOf, &out.RerunOf
+		*out = new(PipelineRunRef)
+		**out = **in
+	}
 	return
 }
 
@@ -999,6 +1216,10 @@ func (in *PipelineRunStatusFields) DeepCopyInto(out *PipelineRunStatusFields) {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ExecutionStartTime != nil {
+		in, out := &in.ExecutionStartTime, &out.ExecutionStartTime
+		*out = (*in).DeepCopy()
+	}
 	if in.CompletionTime != nil {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
@@ -1052,6 +1273,16 @@ func (in *PipelineRunStatusFields) DeepCopyInto(out *PipelineRunStatusFields) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CachedTasks != nil {
+		in, out := &in.CachedTasks, &out.CachedTasks
+		*out = make([]CachedTask, len(*in))
+		copy(*out, *in)
+	}
+	if in.CarriedOverTasks != nil {
+		in, out := &in.CarriedOverTasks, &out.CarriedOverTasks
+		*out = make([]CarriedOverTask, len(*in))
+		copy(*out, *in)
+	}
 	if in.ChildReferences != nil {
 		in, out := &in.ChildReferences, &out.ChildReferences
 		*out = make([]ChildStatusReference, len(*in))
@@ -1063,6 +1294,15 @@ func (in *PipelineRunStatusFields) DeepCopyInto(out *PipelineRunStatusFields) {
 		in, out := &in.FinallyStartTime, &out.FinallyStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.PausedTime != nil {
+		in, out := &in.PausedTime, &out.PausedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PausedDuration != nil {
+		in, out := &in.PausedDuration, &out.PausedDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Provenance != nil {
 		in, out := &in.Provenance, &out.Provenance
 		*out = new(Provenance)
@@ -1157,6 +1397,13 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]PipelineImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1190,11 +1437,21 @@ func (in *PipelineTask) DeepCopyInto(out *PipelineTask) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RunAfter != nil {
 		in, out := &in.RunAfter, &out.RunAfter
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RunAfterTriggers != nil {
+		in, out := &in.RunAfterTriggers, &out.RunAfterTriggers
+		*out = make([]RunAfterTrigger, len(*in))
+		copy(*out, *in)
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(PipelineTaskResources)
@@ -1222,6 +1479,11 @@ func (in *PipelineTask) DeepCopyInto(out *PipelineTask) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(TaskCache)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PipelineRef != nil {
 		in, out := &in.PipelineRef, &out.PipelineRef
 		*out = new(PipelineRef)
@@ -1489,6 +1751,11 @@ func (in *Provenance) DeepCopyInto(out *Provenance) {
 		*out = new(RefSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BaseRefSource != nil {
+		in, out := &in.BaseRefSource, &out.BaseRefSource
+		*out = new(RefSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FeatureFlags != nil {
 		in, out := &in.FeatureFlags, &out.FeatureFlags
 		*out = new(config.FeatureFlags)
@@ -1613,6 +1880,97 @@ func (in RetriesStatus) DeepCopy() RetriesStatus {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryCondition) DeepCopyInto(out *RetryCondition) {
+	*out = *in
+	if in.ExitCodes != nil {
+		in, out := &in.ExitCodes, &out.ExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryCondition.
+func (in *RetryCondition) DeepCopy() *RetryCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]RetryCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunAfterTrigger) DeepCopyInto(out *RunAfterTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunAfterTrigger.
+func (in *RunAfterTrigger) DeepCopy() *RunAfterTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RunAfterTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sidecar) DeepCopyInto(out *Sidecar) {
 	*out = *in
@@ -1862,6 +2220,11 @@ func (in *Step) DeepCopyInto(out *Step) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RetryDelay != nil {
+		in, out := &in.RetryDelay, &out.RetryDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1981,6 +2344,27 @@ func (in *StepActionSpec) DeepCopyInto(out *StepActionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]pipelinev1.Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]pipelinev1.Sidecar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1994,6 +2378,22 @@ func (in *StepActionSpec) DeepCopy() *StepActionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepInsertion) DeepCopyInto(out *StepInsertion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepInsertion.
+func (in *StepInsertion) DeepCopy() *StepInsertion {
+	if in == nil {
+		return nil
+	}
+	out := new(StepInsertion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepOutputConfig) DeepCopyInto(out *StepOutputConfig) {
 	*out = *in
@@ -2040,6 +2440,11 @@ func (in *StepState) DeepCopyInto(out *StepState) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AttemptExitCodes != nil {
+		in, out := &in.AttemptExitCodes, &out.AttemptExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2184,6 +2589,49 @@ func (in *TaskBreakpoints) DeepCopy() *TaskBreakpoints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskCache) DeepCopyInto(out *TaskCache) {
+	*out = *in
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]CacheWorkspace, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskCache.
+func (in *TaskCache) DeepCopy() *TaskCache {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskExtends) DeepCopyInto(out *TaskExtends) {
+	*out = *in
+	in.TaskRef.DeepCopyInto(&out.TaskRef)
+	if in.InsertSteps != nil {
+		in, out := &in.InsertSteps, &out.InsertSteps
+		*out = make([]StepInsertion, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskExtends.
+func (in *TaskExtends) DeepCopy() *TaskExtends {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskExtends)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskList) DeepCopyInto(out *TaskList) {
 	*out = *in
@@ -2314,6 +2762,11 @@ func (in *TaskResult) DeepCopyInto(out *TaskResult) {
 		*out = new(ParamValue)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(pipelinev1.JSONSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2556,11 +3009,21 @@ func (in *TaskRunSpec) DeepCopyInto(out *TaskRunSpec) {
 		*out = new(TaskSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(TaskRunTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PodTemplate != nil {
 		in, out := &in.PodTemplate, &out.PodTemplate
 		*out = new(pod.Template)
@@ -2635,6 +3098,10 @@ func (in *TaskRunStatusFields) DeepCopyInto(out *TaskRunStatusFields) {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ExecutionStartTime != nil {
+		in, out := &in.ExecutionStartTime, &out.ExecutionStartTime
+		*out = (*in).DeepCopy()
+	}
 	if in.CompletionTime != nil {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
@@ -2696,6 +3163,11 @@ func (in *TaskRunStatusFields) DeepCopyInto(out *TaskRunStatusFields) {
 			(*out)[key] = val
 		}
 	}
+	if in.WorkspaceCaches != nil {
+		in, out := &in.WorkspaceCaches, &out.WorkspaceCaches
+		*out = make([]WorkspaceCacheStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2726,6 +3198,27 @@ func (in *TaskRunStepOverride) DeepCopy() *TaskRunStepOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunTimeouts) DeepCopyInto(out *TaskRunTimeouts) {
+	*out = *in
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunTimeouts.
+func (in *TaskRunTimeouts) DeepCopy() *TaskRunTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
 	*out = *in
@@ -2779,6 +3272,11 @@ func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Extends != nil {
+		in, out := &in.Extends, &out.Extends
+		*out = new(TaskExtends)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2810,6 +3308,11 @@ func (in *TimeoutFields) DeepCopyInto(out *TimeoutFields) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2823,6 +3326,48 @@ func (in *TimeoutFields) DeepCopy() *TimeoutFields {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferWorkspace) DeepCopyInto(out *TransferWorkspace) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferWorkspace.
+func (in *TransferWorkspace) DeepCopy() *TransferWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeClaimRetentionPolicy) DeepCopyInto(out *VolumeClaimRetentionPolicy) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeClaimRetentionPolicy.
+func (in *VolumeClaimRetentionPolicy) DeepCopy() *VolumeClaimRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeClaimRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Volumes) DeepCopyInto(out *Volumes) {
 	{
@@ -2926,6 +3471,31 @@ func (in *WorkspaceBinding) DeepCopyInto(out *WorkspaceBinding) {
 		*out = new(corev1.CSIVolumeSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ephemeral != nil {
+		in, out := &in.Ephemeral, &out.Ephemeral
+		*out = new(corev1.EphemeralVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(corev1.ImageVolumeSource)
+		**out = **in
+	}
+	if in.Transfer != nil {
+		in, out := &in.Transfer, &out.Transfer
+		*out = new(TransferWorkspace)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(WorkspaceCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(VolumeClaimRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2939,6 +3509,48 @@ func (in *WorkspaceBinding) DeepCopy() *WorkspaceBinding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceCache) DeepCopyInto(out *WorkspaceCache) {
+	*out = *in
+	if in.HashFiles != nil {
+		in, out := &in.HashFiles, &out.HashFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestoreKeys != nil {
+		in, out := &in.RestoreKeys, &out.RestoreKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCache.
+func (in *WorkspaceCache) DeepCopy() *WorkspaceCache {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceCacheStatus) DeepCopyInto(out *WorkspaceCacheStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCacheStatus.
+func (in *WorkspaceCacheStatus) DeepCopy() *WorkspaceCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceDeclaration) DeepCopyInto(out *WorkspaceDeclaration) {
 	*out = *in
@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	pipelinev1alpha1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	scheme "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ApprovalRequestsGetter has a method to return a ApprovalRequestInterface.
+// A group's client should implement this interface.
+type ApprovalRequestsGetter interface {
+	ApprovalRequests(namespace string) ApprovalRequestInterface
+}
+
+// ApprovalRequestInterface has methods to work with ApprovalRequest resources.
+type ApprovalRequestInterface interface {
+	Create(ctx context.Context, approvalRequest *pipelinev1alpha1.ApprovalRequest, opts v1.CreateOptions) (*pipelinev1alpha1.ApprovalRequest, error)
+	Update(ctx context.Context, approvalRequest *pipelinev1alpha1.ApprovalRequest, opts v1.UpdateOptions) (*pipelinev1alpha1.ApprovalRequest, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, approvalRequest *pipelinev1alpha1.ApprovalRequest, opts v1.UpdateOptions) (*pipelinev1alpha1.ApprovalRequest, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*pipelinev1alpha1.ApprovalRequest, error)
+	List(ctx context.Context, opts v1.ListOptions) (*pipelinev1alpha1.ApprovalRequestList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *pipelinev1alpha1.ApprovalRequest, err error)
+	ApprovalRequestExpansion
+}
+
+// approvalRequests implements ApprovalRequestInterface
+type approvalRequests struct {
+	*gentype.ClientWithList[*pipelinev1alpha1.ApprovalRequest, *pipelinev1alpha1.ApprovalRequestList]
+}
+
+// newApprovalRequests returns a ApprovalRequests
+func newApprovalRequests(c *TektonV1alpha1Client, namespace string) *approvalRequests {
+	return &approvalRequests{
+		gentype.NewClientWithList[*pipelinev1alpha1.ApprovalRequest, *pipelinev1alpha1.ApprovalRequestList](
+			"approvalrequests",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *pipelinev1alpha1.ApprovalRequest { return &pipelinev1alpha1.ApprovalRequest{} },
+			func() *pipelinev1alpha1.ApprovalRequestList { return &pipelinev1alpha1.ApprovalRequestList{} },
+		),
+	}
+}
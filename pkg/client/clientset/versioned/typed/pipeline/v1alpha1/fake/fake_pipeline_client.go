@@ -28,6 +28,10 @@ type FakeTektonV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeTektonV1alpha1) ApprovalRequests(namespace string) v1alpha1.ApprovalRequestInterface {
+	return newFakeApprovalRequests(c, namespace)
+}
+
 func (c *FakeTektonV1alpha1) Runs(namespace string) v1alpha1.RunInterface {
 	return newFakeRuns(c, namespace)
 }
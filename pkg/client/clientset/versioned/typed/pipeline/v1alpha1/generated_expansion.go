@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type ApprovalRequestExpansion interface{}
+
 type RunExpansion interface{}
 
 type StepActionExpansion interface{}
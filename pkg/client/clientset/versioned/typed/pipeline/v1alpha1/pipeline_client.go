@@ -28,6 +28,7 @@ import (
 
 type TektonV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ApprovalRequestsGetter
 	RunsGetter
 	StepActionsGetter
 	VerificationPoliciesGetter
@@ -38,6 +39,10 @@ type TektonV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *TektonV1alpha1Client) ApprovalRequests(namespace string) ApprovalRequestInterface {
+	return newApprovalRequests(c, namespace)
+}
+
 func (c *TektonV1alpha1Client) Runs(namespace string) RunInterface {
 	return newRuns(c, namespace)
 }
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ApprovalRequests returns a ApprovalRequestInformer.
+	ApprovalRequests() ApprovalRequestInformer
 	// Runs returns a RunInformer.
 	Runs() RunInformer
 	// StepActions returns a StepActionInformer.
@@ -43,6 +45,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ApprovalRequests returns a ApprovalRequestInformer.
+func (v *version) ApprovalRequests() ApprovalRequestInformer {
+	return &approvalRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Runs returns a RunInformer.
 func (v *version) Runs() RunInformer {
 	return &runInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
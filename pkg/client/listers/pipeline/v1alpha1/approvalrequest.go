@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	pipelinev1alpha1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ApprovalRequestLister helps list ApprovalRequests.
+// All objects returned here must be treated as read-only.
+type ApprovalRequestLister interface {
+	// List lists all ApprovalRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*pipelinev1alpha1.ApprovalRequest, err error)
+	// ApprovalRequests returns an object that can list and get ApprovalRequests.
+	ApprovalRequests(namespace string) ApprovalRequestNamespaceLister
+	ApprovalRequestListerExpansion
+}
+
+// approvalRequestLister implements the ApprovalRequestLister interface.
+type approvalRequestLister struct {
+	listers.ResourceIndexer[*pipelinev1alpha1.ApprovalRequest]
+}
+
+// NewApprovalRequestLister returns a new ApprovalRequestLister.
+func NewApprovalRequestLister(indexer cache.Indexer) ApprovalRequestLister {
+	return &approvalRequestLister{listers.New[*pipelinev1alpha1.ApprovalRequest](indexer, pipelinev1alpha1.Resource("approvalrequest"))}
+}
+
+// ApprovalRequests returns an object that can list and get ApprovalRequests.
+func (s *approvalRequestLister) ApprovalRequests(namespace string) ApprovalRequestNamespaceLister {
+	return approvalRequestNamespaceLister{listers.NewNamespaced[*pipelinev1alpha1.ApprovalRequest](s.ResourceIndexer, namespace)}
+}
+
+// ApprovalRequestNamespaceLister helps list and get ApprovalRequests.
+// All objects returned here must be treated as read-only.
+type ApprovalRequestNamespaceLister interface {
+	// List lists all ApprovalRequests in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*pipelinev1alpha1.ApprovalRequest, err error)
+	// Get retrieves the ApprovalRequest from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*pipelinev1alpha1.ApprovalRequest, error)
+	ApprovalRequestNamespaceListerExpansion
+}
+
+// approvalRequestNamespaceLister implements the ApprovalRequestNamespaceLister
+// interface.
+type approvalRequestNamespaceLister struct {
+	listers.ResourceIndexer[*pipelinev1alpha1.ApprovalRequest]
+}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// ApprovalRequestListerExpansion allows custom methods to be added to
+// ApprovalRequestLister.
+type ApprovalRequestListerExpansion interface{}
+
+// ApprovalRequestNamespaceListerExpansion allows custom methods to be added to
+// ApprovalRequestNamespaceLister.
+type ApprovalRequestNamespaceListerExpansion interface{}
+
 // RunListerExpansion allows custom methods to be added to
 // RunLister.
 type RunListerExpansion interface{}
@@ -24,10 +24,12 @@ import (
 	"log/slog"
 
 	"log"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
@@ -53,6 +55,7 @@ const (
 	breakpointExitSuffix                     = ".breakpointexit"
 	breakpointBeforeStepSuffix               = ".beforestepexit"
 	ResultExtractionMethodTerminationMessage = "termination-message"
+	ResultExtractionMethodObjectStorage      = "object-storage"
 	TerminationReasonSkipped                 = "Skipped"
 	TerminationReasonCancelled               = "Cancelled"
 	TerminationReasonTimeoutExceeded         = "TimeoutExceeded"
@@ -160,6 +163,10 @@ type Entrypointer struct {
 	// set it to "stopAndFail" to indicate the entrypoint to exit the taskRun if the container exits with non zero exit code
 	// set it to "continue" to indicate the entrypoint to continue executing the rest of the steps irrespective of the container exit code
 	OnError string
+	// Retries is the number of times the command is run again when it exits with a non-zero exit code
+	Retries int
+	// RetryDelay is the delay to wait after a failed attempt before running the command again
+	RetryDelay time.Duration
 	// StepMetadataDir is the directory for a step where the step related metadata can be stored
 	StepMetadataDir string
 	// SpireWorkloadAPI connects to spire and does obtains SVID based on taskrun
@@ -168,6 +175,8 @@ type Entrypointer struct {
 	ResultsDirectory string
 	// ResultExtractionMethod is the method using which the controller extracts the results from the task pod.
 	ResultExtractionMethod string
+	// ResultUploader uploads the values of the results when ResultExtractionMethod is object-storage
+	ResultUploader ResultUploader
 
 	// StepWhenExpressions     a list of when expression to decide if the step should be skipped
 	StepWhenExpressions v1.StepWhenExpressions
@@ -177,6 +186,19 @@ type Entrypointer struct {
 	// CompressTerminationMessage enables flate compression of termination messages
 	// to fit more results in the 4KB Kubernetes limit.
 	CompressTerminationMessage bool
+
+	// WorkspaceSnapshots maps the directories of the workspaces bound with transfer to the URIs
+	// their snapshots are uploaded to once the Step completes. It is only set on the last Step.
+	WorkspaceSnapshots map[string]string
+	// WorkspaceCaches maps the directories of the cache workspaces to the files holding the URIs
+	// they are saved to once the Step completes, which only exist when they were not restored
+	// from their key. It is only set on the last Step.
+	WorkspaceCaches map[string]string
+	// SnapshotWaitFiles are the post files of the other Steps of the parallel group of the last Step,
+	// which it waits for before uploading WorkspaceSnapshots and WorkspaceCaches.
+	SnapshotWaitFiles []string
+	// WorkspaceSnapshotter uploads the snapshots of WorkspaceSnapshots and WorkspaceCaches
+	WorkspaceSnapshotter WorkspaceSnapshotter
 }
 
 // Waiter encapsulates waiting for files to exist.
@@ -190,6 +212,18 @@ type Runner interface {
 	Run(ctx context.Context, args ...string) error
 }
 
+// ResultUploader encapsulates uploading the values of results to object storage.
+type ResultUploader interface {
+	// Upload stores the value and returns the URI it can be downloaded from.
+	Upload(ctx context.Context, value []byte) (string, error)
+}
+
+// WorkspaceSnapshotter encapsulates uploading the snapshots of workspaces.
+type WorkspaceSnapshotter interface {
+	// Snapshot uploads the content of dir to the URI.
+	Snapshot(ctx context.Context, dir, uri string) error
+}
+
 // PostWriter encapsulates writing a file when complete.
 type PostWriter interface {
 	// Write writes to the path when complete.
@@ -275,13 +309,15 @@ func (e Entrypointer) Go() error {
 		case err1 != nil:
 			err = err1
 		case allowExec:
-			err = e.Runner.Run(ctx, e.Command...)
+			var attempts []result.RunResult
+			attempts, err = e.runWithRetries(ctx)
+			output = append(output, attempts...)
 		default:
 			slog.Info("Step was skipped due to when expressions were evaluated to false.")
 			output = append(output, e.outputRunResult(TerminationReasonSkipped))
 			e.WritePostFile(e.PostFile, nil)
 			e.WriteExitCodeFile(e.StepMetadataDir, "0")
-			return nil
+			return e.snapshotWorkspaces()
 		}
 	}
 
@@ -341,13 +377,51 @@ func (e Entrypointer) Go() error {
 		}
 	}
 
-	if e.ResultExtractionMethod == ResultExtractionMethodTerminationMessage {
+	if e.resultsInTerminationMessage() {
 		e.appendArtifactOutputs(&output)
 	}
 
+	if err == nil || (e.OnError == ContinueOnError && errors.As(err, &ee)) {
+		if sErr := e.snapshotWorkspaces(); sErr != nil {
+			return sErr
+		}
+	}
+
 	return err
 }
 
+// snapshotWorkspaces uploads the snapshots of the workspaces bound with transfer, so that the
+// TaskRuns which depend on this one can restore them, and saves the cache workspaces.
+func (e Entrypointer) snapshotWorkspaces() error {
+	for _, f := range e.SnapshotWaitFiles {
+		if err := e.Waiter.Wait(context.Background(), f, false, false); err != nil {
+			if errors.Is(err, ErrSkipPreviousStepFailed) {
+				// The TaskRun fails with the other Step, so its workspaces are not snapshotted.
+				return nil
+			}
+			return err
+		}
+	}
+	for _, dir := range slices.Sorted(maps.Keys(e.WorkspaceSnapshots)) {
+		if err := e.WorkspaceSnapshotter.Snapshot(context.Background(), dir, e.WorkspaceSnapshots[dir]); err != nil {
+			return fmt.Errorf("error snapshotting workspace %s: %w", dir, err)
+		}
+	}
+	for _, dir := range slices.Sorted(maps.Keys(e.WorkspaceCaches)) {
+		uri, err := os.ReadFile(e.WorkspaceCaches[dir])
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := e.WorkspaceSnapshotter.Snapshot(context.Background(), dir, strings.TrimSpace(string(uri))); err != nil {
+			return fmt.Errorf("error saving the cache of workspace %s: %w", dir, err)
+		}
+	}
+	return nil
+}
+
 func readArtifacts(fp string, resultType result.ResultType) ([]result.RunResult, error) {
 	file, err := os.ReadFile(fp)
 	if os.IsNotExist(err) {
@@ -453,10 +527,16 @@ func (e Entrypointer) readResultsFromDisk(ctx context.Context, resultDir string,
 		} else if err != nil {
 			return err
 		}
+		value := string(fileContents)
+		if e.ResultExtractionMethod == ResultExtractionMethodObjectStorage {
+			if value, err = e.ResultUploader.Upload(ctx, fileContents); err != nil {
+				return fmt.Errorf("error uploading result %q: %w", resultFile, err)
+			}
+		}
 		// if the file doesn't exist, ignore it
 		output = append(output, result.RunResult{
 			Key:        resultFile,
-			Value:      string(fileContents),
+			Value:      value,
 			ResultType: resultType,
 		})
 	}
@@ -468,7 +548,7 @@ func (e Entrypointer) readResultsFromDisk(ctx context.Context, resultDir string,
 	output = append(output, signed...)
 
 	// push output to termination path
-	if e.ResultExtractionMethod == ResultExtractionMethodTerminationMessage && len(output) != 0 {
+	if e.resultsInTerminationMessage() && len(output) != 0 {
 		if err := e.writeTerminationMessage(e.TerminationPath, output); err != nil {
 			return err
 		}
@@ -476,6 +556,12 @@ func (e Entrypointer) readResultsFromDisk(ctx context.Context, resultDir string,
 	return nil
 }
 
+// resultsInTerminationMessage returns true if the results are written to the termination message,
+// either as values or as the URIs of the values uploaded to object storage.
+func (e Entrypointer) resultsInTerminationMessage() bool {
+	return e.ResultExtractionMethod == ResultExtractionMethodTerminationMessage || e.ResultExtractionMethod == ResultExtractionMethodObjectStorage
+}
+
 // writeTerminationMessage writes results to the termination message path,
 // using compression if enabled.
 func (e Entrypointer) writeTerminationMessage(path string, results []result.RunResult) error {
@@ -497,6 +583,55 @@ func (e Entrypointer) BreakpointExitCode(breakpointExitPostFile string) (int, er
 	return strconv.Atoi(strExitCode)
 }
 
+// runWithRetries runs the command, and runs it again after the RetryDelay as long as it exits
+// with a non-zero exit code and Retries are left. When the step has retries, the number of
+// attempts and the exit codes of the attempts that exited are returned as internal results.
+func (e Entrypointer) runWithRetries(ctx context.Context) ([]result.RunResult, error) {
+	err := e.Runner.Run(ctx, e.Command...)
+	if e.Retries <= 0 {
+		return nil, err
+	}
+	attempts := 1
+	var exitCodes []string
+	for {
+		var ee *exec.ExitError
+		switch {
+		case err == nil:
+			exitCodes = append(exitCodes, "0")
+		case errors.As(err, &ee):
+			exitCodes = append(exitCodes, strconv.Itoa(ee.ExitCode()))
+		}
+		// only a command exiting with a non-zero exit code is retried, not a timeout or a cancellation
+		if ee == nil || attempts > e.Retries {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(e.RetryDelay):
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = ErrContextDeadlineExceeded
+			break
+		}
+		if ctx.Err() != nil {
+			err = ErrContextCanceled
+			break
+		}
+		attempts++
+		slog.Info("Step failed, running it again", slog.Int("exitCode", ee.ExitCode()), slog.Int("attempt", attempts), slog.Int("attempts", e.Retries+1))
+		err = e.Runner.Run(ctx, e.Command...)
+	}
+	return []result.RunResult{{
+		Key:        "Attempts",
+		Value:      strconv.Itoa(attempts),
+		ResultType: result.InternalTektonResultType,
+	}, {
+		Key:        "AttemptExitCodes",
+		Value:      strings.Join(exitCodes, ","),
+		ResultType: result.InternalTektonResultType,
+	}}, err
+}
+
 // WritePostFile write the postfile
 func (e Entrypointer) WritePostFile(postFile string, err error) {
 	if err != nil && postFile != "" {
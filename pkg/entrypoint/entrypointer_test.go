@@ -27,6 +27,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -460,6 +461,172 @@ func TestReadResultsFromDisk(t *testing.T) {
 	}
 }
 
+type fakeResultUploader struct {
+	err error
+}
+
+func (u fakeResultUploader) Upload(_ context.Context, value []byte) (string, error) {
+	if u.err != nil {
+		return "", u.err
+	}
+	return "s3://results/" + string(value), nil
+}
+
+func TestReadResultsFromDisk_ObjectStorage(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "foo"), []byte("hello world"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	terminationPath := filepath.Join(t.TempDir(), "termination")
+	e := Entrypointer{
+		Results:                []string{"foo", "missing"},
+		TerminationPath:        terminationPath,
+		ResultExtractionMethod: ResultExtractionMethodObjectStorage,
+		ResultUploader:         fakeResultUploader{},
+	}
+	if err := e.readResultsFromDisk(t.Context(), dir, result.TaskRunResultType); err != nil {
+		t.Fatalf("readResultsFromDisk() returned unexpected error: %v", err)
+	}
+	msg, err := os.ReadFile(terminationPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger, _ := logging.NewLogger("", "status")
+	got, err := termination.ParseMessage(logger, string(msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []result.RunResult{{
+		Key:        "foo",
+		Value:      "s3://results/hello world",
+		ResultType: result.TaskRunResultType,
+	}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("termination message %s", diff.PrintWantGot(d))
+	}
+
+	e.ResultUploader = fakeResultUploader{err: errors.New("bucket unavailable")}
+	if err := e.readResultsFromDisk(t.Context(), dir, result.TaskRunResultType); err == nil || err.Error() != `error uploading result "foo": bucket unavailable` {
+		t.Errorf("readResultsFromDisk() error = %v", err)
+	}
+}
+
+type fakeWorkspaceSnapshotter struct {
+	snapshots map[string]string
+	err       error
+}
+
+func (s *fakeWorkspaceSnapshotter) Snapshot(_ context.Context, dir, uri string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.snapshots[dir] = uri
+	return nil
+}
+
+func TestEntrypointer_WorkspaceSnapshots(t *testing.T) {
+	snapshots := map[string]string{
+		"/tekton/workspace-transfer/source": "s3://results/workspaces/foo/pr-build/source.tar.gz",
+	}
+	for _, c := range []struct {
+		desc              string
+		runner            Runner
+		onError           string
+		snapshotErr       error
+		snapshotWaitFiles []string
+		skipStep          bool
+		wantSnapshots     map[string]string
+		wantErr           bool
+	}{{
+		desc:          "the step succeeds",
+		runner:        &fakeRunner{},
+		wantSnapshots: snapshots,
+	}, {
+		desc:          "the step fails",
+		runner:        &fakeExitErrorRunner{},
+		wantSnapshots: map[string]string{},
+		wantErr:       true,
+	}, {
+		desc:          "the step fails with onError set to continue",
+		runner:        &fakeExitErrorRunner{},
+		onError:       ContinueOnError,
+		wantSnapshots: snapshots,
+		wantErr:       true,
+	}, {
+		desc:          "the snapshot cannot be uploaded",
+		runner:        &fakeRunner{},
+		snapshotErr:   errors.New("bucket unavailable"),
+		wantSnapshots: map[string]string{},
+		wantErr:       true,
+	}, {
+		desc:              "the other steps of the parallel group complete",
+		runner:            &fakeRunner{},
+		snapshotWaitFiles: []string{"/tekton/run/0/out"},
+		wantSnapshots:     snapshots,
+	}, {
+		desc:              "another step of the parallel group fails",
+		runner:            &fakeRunner{},
+		snapshotWaitFiles: []string{"/tekton/run/0/out"},
+		skipStep:          true,
+		wantSnapshots:     map[string]string{},
+	}} {
+		t.Run(c.desc, func(t *testing.T) {
+			snapshotter := &fakeWorkspaceSnapshotter{snapshots: map[string]string{}, err: c.snapshotErr}
+			waiter := &fakeWaiter{skipStep: c.skipStep}
+			e := Entrypointer{
+				Command:              []string{"echo", "some", "args"},
+				Waiter:               waiter,
+				Runner:               c.runner,
+				PostWriter:           &fakePostWriter{},
+				TerminationPath:      filepath.Join(t.TempDir(), "termination"),
+				StepMetadataDir:      t.TempDir(),
+				OnError:              c.onError,
+				WorkspaceSnapshots:   snapshots,
+				SnapshotWaitFiles:    c.snapshotWaitFiles,
+				WorkspaceSnapshotter: snapshotter,
+			}
+			if err := e.Go(); (err != nil) != c.wantErr {
+				t.Errorf("Go() error = %v, wantErr %t", err, c.wantErr)
+			}
+			if d := cmp.Diff(c.wantSnapshots, snapshotter.snapshots); d != "" {
+				t.Errorf("snapshots %s", diff.PrintWantGot(d))
+			}
+			if len(c.snapshotWaitFiles) > 0 && !c.skipStep && !slices.Contains(waiter.waited, c.snapshotWaitFiles[0]) {
+				t.Errorf("the step did not wait for %v before snapshotting, waited for %v", c.snapshotWaitFiles, waiter.waited)
+			}
+		})
+	}
+}
+
+func TestEntrypointer_WorkspaceCaches(t *testing.T) {
+	// The gomod cache was not restored from its key and is saved, the npm cache was and is not.
+	stateDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(stateDir, "gomod"), []byte("s3://results/caches/foo/go-mod.tar.gz"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	snapshotter := &fakeWorkspaceSnapshotter{snapshots: map[string]string{}}
+	e := Entrypointer{
+		Command:         []string{"echo", "some", "args"},
+		Waiter:          &fakeWaiter{},
+		Runner:          &fakeRunner{},
+		PostWriter:      &fakePostWriter{},
+		TerminationPath: filepath.Join(t.TempDir(), "termination"),
+		StepMetadataDir: t.TempDir(),
+		WorkspaceCaches: map[string]string{
+			"/tekton/workspace-cache/gomod": filepath.Join(stateDir, "gomod"),
+			"/tekton/workspace-cache/npm":   filepath.Join(stateDir, "npm"),
+		},
+		WorkspaceSnapshotter: snapshotter,
+	}
+	if err := e.Go(); err != nil {
+		t.Fatalf("Go() = %v", err)
+	}
+	want := map[string]string{"/tekton/workspace-cache/gomod": "s3://results/caches/foo/go-mod.tar.gz"}
+	if d := cmp.Diff(want, snapshotter.snapshots); d != "" {
+		t.Errorf("snapshots %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestEntrypointer_ReadBreakpointExitCodeFromDisk(t *testing.T) {
 	expectedExitCode := 1
 	// setup test
@@ -579,6 +746,91 @@ func TestEntrypointer_OnError(t *testing.T) {
 	}
 }
 
+func TestEntrypointer_Retries(t *testing.T) {
+	for _, c := range []struct {
+		desc              string
+		retries           int
+		failures          int
+		wantRuns          int
+		wantError         bool
+		wantExitCodes     string
+		wantAttemptsCount string
+	}{{
+		desc:      "no retries",
+		failures:  1,
+		wantRuns:  1,
+		wantError: true,
+	}, {
+		desc:              "succeeds after a retry",
+		retries:           2,
+		failures:          1,
+		wantRuns:          2,
+		wantAttemptsCount: "2",
+		wantExitCodes:     "3,0",
+	}, {
+		desc:              "fails after all the retries",
+		retries:           2,
+		failures:          5,
+		wantRuns:          3,
+		wantError:         true,
+		wantAttemptsCount: "3",
+		wantExitCodes:     "3,3,3",
+	}, {
+		desc:              "succeeds at the first attempt",
+		retries:           2,
+		wantRuns:          1,
+		wantAttemptsCount: "1",
+		wantExitCodes:     "0",
+	}} {
+		t.Run(c.desc, func(t *testing.T) {
+			terminationFile, err := os.CreateTemp(t.TempDir(), "termination")
+			if err != nil {
+				t.Fatalf("unexpected error creating temporary termination file: %v", err)
+			}
+			fr := &fakeFlakyRunner{failures: c.failures}
+			err = Entrypointer{
+				Command:         []string{"npm", "install"},
+				Waiter:          &fakeWaiter{waitCancelDuration: 10 * time.Second}, // the step isn't canceled while retried
+				Runner:          fr,
+				PostWriter:      &fakePostWriter{},
+				TerminationPath: terminationFile.Name(),
+				Retries:         c.retries,
+				RetryDelay:      time.Millisecond,
+			}.Go()
+			if c.wantError != (err != nil) {
+				t.Errorf("Entrypointer.Go() error = %v, wantError %v", err, c.wantError)
+			}
+			if fr.runs != c.wantRuns {
+				t.Errorf("ran the command %d times, want %d", fr.runs, c.wantRuns)
+			}
+
+			fileContents, err := os.ReadFile(terminationFile.Name())
+			if err != nil {
+				t.Fatalf("unexpected error reading the termination file: %v", err)
+			}
+			logger, _ := logging.NewLogger("", "status")
+			results, err := termination.ParseMessage(logger, string(fileContents))
+			if err != nil {
+				t.Fatalf("unexpected error parsing the termination message: %v", err)
+			}
+			got := map[string]string{}
+			for _, r := range results {
+				if r.Key == "Attempts" || r.Key == "AttemptExitCodes" {
+					got[r.Key] = r.Value
+				}
+			}
+			want := map[string]string{}
+			if c.wantAttemptsCount != "" {
+				want["Attempts"] = c.wantAttemptsCount
+				want["AttemptExitCodes"] = c.wantExitCodes
+			}
+			if d := cmp.Diff(want, got); d != "" {
+				t.Errorf("unexpected attempts in the termination message %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestEntrypointerResults(t *testing.T) {
 	for _, c := range []struct {
 		desc, entrypoint, postFile, stepDir, stepDirLink string
@@ -2244,6 +2496,20 @@ func (f *fakeExitErrorRunner) Run(ctx context.Context, args ...string) error {
 	return exec.Command("ls", "/bogus/path").Run()
 }
 
+// fakeFlakyRunner exits with 3 for the given number of failures, then succeeds.
+type fakeFlakyRunner struct {
+	failures int
+	runs     int
+}
+
+func (f *fakeFlakyRunner) Run(ctx context.Context, args ...string) error {
+	f.runs++
+	if f.runs <= f.failures {
+		return exec.Command("sh", "-c", "exit 3").Run()
+	}
+	return nil
+}
+
 type fakeLongRunner struct {
 	runningDuration time.Duration
 	waitingDuration time.Duration
@@ -100,6 +100,58 @@ func GetVirtualLimitRange(namespace string, lister corev1listers.LimitRangeListe
 	return limitRange, nil
 }
 
+// GetPodLevelContainerRequirements returns the resource requirements to set on each container of a Pod
+// with pod-level resources, for the resources set at the pod level and constrained by a "Container"
+// LimitRange. Kubernetes would otherwise apply the LimitRange defaults to each container, and reject
+// the Pod when the sum of their requests exceeded its pod-level requests, or their limits its pod-level limits.
+// For each of these resources:
+// - The request is the LimitRange minimum, or zero if there is none, since the Pod requests are set at the pod level.
+// - The limit is the pod-level limit, capped to the LimitRange maximum. Without a pod-level limit,
+// Kubernetes applies the LimitRange default limit as usual.
+func GetPodLevelContainerRequirements(limitRange *corev1.LimitRange, podResources *corev1.ResourceRequirements) corev1.ResourceRequirements {
+	r := corev1.ResourceRequirements{}
+	if limitRange == nil || podResources == nil {
+		return r
+	}
+	for _, item := range limitRange.Spec.Limits {
+		// Only support LimitTypeContainer
+		if item.Type != corev1.LimitTypeContainer {
+			continue
+		}
+		for _, list := range []corev1.ResourceList{podResources.Requests, podResources.Limits} {
+			for name := range list {
+				if !constrains(item, name) {
+					continue
+				}
+				if r.Requests == nil {
+					r.Requests = corev1.ResourceList{}
+				}
+				r.Requests[name] = compare.MaxRequest(r.Requests[name], item.Min[name])
+				if limit, ok := podResources.Limits[name]; ok {
+					if r.Limits == nil {
+						r.Limits = corev1.ResourceList{}
+					}
+					if max := item.Max[name]; !compare.IsZero(max) {
+						limit = compare.MinLimit(limit, max)
+					}
+					r.Limits[name] = limit
+				}
+			}
+		}
+	}
+	return r
+}
+
+// constrains returns true if the LimitRange item has a minimum, maximum or default for the resource.
+func constrains(item corev1.LimitRangeItem, name corev1.ResourceName) bool {
+	for _, list := range []corev1.ResourceList{item.Min, item.Max, item.Default, item.DefaultRequest} {
+		if !compare.IsZero(list[name]) {
+			return true
+		}
+	}
+	return false
+}
+
 func minOfBetween(a, b, min, max resource.Quantity) resource.Quantity {
 	if compare.IsZero(a) || (&a).Cmp(b) > 0 {
 		return b
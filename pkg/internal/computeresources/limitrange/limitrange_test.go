@@ -285,6 +285,55 @@ func TestGetVirtualLimitRange(t *testing.T) {
 	}
 }
 
+func TestGetPodLevelContainerRequirements(t *testing.T) {
+	podResources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+	}
+	for _, tc := range []struct {
+		description string
+		limitRange  *corev1.LimitRange
+		want        corev1.ResourceRequirements
+	}{{
+		description: "no limitrange",
+	}, {
+		description: "pod limitrange",
+		limitRange: &corev1.LimitRange{Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+			Type: corev1.LimitTypePod,
+			Min:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+		}}}},
+	}, {
+		description: "container limitrange with minimum and maximum",
+		limitRange: &corev1.LimitRange{Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+			Type: corev1.LimitTypeContainer,
+			Min:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		}}}},
+		want: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+	}, {
+		description: "container limitrange with defaults",
+		limitRange: &corev1.LimitRange{Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+			Type:           corev1.LimitTypeContainer,
+			Default:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+			DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		}}}},
+		want: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.Quantity{}, corev1.ResourceMemory: resource.Quantity{}},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+		},
+	}} {
+		t.Run(tc.description, func(t *testing.T) {
+			got := limitrange.GetPodLevelContainerRequirements(tc.limitRange, podResources)
+			if d := cmp.Diff(tc.want, got, compare.ResourceQuantityCmp); d != "" {
+				t.Errorf("Unexpected container requirements: %s", d)
+			}
+		})
+	}
+}
+
 func createResourceList(multiple int) corev1.ResourceList {
 	cpuStr := strconv.Itoa(multiple*100) + "m"
 	memStr := strconv.Itoa(multiple*10) + "Mi"
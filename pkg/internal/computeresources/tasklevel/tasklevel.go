@@ -17,6 +17,8 @@ limitations under the License.
 package tasklevel
 
 import (
+	"strings"
+
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -44,6 +46,47 @@ func ApplyTaskLevelComputeResources(steps []v1.Step, computeResources *corev1.Re
 	}
 }
 
+// PodLevelComputeResources returns the pod-level resources to set for the task-level compute resource
+// requirements, or nil if Kubernetes doesn't support some of them at the pod level, which only supports
+// CPU, memory and huge pages. As for Steps, if no requests are specified, the limits are used.
+func PodLevelComputeResources(computeResources *corev1.ResourceRequirements) *corev1.ResourceRequirements {
+	if computeResources == nil {
+		return nil
+	}
+	if len(computeResources.Requests) == 0 && len(computeResources.Limits) == 0 {
+		return nil
+	}
+	for _, list := range []corev1.ResourceList{computeResources.Requests, computeResources.Limits} {
+		for name := range list {
+			if !isPodLevelResource(name) {
+				return nil
+			}
+		}
+	}
+	podResources := computeResources.DeepCopy()
+	if len(podResources.Requests) == 0 {
+		podResources.Requests = podResources.Limits.DeepCopy()
+	}
+	return podResources
+}
+
+// ApplyPodLevelComputeResources removes from each Step the compute resource requirements that are set as
+// pod-level resources instead, since the task-level requirements override them.
+func ApplyPodLevelComputeResources(steps []v1.Step, podResources *corev1.ResourceRequirements) {
+	for i := range steps {
+		for _, list := range []corev1.ResourceList{podResources.Requests, podResources.Limits} {
+			for name := range list {
+				delete(steps[i].ComputeResources.Requests, name)
+				delete(steps[i].ComputeResources.Limits, name)
+			}
+		}
+	}
+}
+
+func isPodLevelResource(name corev1.ResourceName) bool {
+	return name == corev1.ResourceCPU || name == corev1.ResourceMemory || strings.HasPrefix(string(name), corev1.ResourceHugePagesPrefix)
+}
+
 // computeAverageRequests computes the average of the requests of all the steps.
 func computeAverageRequests(requests corev1.ResourceList, steps int) corev1.ResourceList {
 	if len(requests) == 0 || steps == 0 {
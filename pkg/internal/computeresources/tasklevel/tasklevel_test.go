@@ -234,6 +234,80 @@ func TestApplyTaskLevelResourceRequirements(t *testing.T) {
 	}
 }
 
+func TestPodLevelComputeResources(t *testing.T) {
+	testcases := []struct {
+		desc             string
+		ComputeResources *corev1.ResourceRequirements
+		expected         *corev1.ResourceRequirements
+	}{{
+		desc: "no compute resources",
+	}, {
+		desc:             "empty compute resources",
+		ComputeResources: &corev1.ResourceRequirements{},
+	}, {
+		desc: "requests and limits",
+		ComputeResources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), "hugepages-2Mi": resource.MustParse("100Mi")},
+		},
+		expected: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), "hugepages-2Mi": resource.MustParse("100Mi")},
+		},
+	}, {
+		desc: "only limits are used as requests",
+		ComputeResources: &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+		expected: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+	}, {
+		desc: "ephemeral storage is not supported at the pod level",
+		ComputeResources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+		},
+	}}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tasklevel.PodLevelComputeResources(tc.ComputeResources)
+			if d := cmp.Diff(tc.expected, got); d != "" {
+				t.Errorf("PodLevelComputeResources() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestApplyPodLevelComputeResources(t *testing.T) {
+	steps := []v1.Step{{
+		Name:  "1st-step",
+		Image: "image",
+		ComputeResources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m"), "nvidia.com/gpu": resource.MustParse("1")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		},
+	}, {
+		Name:  "2nd-step",
+		Image: "image",
+	}}
+	podResources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+	}
+
+	tasklevel.ApplyPodLevelComputeResources(steps, podResources)
+
+	expectedComputeResources := []corev1.ResourceRequirements{{
+		Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+		Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}, {}}
+	if err := verifyTaskLevelComputeResources(steps, expectedComputeResources); err != nil {
+		t.Errorf("verifyTaskLevelComputeResources: %v", err)
+	}
+}
+
 // verifyTaskLevelComputeResources verifies that the given TaskRun's containers have the expected compute resources.
 func verifyTaskLevelComputeResources(steps []v1.Step, expectedComputeResources []corev1.ResourceRequirements) error {
 	if len(expectedComputeResources) != len(steps) {
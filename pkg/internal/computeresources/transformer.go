@@ -49,12 +49,18 @@ func NewTransformer(ctx context.Context, namespace string, lister corev1listers.
 // (for app containers).
 // - If the container has limits, they are set to the min of (limits, limitRange maximum).
 // - If the container doesn't have limits, they are set to the min of (limitRange maximum, limitRange default).
+// For a pod with pod-level resources, all the containers are instead given the requirements computed by
+// limitrange.GetPodLevelContainerRequirements for the resources set at the pod level.
 func transformPodBasedOnLimitRange(p *corev1.Pod, limitRange *corev1.LimitRange) *corev1.Pod {
 	// No LimitRange defined, nothing to transform, bail early we don't have anything to transform.
 	if limitRange == nil {
 		return p
 	}
 
+	if p.Spec.Resources != nil {
+		return transformPodLevelResourcesPodBasedOnLimitRange(p, limitRange)
+	}
+
 	// The assumption here is that the min, max, default, ratio have already been
 	// computed if there is multiple LimitRange to satisfy the most (if we can).
 	// Count the number of step containers in the Pod.
@@ -84,6 +90,40 @@ func transformPodBasedOnLimitRange(p *corev1.Pod, limitRange *corev1.LimitRange)
 	return p
 }
 
+// transformPodLevelResourcesPodBasedOnLimitRange sets the requirements of the init, step and sidecar containers
+// of a pod with pod-level resources, for the resources they don't already set. Kubernetes would otherwise apply
+// the LimitRange defaults to any of them, and their requests count against the pod-level requests as well.
+func transformPodLevelResourcesPodBasedOnLimitRange(p *corev1.Pod, limitRange *corev1.LimitRange) *corev1.Pod {
+	containerRequirements := limitrange.GetPodLevelContainerRequirements(limitRange, p.Spec.Resources)
+	for i := range p.Spec.InitContainers {
+		setMissingRequirements(&p.Spec.InitContainers[i].Resources, containerRequirements)
+	}
+	for i := range p.Spec.Containers {
+		setMissingRequirements(&p.Spec.Containers[i].Resources, containerRequirements)
+	}
+	return p
+}
+
+// setMissingRequirements sets the requests and limits of src that dst doesn't set.
+func setMissingRequirements(dst *corev1.ResourceRequirements, src corev1.ResourceRequirements) {
+	for name, q := range src.Requests {
+		if _, ok := dst.Requests[name]; !ok {
+			if dst.Requests == nil {
+				dst.Requests = corev1.ResourceList{}
+			}
+			dst.Requests[name] = q
+		}
+	}
+	for name, q := range src.Limits {
+		if _, ok := dst.Limits[name]; !ok {
+			if dst.Limits == nil {
+				dst.Limits = corev1.ResourceList{}
+			}
+			dst.Limits[name] = q
+		}
+	}
+}
+
 func setRequests(name corev1.ResourceName, dst, src corev1.ResourceList) {
 	if compare.IsZero(dst[name]) && !compare.IsZero(src[name]) {
 		dst[name] = src[name]
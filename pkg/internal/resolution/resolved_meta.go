@@ -27,6 +27,8 @@ type ResolvedObjectMeta struct {
 	*metav1.ObjectMeta `json:",omitempty"`
 	// RefSource identifies where the spec came from.
 	RefSource *v1.RefSource `json:",omitempty"`
+	// BaseRefSource identifies where the base Task extended by the spec came from.
+	BaseRefSource *v1.RefSource `json:",omitempty"`
 	// VerificationResult contains the result of trusted resources verification
 	VerificationResult *trustedresources.VerificationResult `json:",omitempty"`
 }
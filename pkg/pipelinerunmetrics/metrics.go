@@ -59,6 +59,7 @@ type Recorder struct {
 	runningPRsGauge                            metric.Int64ObservableGauge
 	runningPRsWaitingOnPipelineResolutionGauge metric.Int64ObservableGauge
 	runningPRsWaitingOnTaskResolutionGauge     metric.Int64ObservableGauge
+	runningPRsPausedGauge                      metric.Int64ObservableGauge
 
 	insertTag func(pipeline, pipelinerun string) []attribute.KeyValue
 }
@@ -172,6 +173,15 @@ func (r *Recorder) configure(cfg *config.Metrics) error {
 	}
 	r.runningPRsWaitingOnTaskResolutionGauge = runningPRsWaitingOnTaskResolutionGauge
 
+	runningPRsPausedGauge, err := r.meter.Int64ObservableGauge(
+		"tekton_pipelines_controller_running_pipelineruns_paused",
+		metric.WithDescription("Number of pipelineruns executing currently that are paused and don't schedule new tasks."),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create running pipelineruns paused gauge: %w", err)
+	}
+	r.runningPRsPausedGauge = runningPRsPausedGauge
+
 	return nil
 }
 
@@ -244,6 +254,7 @@ func (r *Recorder) observeRunningPipelineRuns(ctx context.Context, o metric.Obse
 	runningPRsGauge := r.runningPRsGauge
 	waitingOnPipelineGauge := r.runningPRsWaitingOnPipelineResolutionGauge
 	waitingOnTaskGauge := r.runningPRsWaitingOnTaskResolutionGauge
+	pausedGauge := r.runningPRsPausedGauge
 	r.mutex.Unlock()
 
 	prs, err := lister.List(labels.Everything())
@@ -255,6 +266,7 @@ func (r *Recorder) observeRunningPipelineRuns(ctx context.Context, o metric.Obse
 	currentCounts := make(map[attribute.Set]int64)
 	var waitingOnPipelineCount int64
 	var waitingOnTaskCount int64
+	var pausedCount int64
 
 	for _, pr := range prs {
 		succeedCondition := pr.Status.GetCondition(apis.ConditionSucceeded)
@@ -262,12 +274,14 @@ func (r *Recorder) observeRunningPipelineRuns(ctx context.Context, o metric.Obse
 			continue
 		}
 
-		// Handle waiting metrics (these are cluster-wide, no extra attributes).
+		// Handle waiting and paused metrics (these are cluster-wide, no extra attributes).
 		switch succeedCondition.Reason {
 		case v1.PipelineRunReasonResolvingPipelineRef.String():
 			waitingOnPipelineCount++
 		case v1.TaskRunReasonResolvingTaskRef:
 			waitingOnTaskCount++
+		case v1.PipelineRunReasonPaused.String():
+			pausedCount++
 		}
 
 		// Handle running_pipelineruns metric with per-level aggregation.
@@ -304,6 +318,7 @@ func (r *Recorder) observeRunningPipelineRuns(ctx context.Context, o metric.Obse
 
 	o.ObserveInt64(waitingOnPipelineGauge, waitingOnPipelineCount)
 	o.ObserveInt64(waitingOnTaskGauge, waitingOnTaskCount)
+	o.ObserveInt64(pausedGauge, pausedCount)
 
 	return nil
 }
@@ -315,7 +330,7 @@ func (r *Recorder) ReportRunningPipelineRuns(ctx context.Context, lister listers
 
 	_, err := r.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
 		return r.observeRunningPipelineRuns(ctx, o, lister)
-	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge)
+	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge, r.runningPRsPausedGauge)
 	if err != nil {
 		logger.Errorf("failed to register callback for running pipelineruns: %v", err)
 		return
@@ -684,7 +684,7 @@ func TestRecordRunningPipelineRunsCount(t *testing.T) {
 	// Register callback manually
 	_, err = r.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
 		return r.observeRunningPipelineRuns(ctx, o, mockLister)
-	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge)
+	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge, r.runningPRsPausedGauge)
 	if err != nil {
 		t.Fatalf("Failed to register callback: %v", err)
 	}
@@ -807,7 +807,7 @@ func TestRecordRunningPipelineRunsCountAtAllLevels(t *testing.T) {
 
 			_, err := r.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
 				return r.observeRunningPipelineRuns(ctx, o, mockLister)
-			}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge)
+			}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge, r.runningPRsPausedGauge)
 			if err != nil {
 				t.Fatalf("Failed to register callback: %v", err)
 			}
@@ -859,6 +859,7 @@ func TestRecordRunningPipelineRunsResolutionWaitCounts(t *testing.T) {
 		reason      string
 		prWaitCount int64
 		trWaitCount int64
+		pausedCount int64
 	}{
 		{
 			status: corev1.ConditionTrue,
@@ -898,6 +899,15 @@ func TestRecordRunningPipelineRunsResolutionWaitCounts(t *testing.T) {
 			reason:      v1.TaskRunReasonResolvingTaskRef,
 			trWaitCount: 3,
 		},
+		{
+			status: corev1.ConditionFalse,
+			reason: v1.PipelineRunReasonPaused.String(),
+		},
+		{
+			status:      corev1.ConditionUnknown,
+			reason:      v1.PipelineRunReasonPaused.String(),
+			pausedCount: 3,
+		},
 	} {
 		ctx := getConfigContext(false)
 		reader := sdkmetric.NewManualReader()
@@ -936,7 +946,7 @@ func TestRecordRunningPipelineRunsResolutionWaitCounts(t *testing.T) {
 
 		_, err := r.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
 			return r.observeRunningPipelineRuns(ctx, o, mockLister)
-		}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge)
+		}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge, r.runningPRsPausedGauge)
 		if err != nil {
 			t.Fatalf("Failed to register callback: %v", err)
 		}
@@ -981,6 +991,24 @@ func TestRecordRunningPipelineRunsResolutionWaitCounts(t *testing.T) {
 				t.Errorf("Expected task resolution wait count %v, got %v", tc.trWaitCount, gauge.DataPoints[0].Value)
 			}
 		}
+
+		// Check paused count
+		if tc.pausedCount > 0 {
+			var m metricdata.Metrics
+			for _, metric := range rm.ScopeMetrics[0].Metrics {
+				if metric.Name == "tekton_pipelines_controller_running_pipelineruns_paused" {
+					m = metric
+					break
+				}
+			}
+			if m.Name == "" {
+				t.Error("paused metric not found")
+			} else if gauge, ok := m.Data.(metricdata.Gauge[int64]); !ok {
+				t.Errorf("metric data is not a Gauge[int64]: %T", m.Data)
+			} else if len(gauge.DataPoints) > 0 && gauge.DataPoints[0].Value != tc.pausedCount {
+				t.Errorf("Expected paused count %v, got %v", tc.pausedCount, gauge.DataPoints[0].Value)
+			}
+		}
 	}
 }
 
@@ -1006,7 +1034,7 @@ func TestRecordRunningPipelineRunsCountZeroing(t *testing.T) {
 
 	_, err := r.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
 		return r.observeRunningPipelineRuns(ctx, o, mockLister)
-	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge)
+	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge, r.runningPRsPausedGauge)
 	if err != nil {
 		t.Fatalf("Failed to register callback: %v", err)
 	}
@@ -1095,7 +1123,7 @@ func TestObserveRunningPipelineRunsNoSucceededCondition(t *testing.T) {
 
 	_, err = r.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
 		return r.observeRunningPipelineRuns(ctx, o, mockLister)
-	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge)
+	}, r.runningPRsGauge, r.runningPRsWaitingOnPipelineResolutionGauge, r.runningPRsWaitingOnTaskResolutionGauge, r.runningPRsPausedGauge)
 	if err != nil {
 		t.Fatalf("RegisterCallback: %v", err)
 	}
@@ -124,15 +124,24 @@ var (
 // command, we must have fetched the image's ENTRYPOINT before calling this
 // method, using entrypoint_lookup.go.
 // Additionally, Step timeouts are added as entrypoint flag.
+//
+// The steps of a parallel group all wait for the steps of the previous group,
+// and the steps of the next group wait for all of them.
 func orderContainers(ctx context.Context, commonExtraEntrypointArgs []string, steps []corev1.Container, taskSpec *v1.TaskSpec, breakpointConfig *v1.TaskRunDebug, waitForReadyAnnotation, enableKeepPodOnCancel bool) ([]corev1.Container, error) {
 	if len(steps) == 0 {
 		return nil, errors.New("no steps specified")
 	}
 
+	groups := stepGroups(taskSpec, len(steps))
+	groupPostFiles := make([][]string, groups[len(groups)-1]+1)
+	for i, g := range groups {
+		groupPostFiles[g] = append(groupPostFiles[g], filepath.Join(RunDir, strconv.Itoa(i), "out"))
+	}
+
 	for i, s := range steps {
 		var argsForEntrypoint = []string{}
 		idx := strconv.Itoa(i)
-		if i == 0 {
+		if groups[i] == 0 {
 			if waitForReadyAnnotation {
 				argsForEntrypoint = append(argsForEntrypoint,
 					// First step waits for the Downward volume file.
@@ -140,8 +149,8 @@ func orderContainers(ctx context.Context, commonExtraEntrypointArgs []string, st
 					"-wait_file_content", // Wait for file contents, not just an empty file.
 				)
 			}
-		} else { // Not the first step - wait for previous
-			argsForEntrypoint = append(argsForEntrypoint, "-wait_file", filepath.Join(RunDir, strconv.Itoa(i-1), "out"))
+		} else { // Not the first group of steps - wait for all the steps of the previous group
+			argsForEntrypoint = append(argsForEntrypoint, "-wait_file", strings.Join(groupPostFiles[groups[i]-1], ","))
 		}
 		argsForEntrypoint = append(argsForEntrypoint,
 			// Start next step.
@@ -163,6 +172,12 @@ func orderContainers(ctx context.Context, commonExtraEntrypointArgs []string, st
 				if taskSpec.Steps[i].Timeout != nil {
 					argsForEntrypoint = append(argsForEntrypoint, "-timeout", taskSpec.Steps[i].Timeout.Duration.String())
 				}
+				if taskSpec.Steps[i].Retries > 0 {
+					argsForEntrypoint = append(argsForEntrypoint, "-retries", strconv.Itoa(taskSpec.Steps[i].Retries))
+					if taskSpec.Steps[i].RetryDelay != nil {
+						argsForEntrypoint = append(argsForEntrypoint, "-retry_delay", taskSpec.Steps[i].RetryDelay.Duration.String())
+					}
+				}
 				if taskSpec.Steps[i].StdoutConfig != nil {
 					argsForEntrypoint = append(argsForEntrypoint, "-stdout_path", taskSpec.Steps[i].StdoutConfig.Path)
 				}
@@ -206,8 +221,8 @@ func orderContainers(ctx context.Context, commonExtraEntrypointArgs []string, st
 		steps[i].Command = []string{entrypointBinary}
 		steps[i].Args = argsForEntrypoint
 		steps[i].TerminationMessagePath = terminationPath
-		if (i == 0 && waitForReadyAnnotation) || enableKeepPodOnCancel {
-			// Mount the Downward volume into the first step container.
+		if (groups[i] == 0 && waitForReadyAnnotation) || enableKeepPodOnCancel {
+			// Mount the Downward volume into the first step containers.
 			// if enableKeepPodOnCancel is true, mount the Downward volume into all the steps.
 			steps[i].VolumeMounts = append(steps[i].VolumeMounts, downwardMount)
 		}
@@ -216,6 +231,21 @@ func orderContainers(ctx context.Context, commonExtraEntrypointArgs []string, st
 	return steps, nil
 }
 
+// stepGroups returns the index of the group of each step. Consecutive steps of
+// the same parallel group share a group, any other step is in a group of its own.
+func stepGroups(taskSpec *v1.TaskSpec, count int) []int {
+	groups := make([]int, count)
+	for i := 1; i < count; i++ {
+		groups[i] = groups[i-1] + 1
+		if taskSpec != nil && i < len(taskSpec.Steps) {
+			if group := taskSpec.Steps[i].ParallelGroup; group != "" && group == taskSpec.Steps[i-1].ParallelGroup {
+				groups[i] = groups[i-1]
+			}
+		}
+	}
+	return groups
+}
+
 // stepResultArgument creates the cli arguments for step results to the entrypointer.
 func stepResultArgument(stepResults []v1.StepResult) []string {
 	if len(stepResults) == 0 {
@@ -160,6 +160,14 @@ func buildCommandMap(idx v1.ImageIndex, hasArgs bool) (map[string][]string, erro
 }
 
 func imageInfo(img v1.Image, hasArgs bool) (cmd []string, platform string, err error) {
+	// OCI artifacts other than images, such as Helm charts, have another config and can't be run.
+	m, err := img.Manifest()
+	if err != nil {
+		return nil, "", err
+	}
+	if !m.Config.MediaType.IsConfig() {
+		return nil, "", fmt.Errorf("not a runnable image: its config has media type %q", m.Config.MediaType)
+	}
 	cf, err := img.ConfigFile()
 	if err != nil {
 		return nil, "", err
@@ -349,6 +349,13 @@ func TestImageInfo(t *testing.T) {
 	}
 }
 
+func TestImageInfo_Artifact(t *testing.T) {
+	artifact := mutate.ConfigMediaType(empty.Image, "application/vnd.cncf.helm.config.v1+json")
+	if _, _, err := imageInfo(artifact, false); err == nil {
+		t.Error("imageInfo() should fail for an OCI artifact which is not a runnable image")
+	}
+}
+
 func TestImageInfo_PlatformDoesNotLeakControllerVariant(t *testing.T) {
 	// Regression test for https://github.com/tektoncd/pipeline/issues/10073
 	//
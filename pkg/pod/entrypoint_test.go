@@ -361,6 +361,91 @@ func TestOrderContainersWithEnabelKeepPodOnCancel(t *testing.T) {
 	}
 }
 
+func TestOrderContainersWithParallelGroups(t *testing.T) {
+	taskSpec := v1.TaskSpec{
+		Steps: []v1.Step{{
+			Name:          "lint",
+			ParallelGroup: "checks",
+		}, {
+			Name:          "test",
+			ParallelGroup: "checks",
+		}, {
+			Name: "build",
+		}, {
+			Name: "push",
+		}},
+	}
+	steps := []corev1.Container{{
+		Image:   "step-1",
+		Command: []string{"cmd"},
+	}, {
+		Image:   "step-2",
+		Command: []string{"cmd"},
+	}, {
+		Image:   "step-3",
+		Command: []string{"cmd"},
+	}, {
+		Image:   "step-4",
+		Command: []string{"cmd"},
+	}}
+	want := []corev1.Container{{
+		Image:   "step-1",
+		Command: []string{entrypointBinary},
+		Args: []string{
+			"-wait_file", "/tekton/downward/ready",
+			"-wait_file_content",
+			"-post_file", "/tekton/run/0/out",
+			"-termination_path", "/tekton/termination",
+			"-step_metadata_dir", "/tekton/run/0/status",
+			"-entrypoint", "cmd", "--",
+		},
+		VolumeMounts:           []corev1.VolumeMount{downwardMount},
+		TerminationMessagePath: "/tekton/termination",
+	}, {
+		Image:   "step-2",
+		Command: []string{entrypointBinary},
+		Args: []string{
+			"-wait_file", "/tekton/downward/ready",
+			"-wait_file_content",
+			"-post_file", "/tekton/run/1/out",
+			"-termination_path", "/tekton/termination",
+			"-step_metadata_dir", "/tekton/run/1/status",
+			"-entrypoint", "cmd", "--",
+		},
+		VolumeMounts:           []corev1.VolumeMount{downwardMount},
+		TerminationMessagePath: "/tekton/termination",
+	}, {
+		Image:   "step-3",
+		Command: []string{entrypointBinary},
+		Args: []string{
+			"-wait_file", "/tekton/run/0/out,/tekton/run/1/out",
+			"-post_file", "/tekton/run/2/out",
+			"-termination_path", "/tekton/termination",
+			"-step_metadata_dir", "/tekton/run/2/status",
+			"-entrypoint", "cmd", "--",
+		},
+		TerminationMessagePath: "/tekton/termination",
+	}, {
+		Image:   "step-4",
+		Command: []string{entrypointBinary},
+		Args: []string{
+			"-wait_file", "/tekton/run/2/out",
+			"-post_file", "/tekton/run/3/out",
+			"-termination_path", "/tekton/termination",
+			"-step_metadata_dir", "/tekton/run/3/status",
+			"-entrypoint", "cmd", "--",
+		},
+		TerminationMessagePath: "/tekton/termination",
+	}}
+	got, err := orderContainers(t.Context(), []string{}, steps, &taskSpec, nil, true, false)
+	if err != nil {
+		t.Fatalf("orderContainers: %v", err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("Diff %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestStepResultArgument(t *testing.T) {
 	for _, tc := range []struct {
 		name    string
@@ -715,6 +800,63 @@ func TestEntryPointOnError(t *testing.T) {
 	}
 }
 
+func TestEntryPointStepRetries(t *testing.T) {
+	taskSpec := v1.TaskSpec{
+		Steps: []v1.Step{{
+			Retries:    2,
+			RetryDelay: &metav1.Duration{Duration: 10 * time.Second},
+		}, {
+			Retries: 1,
+		}},
+	}
+	steps := []corev1.Container{{
+		Name:    "npm-install",
+		Image:   "step-1",
+		Command: []string{"cmd"},
+	}, {
+		Name:    "npm-test",
+		Image:   "step-2",
+		Command: []string{"cmd"},
+	}}
+	want := []corev1.Container{{
+		Name:    "npm-install",
+		Image:   "step-1",
+		Command: []string{entrypointBinary},
+		Args: []string{
+			"-wait_file", "/tekton/downward/ready",
+			"-wait_file_content",
+			"-post_file", "/tekton/run/0/out",
+			"-termination_path", "/tekton/termination",
+			"-step_metadata_dir", "/tekton/run/0/status",
+			"-retries", "2",
+			"-retry_delay", "10s",
+			"-entrypoint", "cmd", "--",
+		},
+		VolumeMounts:           []corev1.VolumeMount{downwardMount},
+		TerminationMessagePath: "/tekton/termination",
+	}, {
+		Name:    "npm-test",
+		Image:   "step-2",
+		Command: []string{entrypointBinary},
+		Args: []string{
+			"-wait_file", "/tekton/run/0/out",
+			"-post_file", "/tekton/run/1/out",
+			"-termination_path", "/tekton/termination",
+			"-step_metadata_dir", "/tekton/run/1/status",
+			"-retries", "1",
+			"-entrypoint", "cmd", "--",
+		},
+		TerminationMessagePath: "/tekton/termination",
+	}}
+	got, err := orderContainers(t.Context(), []string{}, steps, &taskSpec, nil, true, false)
+	if err != nil {
+		t.Fatalf("orderContainers: %v", err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("Diff %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestEntryPointStepOutputConfigs(t *testing.T) {
 	taskSpec := v1.TaskSpec{
 		Steps: []v1.Step{{
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/names"
+	"github.com/tektoncd/pipeline/pkg/workspace"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// containerPrefixExtractImageVolume prefixes the names of the init containers extracting the
+	// content of the images workspaces are bound to.
+	containerPrefixExtractImageVolume = "extract-image-volume-"
+
+	imageVolumeMountPath = "/tekton/image-volume"
+)
+
+// volumeFieldRegexp matches the field of a cause of a Pod validation error about one of its volumes.
+var volumeFieldRegexp = regexp.MustCompile(`^spec\.volumes\[(\d+)\]$`)
+
+// IsImageVolumeUnsupportedError returns true if the Pod was rejected because one of its image volumes
+// has no volume source, which happens when the cluster drops it for lack of the ImageVolume feature.
+func IsImageVolumeUnsupportedError(err error, pod *corev1.Pod) bool {
+	var statusErr *k8serrors.StatusError
+	if !k8serrors.IsInvalid(err) || !errors.As(err, &statusErr) || statusErr.ErrStatus.Details == nil {
+		return false
+	}
+	for _, cause := range statusErr.ErrStatus.Details.Causes {
+		m := volumeFieldRegexp.FindStringSubmatch(cause.Field)
+		if cause.Type != metav1.CauseTypeFieldValueRequired || m == nil {
+			continue
+		}
+		if i, err := strconv.Atoi(m[1]); err == nil && i < len(pod.Spec.Volumes) && pod.Spec.Volumes[i].Image != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// extractImageVolumes replaces the image volumes of the workspaces of the TaskRun with emptyDirs,
+// for clusters without the ImageVolume feature, and returns the init containers extracting the
+// images into them. Each init container runs the image of its workspace with the entrypoint binary,
+// so it must run after the binary is placed, and the OCI artifacts which are not runnable images
+// are rejected.
+func extractImageVolumes(ctx context.Context, cache EntrypointCache, taskRun *v1.TaskRun, imagePullSecrets []corev1.LocalObjectReference, volumes []corev1.Volume, securityContext SecurityContextConfig, windows bool) ([]corev1.Container, error) {
+	workspaceVolumes := workspace.CreateVolumes(taskRun.Spec.Workspaces)
+	var containers []corev1.Container
+	for _, wb := range taskRun.Spec.Workspaces {
+		if wb.Image == nil {
+			continue
+		}
+		ref, err := name.ParseReference(wb.Image.Reference, name.WeakValidation)
+		if err == nil {
+			_, err = cache.get(ctx, ref, taskRun.Namespace, taskRun.Spec.ServiceAccountName, imagePullSecrets, false)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("the image %q of workspace %q can't be extracted without the ImageVolume feature of the cluster: %w", wb.Image.Reference, wb.Name, err)
+		}
+		volumeName := workspaceVolumes[wb.Name].Name
+		for i := range volumes {
+			if volumes[i].Name == volumeName && volumes[i].Image != nil {
+				volumes[i].VolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+			}
+		}
+		c := corev1.Container{
+			Name:            names.SimpleNameGenerator.RestrictLength(containerPrefixExtractImageVolume + wb.Name),
+			Image:           wb.Image.Reference,
+			ImagePullPolicy: wb.Image.PullPolicy,
+			WorkingDir:      "/",
+			Command:         []string{entrypointBinary, "extract-image-volume", imageVolumeMountPath},
+			VolumeMounts:    []corev1.VolumeMount{binROMount, {Name: volumeName, MountPath: imageVolumeMountPath}},
+		}
+		if securityContext.SetSecurityContext {
+			c.SecurityContext = securityContext.GetSecurityContext(windows)
+		}
+		containers = append(containers, c)
+	}
+	return containers, nil
+}
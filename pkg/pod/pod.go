@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/tektoncd/pipeline/internal/artifactref"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
@@ -86,9 +87,15 @@ const (
 
 	// Internal container name constants. These containers are created by Tekton
 	// and are not user-defined steps or sidecars.
-	ContainerNamePrepare               = "prepare"
-	ContainerNamePlaceScripts          = "place-scripts"
-	ContainerNameWorkingDirInitializer = "working-dir-initializer"
+	ContainerNamePrepare                = "prepare"
+	ContainerNamePlaceScripts           = "place-scripts"
+	ContainerNameWorkingDirInitializer  = "working-dir-initializer"
+	ContainerNameRestoreWorkspaces      = "restore-workspaces"
+	ContainerNameRestoreWorkspaceCaches = "restore-workspace-caches"
+
+	// resultsStorageVolumeName is the name of the Volume of the Secret holding the credentials to
+	// access the bucket results are stored in.
+	resultsStorageVolumeName = "tekton-internal-results-storage"
 )
 
 // These are effectively const, but Go doesn't have such an annotation.
@@ -140,12 +147,16 @@ var (
 )
 
 // IsInternalContainer returns true if the container name is one of Tekton's
-// internal containers (prepare, place-scripts, working-dir-initializer, or
-// the results sidecar).
+// internal containers (prepare, place-scripts, working-dir-initializer,
+// restore-workspaces, restore-workspace-caches, extract-image-volume-*, or the
+// results sidecar).
 func IsInternalContainer(name string) bool {
 	return name == ContainerNamePrepare ||
 		name == ContainerNamePlaceScripts ||
 		name == ContainerNameWorkingDirInitializer ||
+		name == ContainerNameRestoreWorkspaces ||
+		name == ContainerNameRestoreWorkspaceCaches ||
+		strings.HasPrefix(name, containerPrefixExtractImageVolume) ||
 		name == pipeline.ReservedResultsSidecarContainerName
 }
 
@@ -154,6 +165,12 @@ type Builder struct {
 	Images          pipeline.Images
 	KubeClient      kubernetes.Interface
 	EntrypointCache EntrypointCache
+	// ExtractImageVolumes extracts the images workspaces are bound to in init containers, for clusters
+	// without the ImageVolume feature.
+	ExtractImageVolumes bool
+	// DividePodLevelResources divides the task-level compute resources among steps instead of setting them
+	// at the pod level, for clusters without the PodLevelResources feature.
+	DividePodLevelResources bool
 }
 
 // Transformer is a function that will transform a Pod. This can be used to mutate
@@ -210,8 +227,16 @@ func (b *Builder) Build(ctx context.Context, taskRun *v1.TaskRun, taskSpec v1.Ta
 	if err != nil {
 		return nil, err
 	}
+	var podLevelResources *corev1.ResourceRequirements
 	if taskRun.Spec.ComputeResources != nil {
-		tasklevel.ApplyTaskLevelComputeResources(steps, taskRun.Spec.ComputeResources)
+		if featureFlags.EnablePodLevelResources && !b.DividePodLevelResources {
+			podLevelResources = tasklevel.PodLevelComputeResources(taskRun.Spec.ComputeResources)
+		}
+		if podLevelResources != nil {
+			tasklevel.ApplyPodLevelComputeResources(steps, podLevelResources)
+		} else {
+			tasklevel.ApplyTaskLevelComputeResources(steps, taskRun.Spec.ComputeResources)
+		}
 	}
 
 	securityContextConfig := SecurityContextConfig{
@@ -233,6 +258,35 @@ func (b *Builder) Build(ctx context.Context, taskRun *v1.TaskRun, taskSpec v1.Ta
 		}
 	}
 
+	resultsInObjectStorage := featureFlags.ResultExtractionMethod == config.ResultExtractionMethodObjectStorage && resultsDeclared(taskSpec)
+	if resultsInObjectStorage {
+		storageArgs, storageVolume, storageMount, err := resultsObjectStorageInit(ctx)
+		if err != nil {
+			return nil, err
+		}
+		commonExtraEntrypointArgs = append(commonExtraEntrypointArgs, storageArgs...)
+		volumes = append(volumes, storageVolume)
+		volumeMounts = append(volumeMounts, storageMount)
+	}
+
+	// Workspaces bound with transfer are snapshotted to the same bucket as the results.
+	transfer, err := newWorkspaceTransfer(ctx, taskRun)
+	if err != nil {
+		return nil, err
+	}
+	// So are cache workspaces.
+	caches, err := newWorkspaceCaches(ctx, taskRun)
+	if err != nil {
+		return nil, err
+	}
+	storageVolume, storageMount := resultsStorageCredentials(config.FromContextOrDefaults(ctx).Defaults)
+	if (transfer != nil || caches != nil) && !resultsInObjectStorage {
+		volumes = append(volumes, storageVolume)
+	}
+	if caches != nil {
+		volumes = append(volumes, caches.stateVolume())
+	}
+
 	if featureFlags.EnableTerminationMessageCompression && !sidecarLogsResultsEnabled {
 		commonExtraEntrypointArgs = append(commonExtraEntrypointArgs, "-compress_termination_message=true")
 	}
@@ -264,6 +318,14 @@ func (b *Builder) Build(ctx context.Context, taskRun *v1.TaskRun, taskSpec v1.Ta
 	if alphaAPIEnabled && taskRun.Spec.Debug != nil && taskRun.Spec.Debug.NeedsDebug() {
 		volumes = append(volumes, debugScriptsVolume, debugInfoVolume)
 	}
+	if transfer != nil {
+		if restoreInit := transfer.initContainer(ctx, b.Images.EntrypointImage, storageMount, securityContextConfig, windows); restoreInit != nil {
+			initContainers = append(initContainers, *restoreInit)
+		}
+	}
+	if caches != nil {
+		initContainers = append(initContainers, caches.initContainer(ctx, taskRun.Namespace, b.Images.EntrypointImage, storageMount, securityContextConfig, windows))
+	}
 	// Initialize any workingDirs under /workspace.
 	if workingDirInit := workingDirInit(b.Images.WorkingDirInitImage, stepContainers, securityContextConfig, windows); workingDirInit != nil {
 		initContainers = append(initContainers, *workingDirInit)
@@ -369,6 +431,33 @@ func (b *Builder) Build(ctx context.Context, taskRun *v1.TaskRun, taskSpec v1.Ta
 		stepContainers[i].VolumeMounts = vms
 	}
 
+	// The last Step snapshots the workspaces bound with transfer and saves the cache workspaces
+	// once it and the other Steps of its parallel group complete.
+	if snapshotArgs := append(transfer.snapshotArgs(), caches.saveArgs()...); len(snapshotArgs) > 0 && len(stepContainers) > 0 {
+		last := &stepContainers[len(stepContainers)-1]
+		groups := stepGroups(&taskSpec, len(stepContainers))
+		var groupPostFiles []string
+		for i := range len(stepContainers) - 1 {
+			if groups[i] == groups[len(groups)-1] {
+				groupPostFiles = append(groupPostFiles, filepath.Join(RunDir, strconv.Itoa(i), "out"))
+			}
+		}
+		if len(groupPostFiles) > 0 {
+			snapshotArgs = append(snapshotArgs, "-snapshot_wait_file", strings.Join(groupPostFiles, ","))
+		}
+		if !resultsInObjectStorage {
+			snapshotArgs = append(snapshotArgs, resultsStorageArgs(config.FromContextOrDefaults(ctx).Defaults)...)
+			last.VolumeMounts = append(last.VolumeMounts, storageMount)
+		}
+		last.Args = append(snapshotArgs, last.Args...)
+		if transfer != nil {
+			last.VolumeMounts = append(last.VolumeMounts, transfer.snapshotMounts...)
+		}
+		if caches != nil {
+			last.VolumeMounts = append(last.VolumeMounts, caches.saveMounts...)
+		}
+	}
+
 	if sidecarLogsResultsEnabled {
 		// Mount implicit volumes onto sidecarContainers
 		// so that they can access /tekton/results and /tekton/run.
@@ -408,6 +497,15 @@ func (b *Builder) Build(ctx context.Context, taskRun *v1.TaskRun, taskSpec v1.Ta
 	volumes = append(volumes, taskSpec.Volumes...)
 	volumes = append(volumes, podTemplate.Volumes...)
 
+	// Without the ImageVolume feature, the images workspaces are bound to are extracted by init containers.
+	if b.ExtractImageVolumes {
+		extractContainers, err := extractImageVolumes(ctx, b.EntrypointCache, taskRun, podTemplate.ImagePullSecrets, volumes, securityContextConfig, windows)
+		if err != nil {
+			return nil, err
+		}
+		initContainers = append(initContainers, extractContainers...)
+	}
+
 	if err := v1.ValidateVolumes(volumes); err != nil {
 		return nil, err
 	}
@@ -559,6 +657,7 @@ func (b *Builder) Build(ctx context.Context, taskRun *v1.TaskRun, taskSpec v1.Ta
 			ImagePullSecrets:             podTemplate.ImagePullSecrets,
 			HostAliases:                  podTemplate.HostAliases,
 			TopologySpreadConstraints:    podTemplate.TopologySpreadConstraints,
+			Resources:                    podLevelResources,
 			ActiveDeadlineSeconds:        &activeDeadlineSeconds, // Set ActiveDeadlineSeconds to mark the pod as "terminating" (like a Job)
 		},
 	}
@@ -655,6 +754,61 @@ func entrypointInitContainer(image string, steps []v1.Step, securityContext Secu
 	return prepareInitContainer
 }
 
+// resultsDeclared returns true if the Task or any of its Steps declares results.
+func resultsDeclared(taskSpec v1.TaskSpec) bool {
+	if len(taskSpec.Results) > 0 {
+		return true
+	}
+	for _, s := range taskSpec.Steps {
+		if len(s.Results) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// resultsObjectStorageInit returns the entrypoint arguments, and the Volume and VolumeMount of the
+// Secret holding the credentials, that the Steps need to upload the values of their results to the
+// bucket configured in config-defaults.
+func resultsObjectStorageInit(ctx context.Context) ([]string, corev1.Volume, corev1.VolumeMount, error) {
+	cfg := config.FromContextOrDefaults(ctx).Defaults
+	if cfg.DefaultResultsObjectStorageEndpoint == "" || cfg.DefaultResultsObjectStorageBucket == "" || cfg.DefaultResultsObjectStorageSecret == "" {
+		return nil, corev1.Volume{}, corev1.VolumeMount{}, objectstorageresults.ErrNotConfigured
+	}
+	args := append([]string{"-result_from", config.ResultExtractionMethodObjectStorage}, resultsStorageArgs(cfg)...)
+	volume, mount := resultsStorageCredentials(cfg)
+	return args, volume, mount, nil
+}
+
+// resultsStorageArgs returns the entrypoint arguments locating the bucket configured in config-defaults.
+func resultsStorageArgs(cfg *config.Defaults) []string {
+	args := []string{
+		"-results_storage_endpoint", cfg.DefaultResultsObjectStorageEndpoint,
+		"-results_storage_bucket", cfg.DefaultResultsObjectStorageBucket,
+	}
+	if cfg.DefaultResultsObjectStorageRegion != "" {
+		args = append(args, "-results_storage_region", cfg.DefaultResultsObjectStorageRegion)
+	}
+	return args
+}
+
+// resultsStorageCredentials returns the Volume and VolumeMount of the Secret holding the credentials
+// to access the bucket configured in config-defaults.
+func resultsStorageCredentials(cfg *config.Defaults) (corev1.Volume, corev1.VolumeMount) {
+	volume := corev1.Volume{
+		Name: resultsStorageVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: cfg.DefaultResultsObjectStorageSecret},
+		},
+	}
+	mount := corev1.VolumeMount{
+		Name:      resultsStorageVolumeName,
+		MountPath: objectstorageresults.CredentialsDir,
+		ReadOnly:  true,
+	}
+	return volume, mount
+}
+
 // createResultsSidecar creates a sidecar that will run the sidecarlogresults binary,
 // based on the spec of the Task, the image that should run in the results sidecar,
 // whether it will run on a windows node, and whether the sidecar should include a security context
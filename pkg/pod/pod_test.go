@@ -17,9 +17,11 @@ limitations under the License.
 package pod
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -27,18 +29,25 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/workspacecache"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	tknreconciler "github.com/tektoncd/pipeline/pkg/reconciler"
 	"github.com/tektoncd/pipeline/pkg/spire"
+	"github.com/tektoncd/pipeline/pkg/workspace"
 	"github.com/tektoncd/pipeline/test/diff"
 	"github.com/tektoncd/pipeline/test/names"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/version"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	fakek8s "k8s.io/client-go/kubernetes/fake"
@@ -3108,6 +3117,140 @@ func TestPodBuild_TaskLevelResourceRequirements(t *testing.T) {
 	}
 }
 
+func TestPodBuild_PodLevelResources(t *testing.T) {
+	ts := v1.TaskSpec{
+		Steps: []v1.Step{{
+			Name:    "1st-step",
+			Image:   "image",
+			Command: []string{"cmd"},
+		}, {
+			Name:    "2nd-step",
+			Image:   "image",
+			Command: []string{"cmd"},
+			ComputeResources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+		}},
+	}
+	computeResources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+	}
+	testcases := []struct {
+		desc                     string
+		flags                    map[string]string
+		divide                   bool
+		computeResources         *corev1.ResourceRequirements
+		expectedPodResources     *corev1.ResourceRequirements
+		expectedComputeResources []ExpectedComputeResources
+	}{{
+		desc:                 "pod-level resources",
+		flags:                map[string]string{"enable-pod-level-resources": "true"},
+		computeResources:     computeResources,
+		expectedPodResources: computeResources,
+		expectedComputeResources: []ExpectedComputeResources{{
+			name: "step-1st-step",
+		}, {
+			name: "step-2nd-step",
+			ResourceRequirements: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+		}},
+	}, {
+		desc:             "cluster without pod-level resources",
+		flags:            map[string]string{"enable-pod-level-resources": "true"},
+		divide:           true,
+		computeResources: computeResources,
+		expectedComputeResources: []ExpectedComputeResources{{
+			name: "step-1st-step",
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			},
+		}, {
+			name: "step-2nd-step",
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			},
+		}},
+	}, {
+		desc:  "resources not supported at the pod level",
+		flags: map[string]string{"enable-pod-level-resources": "true"},
+		computeResources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("2Gi")},
+		},
+		expectedComputeResources: []ExpectedComputeResources{{
+			name: "step-1st-step",
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+			},
+		}, {
+			name: "step-2nd-step",
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+			},
+		}},
+	}, {
+		desc:             "feature flag disabled",
+		computeResources: computeResources,
+		expectedComputeResources: []ExpectedComputeResources{{
+			name: "step-1st-step",
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			},
+		}, {
+			name: "step-2nd-step",
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			},
+		}},
+	}}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			names.TestingSeed()
+			store := config.NewStore(logtesting.TestLogger(t))
+			store.OnConfigChanged(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: config.GetFeatureFlagsConfigName(), Namespace: system.Namespace()},
+					Data:       tc.flags,
+				},
+			)
+
+			kubeclient := fakek8s.NewSimpleClientset(
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}},
+			)
+			builder := Builder{
+				Images:                  images,
+				KubeClient:              kubeclient,
+				DividePodLevelResources: tc.divide,
+			}
+			tr := &v1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-taskrun",
+					Namespace: "default",
+				},
+				Spec: v1.TaskRunSpec{ComputeResources: tc.computeResources},
+			}
+
+			gotPod, err := builder.Build(store.ToContext(t.Context()), tr, *ts.DeepCopy())
+			if err != nil {
+				t.Fatalf("builder.Build: %v", err)
+			}
+
+			if d := cmp.Diff(tc.expectedPodResources, gotPod.Spec.Resources, resourceQuantityCmp); d != "" {
+				t.Errorf("Unexpected pod-level resources %s", diff.PrintWantGot(d))
+			}
+			if err := verifyTaskLevelComputeResources(tc.expectedComputeResources, gotPod.Spec.Containers); err != nil {
+				t.Errorf("verifyTaskLevelComputeResources: %v", err)
+			}
+		})
+	}
+}
+
 func TestPodBuildwithSpireEnabled(t *testing.T) {
 	initContainers := []corev1.Container{entrypointInitContainer(images.EntrypointImage, []v1.Step{{Name: "name"}}, SecurityContextConfig{SetSecurityContext: false, SetReadOnlyRootFilesystem: false}, false /* windows */)}
 	readonly := true
@@ -4120,3 +4263,567 @@ func TestPodBuild_CompressTerminationMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestPodBuild_ResultsObjectStorage(t *testing.T) {
+	for _, tc := range []struct {
+		desc         string
+		defaults     map[string]string
+		results      []v1.TaskResult
+		stepResults  []v1.StepResult
+		wantArgs     []string
+		wantErr      error
+		wantNoVolume bool
+	}{{
+		desc: "task results",
+		defaults: map[string]string{
+			"default-results-object-storage-endpoint": "http://minio:9000",
+			"default-results-object-storage-bucket":   "results",
+			"default-results-object-storage-secret":   "results-storage",
+		},
+		results:  []v1.TaskResult{{Name: "foo"}},
+		wantArgs: []string{"-result_from", "object-storage", "-results_storage_endpoint", "http://minio:9000", "-results_storage_bucket", "results"},
+	}, {
+		desc: "step results with region",
+		defaults: map[string]string{
+			"default-results-object-storage-endpoint": "http://minio:9000",
+			"default-results-object-storage-bucket":   "results",
+			"default-results-object-storage-region":   "eu-west-1",
+			"default-results-object-storage-secret":   "results-storage",
+		},
+		stepResults: []v1.StepResult{{Name: "foo"}},
+		wantArgs:    []string{"-result_from", "object-storage", "-results_storage_endpoint", "http://minio:9000", "-results_storage_bucket", "results", "-results_storage_region", "eu-west-1"},
+	}, {
+		desc: "no results",
+		defaults: map[string]string{
+			"default-results-object-storage-endpoint": "http://minio:9000",
+			"default-results-object-storage-bucket":   "results",
+			"default-results-object-storage-secret":   "results-storage",
+		},
+		wantNoVolume: true,
+	}, {
+		desc:     "bucket not configured",
+		defaults: map[string]string{},
+		results:  []v1.TaskResult{{Name: "foo"}},
+		wantErr:  objectstorageresults.ErrNotConfigured,
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			names.TestingSeed()
+			store := config.NewStore(logtesting.TestLogger(t))
+			store.OnConfigChanged(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: config.GetFeatureFlagsConfigName(), Namespace: system.Namespace()},
+					Data:       map[string]string{"results-from": "object-storage"},
+				},
+			)
+			store.OnConfigChanged(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: config.GetDefaultsConfigName(), Namespace: system.Namespace()},
+					Data:       tc.defaults,
+				},
+			)
+			kubeclient := fakek8s.NewSimpleClientset(
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}},
+			)
+			tr := &v1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "taskrun-results-object-storage",
+					Namespace:   "default",
+					Annotations: map[string]string{ReleaseAnnotation: fakeVersion},
+				},
+			}
+			ts := v1.TaskSpec{
+				Results: tc.results,
+				Steps: []v1.Step{{
+					Name:    "step",
+					Image:   "image",
+					Command: []string{"cmd"},
+					Results: tc.stepResults,
+				}},
+			}
+
+			builder := Builder{
+				Images:          images,
+				KubeClient:      kubeclient,
+				EntrypointCache: fakeCache{},
+			}
+			got, err := builder.Build(store.ToContext(t.Context()), tr, ts)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("builder.Build() error = %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("builder.Build: %v", err)
+			}
+
+			stepContainer := got.Spec.Containers[0]
+			args := strings.Join(stepContainer.Args, " ")
+			if len(tc.wantArgs) > 0 && !strings.Contains(args, strings.Join(tc.wantArgs, " ")) {
+				t.Errorf("step args %v do not contain %v", stepContainer.Args, tc.wantArgs)
+			}
+			wantVolume := corev1.Volume{
+				Name:         "tekton-internal-results-storage",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "results-storage"}},
+			}
+			wantMount := corev1.VolumeMount{Name: "tekton-internal-results-storage", MountPath: "/tekton/results-storage", ReadOnly: true}
+			hasVolume := slices.ContainsFunc(got.Spec.Volumes, func(v corev1.Volume) bool { return cmp.Equal(v, wantVolume) })
+			hasMount := slices.ContainsFunc(stepContainer.VolumeMounts, func(m corev1.VolumeMount) bool { return cmp.Equal(m, wantMount) })
+			if hasVolume == tc.wantNoVolume || hasMount == tc.wantNoVolume {
+				t.Errorf("results storage volume: got volume %v and mount %v, want %v; volumes: %v, mounts: %v", hasVolume, hasMount, !tc.wantNoVolume, got.Spec.Volumes, stepContainer.VolumeMounts)
+			}
+		})
+	}
+}
+
+func TestPodBuild_WorkspaceTransfer(t *testing.T) {
+	configuredDefaults := map[string]string{
+		"default-results-object-storage-endpoint": "http://minio:9000",
+		"default-results-object-storage-bucket":   "results",
+		"default-results-object-storage-secret":   "results-storage",
+	}
+	for _, tc := range []struct {
+		desc      string
+		defaults  map[string]string
+		transfer  v1.TransferWorkspace
+		parallel  bool
+		wantInit  []string
+		wantArgs  []string
+		wantError string
+	}{{
+		desc:     "restore and snapshot",
+		defaults: configuredDefaults,
+		transfer: v1.TransferWorkspace{
+			From: []string{"s3://results/workspaces/default/pr-fetch/source.tar.gz", "s3://results/workspaces/default/pr-lint/source.tar.gz"},
+			To:   "s3://results/workspaces/default/pr-build/source.tar.gz",
+		},
+		wantInit: []string{"/ko-app/entrypoint", "restore-workspaces", "http://minio:9000", "results", "",
+			"/tekton/workspace-transfer/source=s3://results/workspaces/default/pr-fetch/source.tar.gz",
+			"/tekton/workspace-transfer/source=s3://results/workspaces/default/pr-lint/source.tar.gz"},
+		wantArgs: []string{"-workspace_snapshots", "/tekton/workspace-transfer/source=s3://results/workspaces/default/pr-build/source.tar.gz",
+			"-results_storage_endpoint", "http://minio:9000", "-results_storage_bucket", "results"},
+	}, {
+		desc:     "snapshot only",
+		defaults: configuredDefaults,
+		transfer: v1.TransferWorkspace{To: "s3://results/workspaces/default/pr-fetch/source.tar.gz"},
+		wantArgs: []string{"-workspace_snapshots", "/tekton/workspace-transfer/source=s3://results/workspaces/default/pr-fetch/source.tar.gz",
+			"-results_storage_endpoint", "http://minio:9000", "-results_storage_bucket", "results"},
+	}, {
+		desc:     "snapshot after the other steps of a parallel last group",
+		defaults: configuredDefaults,
+		transfer: v1.TransferWorkspace{To: "s3://results/workspaces/default/pr-fetch/source.tar.gz"},
+		parallel: true,
+		wantArgs: []string{"-workspace_snapshots", "/tekton/workspace-transfer/source=s3://results/workspaces/default/pr-fetch/source.tar.gz",
+			"-snapshot_wait_file", "/tekton/run/0/out",
+			"-results_storage_endpoint", "http://minio:9000", "-results_storage_bucket", "results"},
+	}, {
+		desc:     "neither restore nor snapshot",
+		defaults: configuredDefaults,
+	}, {
+		desc:      "bucket not configured",
+		defaults:  map[string]string{},
+		wantError: workspacetransfer.ErrNotConfigured.Error(),
+	}, {
+		desc:      "snapshot of another namespace",
+		defaults:  configuredDefaults,
+		transfer:  v1.TransferWorkspace{From: []string{"s3://results/workspaces/other/pr-fetch/source.tar.gz"}},
+		wantError: `snapshot "s3://results/workspaces/other/pr-fetch/source.tar.gz" of workspace "source" is not a snapshot of namespace "default" in bucket "results"`,
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			names.TestingSeed()
+			store := config.NewStore(logtesting.TestLogger(t))
+			store.OnConfigChanged(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: config.GetDefaultsConfigName(), Namespace: system.Namespace()},
+					Data:       tc.defaults,
+				},
+			)
+			kubeclient := fakek8s.NewSimpleClientset(
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}},
+			)
+			transfer := tc.transfer
+			tr := &v1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pr-build",
+					Namespace:   "default",
+					Annotations: map[string]string{ReleaseAnnotation: fakeVersion},
+				},
+				Spec: v1.TaskRunSpec{
+					Workspaces: []v1.WorkspaceBinding{{
+						Name:     "source",
+						Transfer: &transfer,
+					}, {
+						Name:     "scratch",
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					}},
+				},
+			}
+			ts := v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name:    "compile",
+					Image:   "image",
+					Command: []string{"cmd"},
+				}, {
+					Name:    "test",
+					Image:   "image",
+					Command: []string{"cmd"},
+				}},
+			}
+			if tc.parallel {
+				ts.Steps[0].ParallelGroup = "checks"
+				ts.Steps[1].ParallelGroup = "checks"
+			}
+
+			builder := Builder{
+				Images:          images,
+				KubeClient:      kubeclient,
+				EntrypointCache: fakeCache{},
+			}
+			got, err := builder.Build(store.ToContext(t.Context()), tr, ts)
+			if tc.wantError != "" {
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("builder.Build() error = %v, want %q", err, tc.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("builder.Build: %v", err)
+			}
+
+			transferMount := corev1.VolumeMount{Name: "ws-1bcf2", MountPath: "/tekton/workspace-transfer/source"}
+			credentialsMount := corev1.VolumeMount{Name: "tekton-internal-results-storage", MountPath: "/tekton/results-storage", ReadOnly: true}
+			var restoreInit *corev1.Container
+			for i, c := range got.Spec.InitContainers {
+				if c.Name == "restore-workspaces" {
+					restoreInit = &got.Spec.InitContainers[i]
+				}
+			}
+			if tc.wantInit == nil {
+				if restoreInit != nil {
+					t.Errorf("unexpected restore-workspaces init container %v", restoreInit)
+				}
+			} else {
+				if restoreInit == nil {
+					t.Fatalf("missing restore-workspaces init container in %v", got.Spec.InitContainers)
+				}
+				if d := cmp.Diff(tc.wantInit, restoreInit.Command); d != "" {
+					t.Errorf("restore-workspaces command %s", diff.PrintWantGot(d))
+				}
+				if d := cmp.Diff([]corev1.VolumeMount{credentialsMount, transferMount}, restoreInit.VolumeMounts); d != "" {
+					t.Errorf("restore-workspaces volume mounts %s", diff.PrintWantGot(d))
+				}
+			}
+
+			first, last := got.Spec.Containers[0], got.Spec.Containers[1]
+			if slices.Contains(first.Args, "-workspace_snapshots") {
+				t.Errorf("first step should not snapshot the workspaces: %v", first.Args)
+			}
+			if tc.wantArgs == nil {
+				if slices.Contains(last.Args, "-workspace_snapshots") {
+					t.Errorf("unexpected snapshot of the workspaces: %v", last.Args)
+				}
+				return
+			}
+			if d := cmp.Diff(tc.wantArgs, last.Args[:len(tc.wantArgs)]); d != "" {
+				t.Errorf("last step args %s", diff.PrintWantGot(d))
+			}
+			for _, m := range []corev1.VolumeMount{credentialsMount, transferMount} {
+				if !slices.Contains(last.VolumeMounts, m) {
+					t.Errorf("last step volume mounts %v do not contain %v", last.VolumeMounts, m)
+				}
+			}
+			wantVolume := corev1.Volume{
+				Name:         "tekton-internal-results-storage",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "results-storage"}},
+			}
+			if !slices.ContainsFunc(got.Spec.Volumes, func(v corev1.Volume) bool { return cmp.Equal(v, wantVolume) }) {
+				t.Errorf("missing results storage volume in %v", got.Spec.Volumes)
+			}
+		})
+	}
+}
+
+func TestPodBuild_WorkspaceCache(t *testing.T) {
+	configuredDefaults := map[string]string{
+		"default-results-object-storage-endpoint": "http://minio:9000",
+		"default-results-object-storage-bucket":   "results",
+		"default-results-object-storage-secret":   "results-storage",
+	}
+	for _, tc := range []struct {
+		desc      string
+		defaults  map[string]string
+		hashFiles []string
+		wantError string
+	}{{
+		desc:      "restore and save",
+		defaults:  configuredDefaults,
+		hashFiles: []string{"source/go.sum"},
+	}, {
+		desc:      "bucket not configured",
+		defaults:  map[string]string{},
+		hashFiles: []string{"source/go.sum"},
+		wantError: workspacecache.ErrNotConfigured.Error(),
+	}, {
+		desc:      "hashed workspace not bound",
+		defaults:  configuredDefaults,
+		hashFiles: []string{"missing/go.sum"},
+		wantError: `hashFiles "missing/go.sum" of the cache of workspace "deps" is not in a workspace of the TaskRun`,
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			names.TestingSeed()
+			store := config.NewStore(logtesting.TestLogger(t))
+			store.OnConfigChanged(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: config.GetDefaultsConfigName(), Namespace: system.Namespace()},
+					Data:       tc.defaults,
+				},
+			)
+			kubeclient := fakek8s.NewSimpleClientset(
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}},
+			)
+			tr := &v1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "build",
+					Namespace:   "default",
+					Annotations: map[string]string{ReleaseAnnotation: fakeVersion},
+				},
+				Spec: v1.TaskRunSpec{
+					Workspaces: []v1.WorkspaceBinding{{
+						Name: "deps",
+						Cache: &v1.WorkspaceCache{
+							Key:         "go-$(hash)",
+							HashFiles:   tc.hashFiles,
+							RestoreKeys: []string{"go-"},
+						},
+					}, {
+						Name:     "source",
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					}},
+				},
+			}
+			ts := v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name:    "download",
+					Image:   "image",
+					Command: []string{"cmd"},
+				}, {
+					Name:    "build",
+					Image:   "image",
+					Command: []string{"cmd"},
+				}},
+			}
+
+			builder := Builder{
+				Images:          images,
+				KubeClient:      kubeclient,
+				EntrypointCache: fakeCache{},
+			}
+			got, err := builder.Build(store.ToContext(t.Context()), tr, ts)
+			if tc.wantError != "" {
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("builder.Build() error = %v, want %q", err, tc.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("builder.Build: %v", err)
+			}
+
+			cacheMount := corev1.VolumeMount{Name: "ws-9b056", MountPath: "/tekton/workspace-cache/deps"}
+			hashedMount := corev1.VolumeMount{Name: "ws-1bcf2", MountPath: "/tekton/workspace-cache/source", ReadOnly: true}
+			stateMount := corev1.VolumeMount{Name: "tekton-internal-workspace-cache-state", MountPath: "/tekton/workspace-cache-state"}
+			credentialsMount := corev1.VolumeMount{Name: "tekton-internal-results-storage", MountPath: "/tekton/results-storage", ReadOnly: true}
+			var restoreInit *corev1.Container
+			for i, c := range got.Spec.InitContainers {
+				if c.Name == "restore-workspace-caches" {
+					restoreInit = &got.Spec.InitContainers[i]
+				}
+			}
+			if restoreInit == nil {
+				t.Fatalf("missing restore-workspace-caches init container in %v", got.Spec.InitContainers)
+			}
+			wantInit := []string{"/ko-app/entrypoint", "restore-workspace-caches", "http://minio:9000", "results", "", "default",
+				`{"name":"deps","key":"go-$(hash)","hashFiles":["source/go.sum"],"restoreKeys":["go-"]}`}
+			if d := cmp.Diff(wantInit, restoreInit.Command); d != "" {
+				t.Errorf("restore-workspace-caches command %s", diff.PrintWantGot(d))
+			}
+			if d := cmp.Diff([]corev1.VolumeMount{credentialsMount, cacheMount, hashedMount, stateMount}, restoreInit.VolumeMounts); d != "" {
+				t.Errorf("restore-workspace-caches volume mounts %s", diff.PrintWantGot(d))
+			}
+
+			first, last := got.Spec.Containers[0], got.Spec.Containers[1]
+			if slices.Contains(first.Args, "-workspace_caches") {
+				t.Errorf("first step should not save the caches: %v", first.Args)
+			}
+			wantArgs := []string{"-workspace_caches", "/tekton/workspace-cache/deps=/tekton/workspace-cache-state/deps",
+				"-results_storage_endpoint", "http://minio:9000", "-results_storage_bucket", "results"}
+			if d := cmp.Diff(wantArgs, last.Args[:len(wantArgs)]); d != "" {
+				t.Errorf("last step args %s", diff.PrintWantGot(d))
+			}
+			for _, m := range []corev1.VolumeMount{credentialsMount, cacheMount, stateMount} {
+				if !slices.Contains(last.VolumeMounts, m) {
+					t.Errorf("last step volume mounts %v do not contain %v", last.VolumeMounts, m)
+				}
+			}
+			wantVolume := corev1.Volume{
+				Name:         "tekton-internal-workspace-cache-state",
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}
+			if !slices.ContainsFunc(got.Spec.Volumes, func(v corev1.Volume) bool { return cmp.Equal(v, wantVolume) }) {
+				t.Errorf("missing workspace cache state volume in %v", got.Spec.Volumes)
+			}
+		})
+	}
+}
+
+func TestIsImageVolumeUnsupportedError(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+		Name:         "scratch",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}, {
+		Name:         "toolchain",
+		VolumeSource: corev1.VolumeSource{Image: &corev1.ImageVolumeSource{Reference: "ghcr.io/tektoncd/toolchain:v1"}},
+	}}}}
+	for _, tc := range []struct {
+		desc string
+		err  error
+		want bool
+	}{{
+		desc: "image volume without source",
+		err: k8serrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "build-pod", field.ErrorList{
+			field.Required(field.NewPath("spec", "volumes").Index(1), "must specify a volume type"),
+		}),
+		want: true,
+	}, {
+		desc: "other volume without source",
+		err: k8serrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "build-pod", field.ErrorList{
+			field.Required(field.NewPath("spec", "volumes").Index(0), "must specify a volume type"),
+		}),
+	}, {
+		desc: "other field of the image volume",
+		err: k8serrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "build-pod", field.ErrorList{
+			field.Required(field.NewPath("spec", "volumes").Index(1).Child("name"), ""),
+		}),
+	}, {
+		desc: "other error",
+		err:  k8serrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "build-pod", errors.New("exceeded quota")),
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := IsImageVolumeUnsupportedError(tc.err, pod); got != tc.want {
+				t.Errorf("IsImageVolumeUnsupportedError() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPodBuild_ImageWorkspace(t *testing.T) {
+	image := &corev1.ImageVolumeSource{Reference: "ghcr.io/tektoncd/toolchain:v1", PullPolicy: corev1.PullIfNotPresent}
+	for _, tc := range []struct {
+		desc       string
+		extract    bool
+		cache      fakeCache
+		wantSource corev1.VolumeSource
+		wantInit   bool
+		wantErr    bool
+	}{{
+		desc:       "extracted by an init container",
+		extract:    true,
+		cache:      fakeCache{"ghcr.io/tektoncd/toolchain:v1": &data{id: &imageData{commands: map[string][]string{"linux/amd64": {"sh"}}}}},
+		wantSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		wantInit:   true,
+	}, {
+		desc:    "artifact which can't be extracted",
+		extract: true,
+		cache:   fakeCache{},
+		wantErr: true,
+	}, {
+		desc:       "mounted as an image volume",
+		wantSource: corev1.VolumeSource{Image: image},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			names.TestingSeed()
+			store := config.NewStore(logtesting.TestLogger(t))
+			kubeclient := fakek8s.NewSimpleClientset(
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"}},
+			)
+			tr := &v1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "build",
+					Namespace:   "default",
+					Annotations: map[string]string{ReleaseAnnotation: fakeVersion},
+				},
+				Spec: v1.TaskRunSpec{
+					Workspaces: []v1.WorkspaceBinding{{Name: "toolchain", Image: image}},
+				},
+			}
+			ts, err := workspace.Apply(t.Context(), v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name:    "build",
+					Image:   "image",
+					Command: []string{"cmd"},
+				}},
+			}, tr.Spec.Workspaces, workspace.CreateVolumes(tr.Spec.Workspaces))
+			if err != nil {
+				t.Fatalf("workspace.Apply: %v", err)
+			}
+
+			builder := Builder{
+				Images:              images,
+				KubeClient:          kubeclient,
+				EntrypointCache:     tc.cache,
+				ExtractImageVolumes: tc.extract,
+			}
+			got, err := builder.Build(store.ToContext(t.Context()), tr, *ts)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("builder.Build() should fail for an image which can't be looked up, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("builder.Build: %v", err)
+			}
+
+			volumeName := ts.Volumes[0].Name
+			i := slices.IndexFunc(got.Spec.Volumes, func(v corev1.Volume) bool { return v.Name == volumeName })
+			if i < 0 {
+				t.Fatalf("missing volume %s in %v", volumeName, got.Spec.Volumes)
+			}
+			if d := cmp.Diff(tc.wantSource, got.Spec.Volumes[i].VolumeSource); d != "" {
+				t.Errorf("workspace volume source %s", diff.PrintWantGot(d))
+			}
+			var extractInit *corev1.Container
+			for i, c := range got.Spec.InitContainers {
+				if c.Name == "extract-image-volume-toolchain" {
+					extractInit = &got.Spec.InitContainers[i]
+				}
+			}
+			if !tc.wantInit {
+				if extractInit != nil {
+					t.Errorf("unexpected extract-image-volume init container %v", extractInit)
+				}
+				return
+			}
+			if extractInit == nil {
+				t.Fatalf("missing extract-image-volume init container in %v", got.Spec.InitContainers)
+			}
+			want := corev1.Container{
+				Name:            "extract-image-volume-toolchain",
+				Image:           "ghcr.io/tektoncd/toolchain:v1",
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				WorkingDir:      "/",
+				Command:         []string{"/tekton/bin/entrypoint", "extract-image-volume", "/tekton/image-volume"},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "tekton-internal-bin", MountPath: "/tekton/bin", ReadOnly: true},
+					{Name: volumeName, MountPath: "/tekton/image-volume"},
+				},
+			}
+			if d := cmp.Diff(want, *extractInit); d != "" {
+				t.Errorf("extract-image-volume init container %s", diff.PrintWantGot(d))
+			}
+			if got.Spec.InitContainers[0].Name != "prepare" {
+				t.Errorf("the entrypoint binary should be placed before the images are extracted: %v", got.Spec.InitContainers)
+			}
+		})
+	}
+}
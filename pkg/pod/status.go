@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
 	"github.com/tektoncd/pipeline/internal/sidecarlogresults"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
@@ -125,7 +126,7 @@ func MakeTaskRunStatus(ctx context.Context, logger *zap.SugaredLogger, tr v1.Tas
 
 	sortPodContainerStatuses(pod.Status.ContainerStatuses, pod.Spec.Containers)
 
-	complete := areContainersCompleted(ctx, pod) || isPodCompleted(pod)
+	complete := areContainersCompleted(ctx, pod) || isPodCompleted(pod, ts)
 
 	// When EnableKubernetesSidecar is true, we need to ensure all init containers
 	// are completed before considering the taskRun complete, in addition to the regular containers.
@@ -161,9 +162,13 @@ func MakeTaskRunStatus(ctx context.Context, logger *zap.SugaredLogger, tr v1.Tas
 		if IsContainerSidecar(s.Name) {
 			sidecarStatuses = append(sidecarStatuses, s)
 		}
+		if s.Name == ContainerNameRestoreWorkspaceCaches && s.State.Terminated != nil {
+			setTaskRunWorkspaceCaches(logger, trs, s.State.Terminated.Message)
+		}
 	}
 
 	err := setTaskRunStatusBasedOnStepStatus(ctx, logger, stepStatuses, &tr, pod.Status.Phase, kubeclient, ts)
+	setTaskRunExecutionStartTime(trs)
 
 	setTaskRunStatusBasedOnSidecarStatus(sidecarStatuses, trs)
 
@@ -172,6 +177,29 @@ func MakeTaskRunStatus(ctx context.Context, logger *zap.SugaredLogger, tr v1.Tas
 	return *trs, err
 }
 
+// setTaskRunExecutionStartTime records the time the first Step of the TaskRun started, once its Pod was
+// scheduled and its init containers completed.
+func setTaskRunExecutionStartTime(trs *v1.TaskRunStatus) {
+	if trs.ExecutionStartTime != nil {
+		return
+	}
+	for _, s := range trs.Steps {
+		var startedAt metav1.Time
+		switch {
+		case s.Running != nil:
+			startedAt = s.Running.StartedAt
+		case s.Terminated != nil:
+			startedAt = s.Terminated.StartedAt
+		}
+		if startedAt.IsZero() {
+			continue
+		}
+		if trs.ExecutionStartTime == nil || startedAt.Before(trs.ExecutionStartTime) {
+			trs.ExecutionStartTime = startedAt.DeepCopy()
+		}
+	}
+}
+
 func createTaskResultsFromStepResults(stepRunRes []v1.TaskRunStepResult, neededStepResults map[string]string) []v1.TaskRunResult {
 	taskResults := []v1.TaskRunResult{}
 	for _, r := range stepRunRes {
@@ -181,6 +209,7 @@ func createTaskResultsFromStepResults(stepRunRes []v1.TaskRunStepResult, neededS
 				Name:  neededStepResults[r.Name],
 				Type:  r.Type,
 				Value: r.Value,
+				URI:   r.URI,
 			}
 			taskResults = append(taskResults, taskRunResult)
 		}
@@ -239,6 +268,8 @@ func setTaskRunStatusBasedOnStepStatus(ctx context.Context, logger *zap.SugaredL
 
 	// Extract results from sidecar logs
 	sidecarLogsResultsEnabled := config.FromContextOrDefaults(ctx).FeatureFlags.ResultExtractionMethod == config.ResultExtractionMethodSidecarLogs
+	// The termination messages hold the URIs of the values of the results stored in object storage
+	objectStorageResultsEnabled := config.FromContextOrDefaults(ctx).FeatureFlags.ResultExtractionMethod == config.ResultExtractionMethodObjectStorage
 	// temporary solution to check if artifacts sidecar created in taskRun as we don't have the api for users to declare if a step/task is producing artifacts yet
 	artifactsSidecarCreated := artifactsPathReferenced(ts.Steps)
 	sidecarLogResults := []result.RunResult{}
@@ -255,7 +286,7 @@ func setTaskRunStatusBasedOnStepStatus(ctx context.Context, logger *zap.SugaredL
 	}
 	// Populate Task results from sidecar logs
 	taskResultsFromSidecarLogs := getTaskResultsFromSidecarLogs(sidecarLogResults)
-	taskResults, _, _ := filterResults(taskResultsFromSidecarLogs, specResults, nil)
+	taskResults, _, _ := filterResults(taskResultsFromSidecarLogs, specResults, nil, false)
 	if tr.IsDone() {
 		trs.Results = append(trs.Results, taskResults...)
 		var tras v1.Artifacts
@@ -301,7 +332,7 @@ func setTaskRunStatusBasedOnStepStatus(ctx context.Context, logger *zap.SugaredL
 		if err != nil {
 			errs = append(errs, err)
 		}
-		_, stepRunRes, _ := filterResults(stepResultsFromSidecarLogs, specResults, stepResults)
+		_, stepRunRes, _ := filterResults(stepResultsFromSidecarLogs, specResults, stepResults, false)
 		if tr.IsDone() {
 			taskRunStepResults = append(taskRunStepResults, stepRunRes...)
 			// Set TaskResults from StepResults
@@ -317,6 +348,8 @@ func setTaskRunStatusBasedOnStepStatus(ctx context.Context, logger *zap.SugaredL
 
 		// Parse termination messages
 		terminationReason := ""
+		var attempts int32
+		var attemptExitCodes []int32
 		if state.Terminated != nil && len(state.Terminated.Message) != 0 {
 			msg := state.Terminated.Message
 
@@ -340,8 +373,13 @@ func setTaskRunStatusBasedOnStepStatus(ctx context.Context, logger *zap.SugaredL
 					logger.Errorf("error extracting the exit code of step %q in taskrun %q: %v", s.Name, tr.Name, err)
 					errs = append(errs, err)
 				}
+				attempts, attemptExitCodes, err = extractAttemptsFromResults(results)
+				if err != nil {
+					logger.Errorf("error extracting the attempts of step %q in taskrun %q: %v", s.Name, tr.Name, err)
+					errs = append(errs, err)
+				}
 
-				taskResults, stepRunRes, filteredResults := filterResults(results, specResults, stepResults)
+				taskResults, stepRunRes, filteredResults := filterResults(results, specResults, stepResults, objectStorageResultsEnabled)
 				if tr.IsDone() {
 					taskRunStepResults = append(taskRunStepResults, stepRunRes...)
 					// Set TaskResults from StepResults
@@ -384,6 +422,8 @@ func setTaskRunStatusBasedOnStepStatus(ctx context.Context, logger *zap.SugaredL
 			TerminationReason: terminationReason,
 			Inputs:            sas.Inputs,
 			Outputs:           sas.Outputs,
+			Attempts:          attempts,
+			AttemptExitCodes:  attemptExitCodes,
 		}
 		if stepStateProvenance, exist := stepStateProvenances[stepState.Name]; exist {
 			stepState.Provenance = stepStateProvenance
@@ -462,7 +502,7 @@ func findStepResultsFetchedByTask(containerName string, specResults []v1.TaskRes
 // filterResults filters the RunResults and TaskResults based on the results declared in the task spec.
 // It returns a slice of any of the input results that are defined in the task spec, converted to TaskRunResults,
 // and a slice of any of the RunResults that don't represent internal values (i.e. those that should not be displayed in the TaskRun status.
-func filterResults(results []result.RunResult, specResults []v1.TaskResult, stepResults []v1.StepResult) ([]v1.TaskRunResult, []v1.TaskRunStepResult, []result.RunResult) {
+func filterResults(results []result.RunResult, specResults []v1.TaskResult, stepResults []v1.StepResult, storedValues bool) ([]v1.TaskRunResult, []v1.TaskRunStepResult, []result.RunResult) {
 	var taskResults []v1.TaskRunResult
 	var taskRunStepResults []v1.TaskRunStepResult
 	var filteredResults []result.RunResult
@@ -478,13 +518,16 @@ func filterResults(results []result.RunResult, specResults []v1.TaskResult, step
 		switch r.ResultType {
 		case result.TaskRunResultType:
 			var taskRunResult v1.TaskRunResult
-			if neededTypes[r.Key] == v1.ResultsTypeString {
+			switch {
+			case storedValues && objectstorageresults.IsURI(r.Value):
+				taskRunResult = storedTaskRunResult(r.Key, neededTypes[r.Key], r.Value)
+			case neededTypes[r.Key] == v1.ResultsTypeString:
 				taskRunResult = v1.TaskRunResult{
 					Name:  r.Key,
 					Type:  v1.ResultsTypeString,
 					Value: *v1.NewStructuredValues(r.Value),
 				}
-			} else {
+			default:
 				v := v1.ResultValue{}
 				err := v.UnmarshalJSON([]byte(r.Value))
 				if err != nil {
@@ -500,13 +543,16 @@ func filterResults(results []result.RunResult, specResults []v1.TaskResult, step
 			filteredResults = append(filteredResults, r)
 		case result.StepResultType:
 			var taskRunStepResult v1.TaskRunStepResult
-			if neededStepTypes[r.Key] == v1.ResultsTypeString {
+			switch {
+			case storedValues && objectstorageresults.IsURI(r.Value):
+				taskRunStepResult = storedTaskRunResult(r.Key, neededStepTypes[r.Key], r.Value)
+			case neededStepTypes[r.Key] == v1.ResultsTypeString:
 				taskRunStepResult = v1.TaskRunStepResult{
 					Name:  r.Key,
 					Type:  v1.ResultsTypeString,
 					Value: *v1.NewStructuredValues(r.Value),
 				}
-			} else {
+			default:
 				v := v1.ResultValue{}
 				err := v.UnmarshalJSON([]byte(r.Value))
 				if err != nil {
@@ -536,6 +582,21 @@ func filterResults(results []result.RunResult, specResults []v1.TaskResult, step
 	return taskResults, taskRunStepResults, filteredResults
 }
 
+// storedTaskRunResult returns the TaskRunResult of a result whose value is stored in object storage
+// at the URI. Its value is left empty, with the type declared for the result or string by default.
+func storedTaskRunResult(name string, resultType v1.ResultsType, uri string) v1.TaskRunResult {
+	value := v1.ResultValue{Type: v1.ParamTypeString}
+	switch resultType {
+	case v1.ResultsTypeArray:
+		value = v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{}}
+	case v1.ResultsTypeObject:
+		value = v1.ResultValue{Type: v1.ParamTypeObject, ObjectVal: map[string]string{}}
+	default:
+		resultType = v1.ResultsTypeString
+	}
+	return v1.TaskRunResult{Name: name, Type: resultType, Value: value, URI: uri}
+}
+
 func removeDuplicateResults(taskRunResult []v1.TaskRunResult) []v1.TaskRunResult {
 	if len(taskRunResult) == 0 {
 		return nil
@@ -584,6 +645,38 @@ func extractExitCodeFromResults(results []result.RunResult) (*int32, error) {
 	return nil, nil //nolint:nilnil // would be more ergonomic to return a sentinel error
 }
 
+// extractAttemptsFromResults returns the number of attempts of a step with retries,
+// and the exit codes of the attempts that exited.
+func extractAttemptsFromResults(results []result.RunResult) (int32, []int32, error) {
+	var attempts int32
+	var exitCodes []int32
+	for _, r := range results {
+		if r.ResultType != result.InternalTektonResultType {
+			continue
+		}
+		switch r.Key {
+		case "Attempts":
+			i, err := strconv.ParseInt(r.Value, 10, 32)
+			if err != nil {
+				return 0, nil, fmt.Errorf("could not parse int value %q in Attempts field: %w", r.Value, err)
+			}
+			attempts = int32(i) // #nosec G115: ParseInt was called with bit size 32, so this is safe
+		case "AttemptExitCodes":
+			if r.Value == "" {
+				continue
+			}
+			for _, v := range strings.Split(r.Value, ",") {
+				i, err := strconv.ParseInt(v, 10, 32)
+				if err != nil {
+					return 0, nil, fmt.Errorf("could not parse int value %q in AttemptExitCodes field: %w", v, err)
+				}
+				exitCodes = append(exitCodes, int32(i)) // #nosec G115: ParseInt was called with bit size 32, so this is safe
+			}
+		}
+	}
+	return attempts, exitCodes, nil
+}
+
 func extractTerminationReasonFromResults(results []result.RunResult) string {
 	for _, r := range results {
 		if r.ResultType == result.InternalTektonResultType && r.Key == "Reason" {
@@ -652,23 +745,39 @@ func updateIncompleteTaskRunStatus(trs *v1.TaskRunStatus, pod *corev1.Pod) {
 // but it remains in a Running status for a visible period of time, it should be considered completed in advance.
 //
 // For example, when certain steps encounter OOM, only the pods that have timed out will change to a failed state,
-// we should consider them completed in advance.
-func isPodCompleted(pod *corev1.Pod) bool {
+// we should consider them completed in advance. The other steps of a parallel group keep running when one of them
+// encounters OOM though, so the pod is only considered completed once the whole group is done.
+func isPodCompleted(pod *corev1.Pod, ts *v1.TaskSpec) bool {
 	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
 		return true
 	}
+	var stepStatuses []corev1.ContainerStatus
 	for _, s := range pod.Status.ContainerStatuses {
 		if IsContainerStep(s.Name) {
-			if s.State.Terminated != nil {
-				if isOOMKilled(s) {
-					return true
-				}
+			stepStatuses = append(stepStatuses, s)
+		}
+	}
+	groups := stepGroups(ts, len(stepStatuses))
+	for i, s := range stepStatuses {
+		if s.State.Terminated != nil {
+			if isOOMKilled(s) && isStepGroupTerminated(stepStatuses, groups, groups[i]) {
+				return true
 			}
 		}
 	}
 	return false
 }
 
+// isStepGroupTerminated checks if all the steps of the given group are terminated.
+func isStepGroupTerminated(stepStatuses []corev1.ContainerStatus, groups []int, group int) bool {
+	for i, s := range stepStatuses {
+		if groups[i] == group && s.State.Terminated == nil {
+			return false
+		}
+	}
+	return true
+}
+
 // DidTaskRunFail check the status of pod to decide if related taskrun is failed
 func DidTaskRunFail(pod *corev1.Pod) bool {
 	if pod.Status.Phase == corev1.PodFailed {
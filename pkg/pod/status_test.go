@@ -248,6 +248,49 @@ func TestSetTaskRunStatusBasedOnStepStatus_sidecar_logs(t *testing.T) {
 	}
 }
 
+func TestSetTaskRunExecutionStartTime(t *testing.T) {
+	first := metav1.NewTime(time.Date(2022, time.January, 1, 0, 1, 0, 0, time.UTC))
+	second := metav1.NewTime(time.Date(2022, time.January, 1, 0, 2, 0, 0, time.UTC))
+	for _, c := range []struct {
+		desc string
+		trs  v1.TaskRunStatus
+		want *metav1.Time
+	}{{
+		desc: "no step started",
+		trs: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+			Steps: []v1.StepState{{
+				ContainerState: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}},
+			}},
+		}},
+	}, {
+		desc: "earliest start of running and terminated steps",
+		trs: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+			Steps: []v1.StepState{{
+				ContainerState: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: second}},
+			}, {
+				ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{StartedAt: first}},
+			}},
+		}},
+		want: &first,
+	}, {
+		desc: "already recorded",
+		trs: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+			ExecutionStartTime: &second,
+			Steps: []v1.StepState{{
+				ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{StartedAt: first}},
+			}},
+		}},
+		want: &second,
+	}} {
+		t.Run(c.desc, func(t *testing.T) {
+			setTaskRunExecutionStartTime(&c.trs)
+			if d := cmp.Diff(c.want, c.trs.ExecutionStartTime); d != "" {
+				t.Errorf("Unexpected execution start time %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestMakeTaskRunStatus_StepResults(t *testing.T) {
 	for _, c := range []struct {
 		desc      string
@@ -1929,6 +1972,47 @@ func TestMakeTaskRunStatus(t *testing.T) {
 				CompletionTime: &metav1.Time{Time: time.Now()},
 			},
 		},
+	}, {
+		desc: "include the attempts of a step with retries",
+		pod: corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pod",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name: "step-npm-install",
+				}},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: "step-npm-install",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							Message: `[{"key":"Attempts","value":"3","type":"InternalTektonResult"},{"key":"AttemptExitCodes","value":"1,1,0","type":"InternalTektonResult"}]`,
+						},
+					},
+				}},
+			},
+		},
+		want: v1.TaskRunStatus{
+			Status: statusSuccess(),
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				Steps: []v1.StepState{{
+					ContainerState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{},
+					},
+					Name:             "npm-install",
+					Container:        "step-npm-install",
+					Attempts:         3,
+					AttemptExitCodes: []int32{1, 1, 0},
+				}},
+				Sidecars:  []v1.SidecarState{},
+				Artifacts: &v1.Artifacts{},
+				// We don't actually care about the time, just that it's not nil
+				CompletionTime: &metav1.Time{Time: time.Now()},
+			},
+		},
 	}, {
 		desc: "when pod is pending because of pulling image then the error should bubble up to taskrun status",
 		pod: corev1.Pod{
@@ -3097,6 +3181,120 @@ func TestMakeTaskRunStatusAlpha(t *testing.T) {
 	}
 }
 
+func TestMakeTaskRunStatus_ObjectStorageResults(t *testing.T) {
+	uri := "s3://results/sha256/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod",
+			Namespace: "foo",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodSucceeded,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "step-bar",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Message: `[{"key":"str","value":"` + uri + `","type":1},{"key":"arr","value":"` + uri + `","type":1},{"key":"inline","value":"hello","type":1}]`,
+					},
+				},
+			}},
+		},
+	}
+	taskSpec := v1.TaskSpec{
+		Results: []v1.TaskResult{
+			{Name: "str", Type: v1.ResultsTypeString},
+			{Name: "arr", Type: v1.ResultsTypeArray},
+			{Name: "inline", Type: v1.ResultsTypeString},
+		},
+	}
+	tr := v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "task-run",
+			Namespace: "foo",
+		},
+	}
+	ctx := config.ToContext(t.Context(), &config.Config{
+		FeatureFlags: &config.FeatureFlags{
+			ResultExtractionMethod: config.ResultExtractionMethodObjectStorage,
+			MaxResultSize:          config.DefaultMaxResultSize,
+		},
+	})
+	logger, _ := logging.NewLogger("", "status")
+	got, err := MakeTaskRunStatus(ctx, logger, tr, &pod, fakek8s.NewSimpleClientset(), &taskSpec)
+	if err != nil {
+		t.Fatalf("MakeTaskRunStatus: %s", err)
+	}
+
+	want := []v1.TaskRunResult{{
+		Name:  "arr",
+		Type:  v1.ResultsTypeArray,
+		Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{}},
+		URI:   uri,
+	}, {
+		Name:  "inline",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues("hello"),
+	}, {
+		Name:  "str",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues(""),
+		URI:   uri,
+	}}
+	if d := cmp.Diff(want, got.Results); d != "" {
+		t.Errorf("Diff %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestMakeTaskRunStatus_WorkspaceCaches(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod",
+			Namespace: "foo",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name: "restore-workspace-caches",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Message: `[{"key":"WorkspaceCache.deps","value":"{\"name\":\"deps\",\"key\":\"go-abc\",\"restoredKey\":\"go-def\",\"outcome\":\"PartialHit\"}","type":3},` +
+							`{"key":"WorkspaceCache.tools","value":"{\"name\":\"tools\",\"key\":\"tools\",\"outcome\":\"Miss\"}","type":3}]`,
+					},
+				},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "step-build",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+	tr := v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "task-run",
+			Namespace: "foo",
+		},
+	}
+	logger, _ := logging.NewLogger("", "status")
+	got, err := MakeTaskRunStatus(t.Context(), logger, tr, &pod, fakek8s.NewSimpleClientset(), &v1.TaskSpec{})
+	if err != nil {
+		t.Fatalf("MakeTaskRunStatus: %s", err)
+	}
+
+	want := []v1.WorkspaceCacheStatus{{
+		Name:        "deps",
+		Key:         "go-abc",
+		RestoredKey: "go-def",
+		Outcome:     v1.WorkspaceCachePartialHit,
+	}, {
+		Name:    "tools",
+		Key:     "tools",
+		Outcome: v1.WorkspaceCacheMiss,
+	}}
+	if d := cmp.Diff(want, got.WorkspaceCaches); d != "" {
+		t.Errorf("Diff %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestMakeRunStatusJSONError(t *testing.T) {
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -4313,6 +4511,74 @@ func TestMakeTaskRunStatus_SidecarFailed(t *testing.T) {
 	}
 }
 
+func TestMakeTaskRunStatus_ParallelGroupOOM(t *testing.T) {
+	taskSpec := v1.TaskSpec{
+		Steps: []v1.Step{
+			{Name: "lint", ParallelGroup: "checks"},
+			{Name: "test", ParallelGroup: "checks"},
+			{Name: "build"},
+		},
+	}
+	oomKilledState := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: oomKilled}}
+	runningState := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	for _, tc := range []struct {
+		desc       string
+		testState  corev1.ContainerState
+		wantStatus corev1.ConditionStatus
+		wantReason string
+	}{{
+		desc:       "other step of the parallel group still running",
+		testState:  runningState,
+		wantStatus: corev1.ConditionUnknown,
+		wantReason: v1.TaskRunReasonRunning.String(),
+	}, {
+		desc:       "other step of the parallel group done",
+		testState:  corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}},
+		wantStatus: corev1.ConditionFalse,
+		wantReason: v1.TaskRunReasonStepOOM.String(),
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			pod := corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod",
+					Namespace: "foo",
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "step-lint"},
+						{Name: "step-test"},
+						{Name: "step-build"},
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "step-lint", State: oomKilledState},
+						{Name: "step-test", State: tc.testState},
+						{Name: "step-build", State: runningState},
+					},
+				},
+			}
+			tr := v1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "task-run",
+					Namespace: "foo",
+				},
+			}
+			logger, _ := logging.NewLogger("", "status")
+			kubeclient := fakek8s.NewSimpleClientset()
+			got, err := MakeTaskRunStatus(t.Context(), logger, tr, &pod, kubeclient, &taskSpec)
+			if err != nil {
+				t.Errorf("MakeTaskRunStatus: %s", err)
+			}
+			condition := got.GetCondition(apis.ConditionSucceeded)
+			if condition.Status != tc.wantStatus || condition.Reason != tc.wantReason {
+				t.Errorf("expected condition %s with reason %q, got %s with reason %q", tc.wantStatus, tc.wantReason, condition.Status, condition.Reason)
+			}
+		})
+	}
+}
+
 func TestMakeTaskRunStatus_MultiFailurePriority(t *testing.T) {
 	// Multi-failure tests verifying priority ordering through MakeTaskRunStatus.
 	for _, tc := range []struct {
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/tektoncd/pipeline/internal/workspacecache"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/termination"
+	"github.com/tektoncd/pipeline/pkg/workspace"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// workspaceCacheStateVolumeName is the name of the Volume in which the init container restoring the
+// cache workspaces tells the last Step which of them to save.
+const workspaceCacheStateVolumeName = "tekton-internal-workspace-cache-state"
+
+// workspaceCaches holds what a Pod needs to restore and save the cache workspaces.
+type workspaceCaches struct {
+	// caches are the JSON encodings of the caches restored by the init container
+	caches []string
+	// restoreMounts mount the cache workspaces and the workspaces holding the hashed files
+	restoreMounts []corev1.VolumeMount
+	// saves are the <dir>=<state file> of the caches saved by the last Step
+	saves      []string
+	saveMounts []corev1.VolumeMount
+}
+
+// newWorkspaceCaches returns the workspaceCaches of the cache workspaces of the TaskRun, or nil if
+// there are none. Like the workspaces bound with transfer, their volumes are mounted under
+// workspacecache.MountDir in the init container and the last Step.
+func newWorkspaceCaches(ctx context.Context, taskRun *v1.TaskRun) (*workspaceCaches, error) {
+	bound := map[string]v1.WorkspaceBinding{}
+	var bindings []v1.WorkspaceBinding
+	for _, wb := range taskRun.Spec.Workspaces {
+		bound[wb.Name] = wb
+		if wb.Cache != nil {
+			bindings = append(bindings, wb)
+		}
+	}
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+	cfg := config.FromContextOrDefaults(ctx).Defaults
+	if cfg.DefaultResultsObjectStorageEndpoint == "" || cfg.DefaultResultsObjectStorageBucket == "" || cfg.DefaultResultsObjectStorageSecret == "" {
+		return nil, workspacecache.ErrNotConfigured
+	}
+
+	volumes := workspace.CreateVolumes(taskRun.Spec.Workspaces)
+	mount := func(name string) corev1.VolumeMount {
+		return corev1.VolumeMount{
+			Name:      volumes[name].Name,
+			MountPath: filepath.Join(workspacecache.MountDir, name),
+			SubPath:   bound[name].SubPath,
+		}
+	}
+	c := &workspaceCaches{}
+	hashed := sets.New[string]()
+	for _, wb := range bindings {
+		for _, f := range wb.Cache.HashFiles {
+			name, _, _ := strings.Cut(f, "/")
+			if _, ok := bound[name]; !ok {
+				return nil, fmt.Errorf("hashFiles %q of the cache of workspace %q is not in a workspace of the TaskRun", f, wb.Name)
+			}
+			if bound[name].Cache == nil {
+				hashed.Insert(name)
+			}
+		}
+		cache, err := json.Marshal(workspacecache.Cache{Name: wb.Name, WorkspaceCache: *wb.Cache})
+		if err != nil {
+			return nil, err
+		}
+		c.caches = append(c.caches, string(cache))
+		c.restoreMounts = append(c.restoreMounts, mount(wb.Name))
+		c.saves = append(c.saves, filepath.Join(workspacecache.MountDir, wb.Name)+"="+filepath.Join(workspacecache.StateDir, wb.Name))
+		c.saveMounts = append(c.saveMounts, mount(wb.Name))
+	}
+	for _, name := range sets.List(hashed) {
+		m := mount(name)
+		m.ReadOnly = true
+		c.restoreMounts = append(c.restoreMounts, m)
+	}
+	stateMount := corev1.VolumeMount{Name: workspaceCacheStateVolumeName, MountPath: workspacecache.StateDir}
+	c.restoreMounts = append(c.restoreMounts, stateMount)
+	c.saveMounts = append(c.saveMounts, stateMount)
+	return c, nil
+}
+
+// stateVolume returns the Volume shared by the init container and the last Step.
+func (c *workspaceCaches) stateVolume() corev1.Volume {
+	return corev1.Volume{
+		Name:         workspaceCacheStateVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}
+}
+
+// initContainer returns the init container restoring the cache workspaces, which reports how they
+// were restored in its termination message. It runs the entrypoint binary of the image.
+func (c *workspaceCaches) initContainer(ctx context.Context, namespace, image string, credentialsMount corev1.VolumeMount, securityContext SecurityContextConfig, windows bool) corev1.Container {
+	cfg := config.FromContextOrDefaults(ctx).Defaults
+	command := []string{"/ko-app/entrypoint", "restore-workspace-caches",
+		cfg.DefaultResultsObjectStorageEndpoint, cfg.DefaultResultsObjectStorageBucket, cfg.DefaultResultsObjectStorageRegion, namespace}
+	container := corev1.Container{
+		Name:         ContainerNameRestoreWorkspaceCaches,
+		Image:        image,
+		WorkingDir:   "/",
+		Command:      append(command, c.caches...),
+		VolumeMounts: append([]corev1.VolumeMount{credentialsMount}, c.restoreMounts...),
+	}
+	if securityContext.SetSecurityContext {
+		container.SecurityContext = securityContext.GetSecurityContext(windows)
+	}
+	return container
+}
+
+// saveArgs returns the entrypoint arguments of the last Step saving the cache workspaces, which
+// are empty if there are none.
+func (c *workspaceCaches) saveArgs() []string {
+	if c == nil {
+		return nil
+	}
+	return []string{"-workspace_caches", strings.Join(c.saves, ",")}
+}
+
+// setTaskRunWorkspaceCaches reports how the cache workspaces were restored, from the termination
+// message of the init container restoring them.
+func setTaskRunWorkspaceCaches(logger *zap.SugaredLogger, trs *v1.TaskRunStatus, msg string) {
+	results, err := termination.ParseMessage(logger, msg)
+	if err == nil {
+		trs.WorkspaceCaches, err = workspacecache.StatusesFromResults(results)
+	}
+	if err != nil {
+		logger.Errorf("termination message could not be parsed as workspace cache statuses: %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/workspace"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// workspaceTransfer holds what a Pod needs to restore and snapshot the workspaces bound with transfer.
+type workspaceTransfer struct {
+	// restores are the <dir>=<uri> of the snapshots extracted by the init container, in order
+	restores      []string
+	restoreMounts []corev1.VolumeMount
+	// snapshots are the <dir>=<uri> of the snapshots uploaded by the last Step
+	snapshots      []string
+	snapshotMounts []corev1.VolumeMount
+}
+
+// newWorkspaceTransfer returns the workspaceTransfer of the workspaces of the TaskRun bound with
+// transfer, or nil if there are none. The volumes of the workspaces are mounted under
+// workspacetransfer.MountDir, so that they are restored and snapshotted even when the Steps
+// use isolated workspaces.
+func newWorkspaceTransfer(ctx context.Context, taskRun *v1.TaskRun) (*workspaceTransfer, error) {
+	var bindings []v1.WorkspaceBinding
+	for _, wb := range taskRun.Spec.Workspaces {
+		if wb.Transfer != nil {
+			bindings = append(bindings, wb)
+		}
+	}
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+	cfg := config.FromContextOrDefaults(ctx).Defaults
+	if cfg.DefaultResultsObjectStorageEndpoint == "" || cfg.DefaultResultsObjectStorageBucket == "" || cfg.DefaultResultsObjectStorageSecret == "" {
+		return nil, workspacetransfer.ErrNotConfigured
+	}
+
+	// The names of the volumes only depend on the bindings, so they match the ones the
+	// workspaces are mounted with in the Steps.
+	volumes := workspace.CreateVolumes(taskRun.Spec.Workspaces)
+	t := &workspaceTransfer{}
+	for _, wb := range bindings {
+		mount := corev1.VolumeMount{
+			Name:      volumes[wb.Name].Name,
+			MountPath: filepath.Join(workspacetransfer.MountDir, wb.Name),
+			SubPath:   wb.SubPath,
+		}
+		for _, uri := range append(slices.Clone(wb.Transfer.From), wb.Transfer.To) {
+			if uri != "" && !workspacetransfer.InNamespace(uri, cfg.DefaultResultsObjectStorageBucket, taskRun.Namespace) {
+				return nil, fmt.Errorf("snapshot %q of workspace %q is not a snapshot of namespace %q in bucket %q", uri, wb.Name, taskRun.Namespace, cfg.DefaultResultsObjectStorageBucket)
+			}
+		}
+		if len(wb.Transfer.From) > 0 {
+			for _, uri := range wb.Transfer.From {
+				t.restores = append(t.restores, mount.MountPath+"="+uri)
+			}
+			t.restoreMounts = append(t.restoreMounts, mount)
+		}
+		if wb.Transfer.To != "" {
+			t.snapshots = append(t.snapshots, mount.MountPath+"="+wb.Transfer.To)
+			t.snapshotMounts = append(t.snapshotMounts, mount)
+		}
+	}
+	return t, nil
+}
+
+// initContainer returns the init container extracting the snapshots into the workspaces, or nil
+// if there is nothing to restore. It runs the entrypoint binary of the image.
+func (t *workspaceTransfer) initContainer(ctx context.Context, image string, credentialsMount corev1.VolumeMount, securityContext SecurityContextConfig, windows bool) *corev1.Container {
+	if len(t.restores) == 0 {
+		return nil
+	}
+	cfg := config.FromContextOrDefaults(ctx).Defaults
+	command := []string{"/ko-app/entrypoint", "restore-workspaces",
+		cfg.DefaultResultsObjectStorageEndpoint, cfg.DefaultResultsObjectStorageBucket, cfg.DefaultResultsObjectStorageRegion}
+	c := &corev1.Container{
+		Name:         ContainerNameRestoreWorkspaces,
+		Image:        image,
+		WorkingDir:   "/",
+		Command:      append(command, t.restores...),
+		VolumeMounts: append([]corev1.VolumeMount{credentialsMount}, t.restoreMounts...),
+	}
+	if securityContext.SetSecurityContext {
+		c.SecurityContext = securityContext.GetSecurityContext(windows)
+	}
+	return c
+}
+
+// snapshotArgs returns the entrypoint arguments of the last Step uploading the snapshots,
+// which are empty if there is nothing to snapshot.
+func (t *workspaceTransfer) snapshotArgs() []string {
+	if t == nil || len(t.snapshots) == 0 {
+		return nil
+	}
+	return []string{"-workspace_snapshots", strings.Join(t.snapshots, ",")}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalrequest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	clientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	customrunreconciler "github.com/tektoncd/pipeline/pkg/client/injection/reconciler/pipeline/v1beta1/customrun"
+	listersalpha "github.com/tektoncd/pipeline/pkg/client/listers/pipeline/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+const (
+	// ApproversParam is the name of the array param listing the approvers. Groups are prefixed with "group:".
+	ApproversParam = "approvers"
+	// NumberOfApprovalsRequiredParam is the name of the param holding the number of approvals required.
+	NumberOfApprovalsRequiredParam = "numberOfApprovalsRequired"
+	// DescriptionParam is the name of the param holding the description shown to the approvers.
+	DescriptionParam = "description"
+
+	// DecisionResult is the name of the result holding the state of the ApprovalRequest.
+	DecisionResult = "decision"
+	// ApproversResult is the name of the result holding the comma separated list of users who approved.
+	ApproversResult = "approvers"
+
+	// ReasonPending indicates that the ApprovalRequest is waiting for responses.
+	ReasonPending = "ApprovalRequestPending"
+	// ReasonApproved indicates that the ApprovalRequest was approved.
+	ReasonApproved = "ApprovalRequestApproved"
+	// ReasonRejected indicates that the ApprovalRequest was rejected.
+	ReasonRejected = "ApprovalRequestRejected"
+	// ReasonInvalidParams indicates that the params of the CustomRun do not make a valid ApprovalRequest.
+	ReasonInvalidParams = "ApprovalRequestInvalidParams"
+	// ReasonNotOwned indicates that an ApprovalRequest with the name of the CustomRun exists,
+	// but is not owned by the CustomRun.
+	ReasonNotOwned = "ApprovalRequestNotOwned"
+	// ReasonDisabled indicates that the ApprovalRequest kind is used without enabling alpha features.
+	ReasonDisabled = "ApprovalRequestDisabled"
+
+	groupPrefix = "group:"
+)
+
+// Reconciler implements controller.Reconciler for CustomRuns referencing the ApprovalRequest kind.
+type Reconciler struct {
+	PipelineClientSet     clientset.Interface
+	Clock                 clock.PassiveClock
+	approvalRequestLister listersalpha.ApprovalRequestLister
+}
+
+var _ customrunreconciler.Interface = (*Reconciler)(nil)
+
+// ReconcileKind creates the ApprovalRequest of the CustomRun, and completes the CustomRun once the
+// ApprovalRequest is approved or rejected, or once the CustomRun is cancelled or times out.
+func (c *Reconciler) ReconcileKind(ctx context.Context, run *v1beta1.CustomRun) pkgreconciler.Event {
+	logger := logging.FromContext(ctx)
+	if run.IsDone() {
+		logger.Debugf("CustomRun %s is done", run.Name)
+		return nil
+	}
+	if !run.HasStarted() {
+		run.Status.InitializeConditions()
+		run.Status.StartTime = &metav1.Time{Time: c.Clock.Now()}
+	}
+
+	if err := config.ValidateEnabledAPIFields(ctx, "ApprovalRequest", config.AlphaAPIFields); err != nil {
+		run.Status.MarkCustomRunFailed(ReasonDisabled, err.Error())
+		return nil
+	}
+
+	spec, err := approvalRequestSpec(ctx, run)
+	if err != nil {
+		run.Status.MarkCustomRunFailed(ReasonInvalidParams, "Invalid params for ApprovalRequest %s: %v", run.Name, err)
+		return nil
+	}
+	ar, err := c.approvalRequestLister.ApprovalRequests(run.Namespace).Get(run.Name)
+	switch {
+	case k8serrors.IsNotFound(err):
+		if ar, err = c.createApprovalRequest(ctx, run, spec); err != nil {
+			return fmt.Errorf("failed to create ApprovalRequest %s: %w", run.Name, err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get ApprovalRequest %s: %w", run.Name, err)
+	case !metav1.IsControlledBy(ar, run):
+		run.Status.MarkCustomRunFailed(ReasonNotOwned, "ApprovalRequest %s already exists and is not owned by CustomRun %s", ar.Name, run.Name)
+		return nil
+	}
+
+	state, approvers, rejecters := ar.Spec.Decide()
+	switch {
+	case state == v1alpha1.ApprovalStateApproved:
+		run.Status.MarkCustomRunSucceeded(ReasonApproved, "ApprovalRequest %s was approved by %s", ar.Name, strings.Join(approvers, ", "))
+	case state == v1alpha1.ApprovalStateRejected:
+		run.Status.MarkCustomRunFailed(ReasonRejected, "ApprovalRequest %s was rejected by %s", ar.Name, strings.Join(rejecters, ", "))
+	case run.IsCancelled():
+		state = v1alpha1.ApprovalStateCancelled
+		msg := string(run.Spec.StatusMessage)
+		if msg == "" {
+			msg = fmt.Sprintf("CustomRun %s was cancelled", run.Name)
+		}
+		run.Status.MarkCustomRunFailed(v1beta1.CustomRunReasonCancelled.String(), msg)
+	case run.HasTimedOut(c.Clock):
+		state = v1alpha1.ApprovalStateTimedOut
+		run.Status.MarkCustomRunFailed(v1beta1.CustomRunReasonTimedOut.String(), "ApprovalRequest %s did not get %d approvals in %s", ar.Name, ar.Spec.GetNumberOfApprovalsRequired(), run.GetTimeout())
+	default:
+		run.Status.MarkCustomRunRunning(ReasonPending, "ApprovalRequest %s is waiting for %d approvals, got %d", ar.Name, ar.Spec.GetNumberOfApprovalsRequired(), len(approvers))
+	}
+	run.Status.Results = []v1beta1.CustomRunResult{
+		{Name: DecisionResult, Value: string(state)},
+		{Name: ApproversResult, Value: strings.Join(approvers, ",")},
+	}
+
+	if err := c.updateApprovalRequestStatus(ctx, ar, v1alpha1.ApprovalRequestStatus{
+		State:     state,
+		Approvers: approvers,
+		Rejecters: rejecters,
+	}); err != nil {
+		return err
+	}
+
+	if !run.IsDone() && run.GetTimeout() != config.NoTimeoutDuration {
+		// Responses requeue the CustomRun through the ApprovalRequest informer,
+		// so only wait for the timeout here.
+		return controller.NewRequeueAfter(run.GetTimeout() - c.Clock.Since(run.Status.StartTime.Time) + time.Second)
+	}
+	return nil
+}
+
+// approvalRequestSpec builds the spec of the ApprovalRequest of the CustomRun from its params.
+func approvalRequestSpec(ctx context.Context, run *v1beta1.CustomRun) (v1alpha1.ApprovalRequestSpec, error) {
+	spec := v1alpha1.ApprovalRequestSpec{}
+	for _, p := range run.Spec.Params {
+		switch p.Name {
+		case ApproversParam:
+			if p.Value.Type != v1beta1.ParamTypeArray {
+				return spec, fmt.Errorf("param %s must be an array", ApproversParam)
+			}
+			for _, name := range p.Value.ArrayVal {
+				if group, ok := strings.CutPrefix(name, groupPrefix); ok {
+					spec.Approvers = append(spec.Approvers, v1alpha1.Approver{Name: group, Type: v1alpha1.ApproverTypeGroup})
+				} else {
+					spec.Approvers = append(spec.Approvers, v1alpha1.Approver{Name: name, Type: v1alpha1.ApproverTypeUser})
+				}
+			}
+		case NumberOfApprovalsRequiredParam:
+			n, err := strconv.Atoi(p.Value.StringVal)
+			if err != nil {
+				return spec, fmt.Errorf("param %s must be an integer: %w", NumberOfApprovalsRequiredParam, err)
+			}
+			spec.NumberOfApprovalsRequired = n
+		case DescriptionParam:
+			spec.Description = p.Value.StringVal
+		default:
+			return spec, fmt.Errorf("unexpected param %s", p.Name)
+		}
+	}
+	if err := spec.Validate(ctx); err != nil {
+		return spec, err
+	}
+	return spec, nil
+}
+
+func (c *Reconciler) createApprovalRequest(ctx context.Context, run *v1beta1.CustomRun, spec v1alpha1.ApprovalRequestSpec) (*v1alpha1.ApprovalRequest, error) {
+	labels := make(map[string]string, len(run.Labels)+1)
+	for key, value := range run.Labels {
+		labels[key] = value
+	}
+	labels[pipeline.CustomRunKey] = run.Name
+	ar := &v1alpha1.ApprovalRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            run.Name,
+			Namespace:       run.Namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(run)},
+		},
+		Spec: spec,
+	}
+	return c.PipelineClientSet.TektonV1alpha1().ApprovalRequests(run.Namespace).Create(ctx, ar, metav1.CreateOptions{})
+}
+
+func (c *Reconciler) updateApprovalRequestStatus(ctx context.Context, ar *v1alpha1.ApprovalRequest, status v1alpha1.ApprovalRequestStatus) error {
+	if equality.Semantic.DeepEqual(ar.Status, status) {
+		return nil
+	}
+	newAR := ar.DeepCopy()
+	newAR.Status = status
+	if _, err := c.PipelineClientSet.TektonV1alpha1().ApprovalRequests(ar.Namespace).UpdateStatus(ctx, newAR, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update status of ApprovalRequest %s: %w", ar.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	th "github.com/tektoncd/pipeline/pkg/reconciler/testing"
+	"github.com/tektoncd/pipeline/test"
+	"github.com/tektoncd/pipeline/test/diff"
+	"github.com/tektoncd/pipeline/test/parse"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clock "k8s.io/utils/clock/testing"
+	"knative.dev/pkg/apis"
+	cminformer "knative.dev/pkg/configmap/informer"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+	_ "knative.dev/pkg/system/testing" // Setup system.Namespace()
+)
+
+var (
+	now                      = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testClock                = clock.NewFakePassiveClock(now)
+	ignoreLastTransitionTime = cmpopts.IgnoreFields(apis.Condition{}, "LastTransitionTime.Inner.Time")
+)
+
+// getApprovalRequestController returns an instance of the ApprovalRequest controller/reconciler that has been seeded with
+// d, where d represents the state of the system (existing resources) needed for the test.
+func getApprovalRequestController(t *testing.T, d test.Data) (test.Assets, func()) {
+	t.Helper()
+	ctx, _ := th.SetupFakeContext(t)
+	ctx, cancel := context.WithCancel(ctx)
+	test.EnsureConfigurationConfigMapsExist(&d)
+	c, informers := test.SeedTestData(t, ctx, d)
+	configMapWatcher := cminformer.NewInformedWatcher(c.Kube, system.Namespace())
+	ctl := NewController(testClock)(ctx, configMapWatcher)
+	if err := configMapWatcher.Start(ctx.Done()); err != nil {
+		t.Fatalf("error starting configmap watcher: %v", err)
+	}
+	if la, ok := ctl.Reconciler.(pkgreconciler.LeaderAware); ok {
+		la.Promote(pkgreconciler.UniversalBucket(), func(pkgreconciler.Bucket, types.NamespacedName) {})
+	}
+	return test.Assets{
+		Controller: ctl,
+		Clients:    c,
+		Informers:  informers,
+		Ctx:        ctx,
+	}, cancel
+}
+
+func newApprovalCustomRun(t *testing.T, startedAgo time.Duration) *v1beta1.CustomRun {
+	t.Helper()
+	run := parse.MustParseCustomRun(t, `
+metadata:
+  name: approve
+  namespace: foo
+  uid: approve-uid
+  labels:
+    tekton.dev/pipelineRun: release
+spec:
+  customRef:
+    apiVersion: tekton.dev/v1alpha1
+    kind: ApprovalRequest
+  params:
+  - name: approvers
+    value: [alice, "group:release-managers"]
+  - name: numberOfApprovalsRequired
+    value: "2"
+  - name: description
+    value: Release to production
+  timeout: 1h
+`)
+	if startedAgo != 0 {
+		run.Status.InitializeConditions()
+		run.Status.StartTime = &metav1.Time{Time: now.Add(-startedAgo)}
+	}
+	return run
+}
+
+func newApprovalRequest(t *testing.T, responses ...v1alpha1.ApprovalResponse) *v1alpha1.ApprovalRequest {
+	t.Helper()
+	return &v1alpha1.ApprovalRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "approve",
+			Namespace: "foo",
+			Labels: map[string]string{
+				pipeline.PipelineRunLabelKey: "release",
+				pipeline.CustomRunKey:        "approve",
+			},
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(newApprovalCustomRun(t, 0))},
+		},
+		Spec: v1alpha1.ApprovalRequestSpec{
+			Description: "Release to production",
+			Approvers: []v1alpha1.Approver{
+				{Name: "alice", Type: v1alpha1.ApproverTypeUser},
+				{Name: "release-managers", Type: v1alpha1.ApproverTypeGroup},
+			},
+			NumberOfApprovalsRequired: 2,
+			Responses:                 responses,
+		},
+		Status: v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStatePending},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	approve := func(name string) v1alpha1.ApprovalResponse {
+		return v1alpha1.ApprovalResponse{Name: name, Decision: v1alpha1.ApprovalDecisionApprove}
+	}
+	reject := func(name string) v1alpha1.ApprovalResponse {
+		return v1alpha1.ApprovalResponse{Name: name, Decision: v1alpha1.ApprovalDecisionReject, Comment: "not this week"}
+	}
+	cancelled := newApprovalCustomRun(t, 10*time.Minute)
+	cancelled.Spec.Status = v1beta1.CustomRunSpecStatusCancelled
+
+	for _, tc := range []struct {
+		name            string
+		run             *v1beta1.CustomRun
+		approvalRequest *v1alpha1.ApprovalRequest
+		wantCondition   apis.Condition
+		wantResults     []v1beta1.CustomRunResult
+		wantStatus      v1alpha1.ApprovalRequestStatus
+		wantRequeue     bool
+	}{{
+		name: "new run creates a pending ApprovalRequest",
+		run:  newApprovalCustomRun(t, 0),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionUnknown,
+			Reason:  ReasonPending,
+			Message: "ApprovalRequest approve is waiting for 2 approvals, got 0",
+		},
+		wantResults: []v1beta1.CustomRunResult{{Name: DecisionResult, Value: "pending"}, {Name: ApproversResult}},
+		wantStatus:  v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStatePending},
+		wantRequeue: true,
+	}, {
+		name:            "not enough approvals",
+		run:             newApprovalCustomRun(t, 10*time.Minute),
+		approvalRequest: newApprovalRequest(t, approve("alice")),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionUnknown,
+			Reason:  ReasonPending,
+			Message: "ApprovalRequest approve is waiting for 2 approvals, got 1",
+		},
+		wantResults: []v1beta1.CustomRunResult{{Name: DecisionResult, Value: "pending"}, {Name: ApproversResult, Value: "alice"}},
+		wantStatus:  v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStatePending, Approvers: []string{"alice"}},
+		wantRequeue: true,
+	}, {
+		name:            "approved",
+		run:             newApprovalCustomRun(t, 10*time.Minute),
+		approvalRequest: newApprovalRequest(t, approve("alice"), approve("bob")),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionTrue,
+			Reason:  ReasonApproved,
+			Message: "ApprovalRequest approve was approved by alice, bob",
+		},
+		wantResults: []v1beta1.CustomRunResult{{Name: DecisionResult, Value: "approved"}, {Name: ApproversResult, Value: "alice,bob"}},
+		wantStatus:  v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStateApproved, Approvers: []string{"alice", "bob"}},
+	}, {
+		name:            "rejected",
+		run:             newApprovalCustomRun(t, 10*time.Minute),
+		approvalRequest: newApprovalRequest(t, approve("alice"), reject("bob")),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  ReasonRejected,
+			Message: "ApprovalRequest approve was rejected by bob",
+		},
+		wantResults: []v1beta1.CustomRunResult{{Name: DecisionResult, Value: "rejected"}, {Name: ApproversResult, Value: "alice"}},
+		wantStatus:  v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStateRejected, Approvers: []string{"alice"}, Rejecters: []string{"bob"}},
+	}, {
+		name:            "timed out",
+		run:             newApprovalCustomRun(t, 2*time.Hour),
+		approvalRequest: newApprovalRequest(t, approve("alice")),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.CustomRunReasonTimedOut.String(),
+			Message: "ApprovalRequest approve did not get 2 approvals in 1h0m0s",
+		},
+		wantResults: []v1beta1.CustomRunResult{{Name: DecisionResult, Value: "timedout"}, {Name: ApproversResult, Value: "alice"}},
+		wantStatus:  v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStateTimedOut, Approvers: []string{"alice"}},
+	}, {
+		name:            "cancelled",
+		run:             cancelled,
+		approvalRequest: newApprovalRequest(t),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.CustomRunReasonCancelled.String(),
+			Message: "CustomRun approve was cancelled",
+		},
+		wantResults: []v1beta1.CustomRunResult{{Name: DecisionResult, Value: "cancelled"}, {Name: ApproversResult}},
+		wantStatus:  v1alpha1.ApprovalRequestStatus{State: v1alpha1.ApprovalStateCancelled},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := test.Data{
+				CustomRuns: []*v1beta1.CustomRun{tc.run},
+				ConfigMaps: th.NewAlphaFeatureFlagsConfigMapInSlice(),
+			}
+			if tc.approvalRequest != nil {
+				d.ApprovalRequests = []*v1alpha1.ApprovalRequest{tc.approvalRequest}
+			}
+			testAssets, cancel := getApprovalRequestController(t, d)
+			defer cancel()
+
+			err := testAssets.Controller.Reconciler.Reconcile(testAssets.Ctx, "foo/approve")
+			if ok, _ := controller.IsRequeueKey(err); ok != tc.wantRequeue {
+				t.Errorf("expected requeue %t, got error %v", tc.wantRequeue, err)
+			} else if err != nil && !ok {
+				t.Fatalf("did not expect an error, but got %v", err)
+			}
+
+			run, err := testAssets.Clients.Pipeline.TektonV1beta1().CustomRuns("foo").Get(testAssets.Ctx, "approve", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("getting updated CustomRun: %v", err)
+			}
+			if d := cmp.Diff(tc.wantCondition, *run.Status.GetCondition(apis.ConditionSucceeded), ignoreLastTransitionTime); d != "" {
+				t.Errorf("CustomRun condition doesn't match %s", diff.PrintWantGot(d))
+			}
+			if d := cmp.Diff(tc.wantResults, run.Status.Results); d != "" {
+				t.Errorf("CustomRun results don't match %s", diff.PrintWantGot(d))
+			}
+
+			ar, err := testAssets.Clients.Pipeline.TektonV1alpha1().ApprovalRequests("foo").Get(testAssets.Ctx, "approve", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("getting ApprovalRequest: %v", err)
+			}
+			if tc.approvalRequest == nil {
+				want := newApprovalRequest(t)
+				if d := cmp.Diff(want.Spec, ar.Spec); d != "" {
+					t.Errorf("ApprovalRequest spec doesn't match %s", diff.PrintWantGot(d))
+				}
+				if d := cmp.Diff(want.Labels, ar.Labels); d != "" {
+					t.Errorf("ApprovalRequest labels don't match %s", diff.PrintWantGot(d))
+				}
+				if !metav1.IsControlledBy(ar, tc.run) {
+					t.Errorf("expected ApprovalRequest to be controlled by CustomRun %s", tc.run.Name)
+				}
+			}
+			if d := cmp.Diff(tc.wantStatus, ar.Status); d != "" {
+				t.Errorf("ApprovalRequest status doesn't match %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestReconcile_Invalid(t *testing.T) {
+	invalidParams := newApprovalCustomRun(t, 0)
+	invalidParams.Spec.Params = append(invalidParams.Spec.Params, v1beta1.Param{Name: "approver", Value: *v1beta1.NewStructuredValues("bob")})
+
+	for _, tc := range []struct {
+		name          string
+		run           *v1beta1.CustomRun
+		configMaps    []*corev1.ConfigMap
+		wantCondition apis.Condition
+	}{{
+		name:       "alpha features disabled",
+		run:        newApprovalCustomRun(t, 0),
+		configMaps: th.NewFeatureFlagsConfigMapInSlice(),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  ReasonDisabled,
+			Message: `ApprovalRequest requires "enable-api-fields" feature gate to be "alpha" but it is "beta": `,
+		},
+	}, {
+		name:       "unexpected param",
+		run:        invalidParams,
+		configMaps: th.NewAlphaFeatureFlagsConfigMapInSlice(),
+		wantCondition: apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  ReasonInvalidParams,
+			Message: "Invalid params for ApprovalRequest approve: unexpected param approver",
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			testAssets, cancel := getApprovalRequestController(t, test.Data{
+				CustomRuns: []*v1beta1.CustomRun{tc.run},
+				ConfigMaps: tc.configMaps,
+			})
+			defer cancel()
+
+			if err := testAssets.Controller.Reconciler.Reconcile(testAssets.Ctx, "foo/approve"); err != nil {
+				t.Fatalf("did not expect an error, but got %v", err)
+			}
+			run, err := testAssets.Clients.Pipeline.TektonV1beta1().CustomRuns("foo").Get(testAssets.Ctx, "approve", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("getting updated CustomRun: %v", err)
+			}
+			if d := cmp.Diff(tc.wantCondition, *run.Status.GetCondition(apis.ConditionSucceeded), ignoreLastTransitionTime); d != "" {
+				t.Errorf("CustomRun condition doesn't match %s", diff.PrintWantGot(d))
+			}
+			if _, err := testAssets.Clients.Pipeline.TektonV1alpha1().ApprovalRequests("foo").Get(testAssets.Ctx, "approve", metav1.GetOptions{}); err == nil {
+				t.Error("expected no ApprovalRequest to be created")
+			}
+		})
+	}
+}
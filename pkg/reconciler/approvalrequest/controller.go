@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalrequest
+
+import (
+	"context"
+
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	pipelineclient "github.com/tektoncd/pipeline/pkg/client/injection/client"
+	approvalrequestinformer "github.com/tektoncd/pipeline/pkg/client/injection/informers/pipeline/v1alpha1/approvalrequest"
+	customruninformer "github.com/tektoncd/pipeline/pkg/client/injection/informers/pipeline/v1beta1/customrun"
+	customrunreconciler "github.com/tektoncd/pipeline/pkg/client/injection/reconciler/pipeline/v1beta1/customrun"
+	tkncontroller "github.com/tektoncd/pipeline/pkg/controller"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// NewController returns a func that returns a knative controller for processing
+// CustomRuns referencing the ApprovalRequest kind.
+func NewController(clock clock.PassiveClock) func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+		logger := logging.FromContext(ctx)
+		customRunInformer := customruninformer.Get(ctx)
+		approvalRequestInformer := approvalrequestinformer.Get(ctx)
+
+		configStore := config.NewStore(logger.Named("config-store"))
+		configStore.WatchConfigs(cmw)
+
+		r := &Reconciler{
+			PipelineClientSet:     pipelineclient.Get(ctx),
+			approvalRequestLister: approvalRequestInformer.Lister(),
+			Clock:                 clock,
+		}
+		apiVersion := v1alpha1.SchemeGroupVersion.String()
+		filterCustomRun := tkncontroller.FilterCustomRunRef(apiVersion, pipeline.ApprovalRequestControllerName)
+		impl := customrunreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
+			return controller.Options{
+				AgentName:         pipeline.ApprovalRequestControllerName,
+				ConfigStore:       configStore,
+				PromoteFilterFunc: filterCustomRun,
+			}
+		})
+
+		if _, err := customRunInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: filterCustomRun,
+			Handler:    controller.HandleAll(impl.Enqueue),
+		}); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register CustomRun informer event handler: %w", err)
+		}
+
+		if _, err := approvalRequestInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: tkncontroller.FilterOwnerCustomRunRef(customRunInformer.Lister(), apiVersion, pipeline.ApprovalRequestControllerName),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		}); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register ApprovalRequest informer event handler: %w", err)
+		}
+
+		return impl
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package attestation generates signed in-toto SLSA provenance attestations of
+// completed TaskRuns and PipelineRuns, and stores them in ConfigMaps owned by the runs.
+package attestation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/kmeta"
+)
+
+const (
+	// DataKey is the key of the DSSE envelope in the ConfigMap holding an attestation.
+	DataKey = "attestation.json"
+	// ReasonFailed is the reason of the warning event emitted when a run cannot be attested.
+	ReasonFailed = "ProvenanceAttestationFailed"
+)
+
+// ConfigMapName returns the name of the ConfigMap holding the attestation of a run.
+func ConfigMapName(runName string) string {
+	return kmeta.ChildName(runName, "-provenance")
+}
+
+// ShouldAttest returns true if the completed run with the provenance is to be attested: it ran while
+// enable-provenance-attestations was set, which still is, and it was not attested yet.
+func ShouldAttest(ctx context.Context, provenance *v1.Provenance) bool {
+	if !config.FromContextOrDefaults(ctx).FeatureFlags.EnableProvenanceAttestations || provenance == nil {
+		return false
+	}
+	ranWithAttestations := provenance.FeatureFlags != nil && provenance.FeatureFlags.EnableProvenanceAttestations
+	return ranWithAttestations && provenance.Attestation == "" && provenance.AttestationError == ""
+}
+
+// Attest signs the statement with the signer and stores the envelope in a ConfigMap owned by the run,
+// whose name it returns. The existing ConfigMap is kept if the run was already attested but its status
+// could not be persisted.
+func Attest(ctx context.Context, kubeclient clientset.Interface, signer signature.Signer, statement *Statement, owner metav1.OwnerReference, namespace string, labels map[string]string) (string, error) {
+	envelope, err := Sign(ctx, statement, signer)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return "", err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ConfigMapName(owner.Name),
+			Namespace:       namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Data: map[string]string{DataKey: string(data)},
+	}
+	_, err = kubeclient.CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+		return cm.Name, nil
+	case !apierrors.IsAlreadyExists(err):
+		return "", fmt.Errorf("failed to create ConfigMap %s for the provenance attestation: %w", cm.Name, err)
+	}
+
+	existing, err := kubeclient.CoreV1().ConfigMaps(namespace).Get(ctx, cm.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get ConfigMap %s for the provenance attestation: %w", cm.Name, err)
+	}
+	if !slices.ContainsFunc(existing.OwnerReferences, func(r metav1.OwnerReference) bool { return r.UID == owner.UID }) {
+		return "", fmt.Errorf("ConfigMap %s for the provenance attestation already exists and is not owned by %s", cm.Name, owner.Name)
+	}
+	return cm.Name, nil
+}
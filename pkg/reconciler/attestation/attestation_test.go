@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/attestation"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+)
+
+var owner = metav1.OwnerReference{
+	APIVersion: "tekton.dev/v1",
+	Kind:       "TaskRun",
+	Name:       "build",
+	UID:        "taskrun-uid",
+}
+
+func TestAttest(t *testing.T) {
+	secret, verifier := signingSecret(t, "password")
+	ctx := config.ToContext(t.Context(), signingConfig(t, secret.Name))
+	signer, err := attestation.LoadSigner(ctx, secretLister(t, secret))
+	if err != nil {
+		t.Fatalf("LoadSigner() = %v", err)
+	}
+	kubeclient := fakek8s.NewSimpleClientset()
+	statement := attestation.GenerateTaskRun(completedTaskRun())
+	labels := map[string]string{"tekton.dev/taskRun": "build"}
+
+	name, err := attestation.Attest(ctx, kubeclient, signer, statement, owner, "ns", labels)
+	if err != nil {
+		t.Fatalf("Attest() = %v", err)
+	}
+	if d := cmp.Diff("build-provenance", name); d != "" {
+		t.Errorf("ConfigMap name %s", diff.PrintWantGot(d))
+	}
+
+	cm, err := kubeclient.CoreV1().ConfigMaps("ns").Get(t.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the ConfigMap: %v", err)
+	}
+	if d := cmp.Diff(labels, cm.Labels); d != "" {
+		t.Errorf("ConfigMap labels %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff([]metav1.OwnerReference{owner}, cm.OwnerReferences); d != "" {
+		t.Errorf("ConfigMap owner references %s", diff.PrintWantGot(d))
+	}
+	envelope := &attestation.Envelope{}
+	if err := json.Unmarshal([]byte(cm.Data[attestation.DataKey]), envelope); err != nil {
+		t.Fatalf("failed to parse the envelope: %v", err)
+	}
+	got, err := attestation.Verify(envelope, verifier)
+	if err != nil {
+		t.Fatalf("Verify() = %v", err)
+	}
+	if d := cmp.Diff(statement.Subject, got.Subject); d != "" {
+		t.Errorf("attested subjects %s", diff.PrintWantGot(d))
+	}
+
+	// Attesting again keeps the ConfigMap of the run
+	if name, err := attestation.Attest(ctx, kubeclient, signer, statement, owner, "ns", labels); err != nil || name != "build-provenance" {
+		t.Errorf("Attest() again = %q, %v, want %q", name, err, "build-provenance")
+	}
+}
+
+func TestAttest_ConfigMapNotOwned(t *testing.T) {
+	secret, _ := signingSecret(t, "password")
+	ctx := config.ToContext(t.Context(), signingConfig(t, secret.Name))
+	existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "build-provenance", Namespace: "ns"}}
+	signer, err := attestation.LoadSigner(ctx, secretLister(t, secret))
+	if err != nil {
+		t.Fatalf("LoadSigner() = %v", err)
+	}
+	kubeclient := fakek8s.NewSimpleClientset(existing)
+
+	if _, err := attestation.Attest(ctx, kubeclient, signer, attestation.GenerateTaskRun(completedTaskRun()), owner, "ns", nil); err == nil {
+		t.Error("expected an error when the ConfigMap exists and is not owned by the run")
+	}
+}
+
+func TestShouldAttest(t *testing.T) {
+	enabled := &config.FeatureFlags{EnableProvenanceAttestations: true}
+	for _, tc := range []struct {
+		desc       string
+		enabled    bool
+		provenance *v1.Provenance
+		want       bool
+	}{{
+		desc:       "ran with attestations enabled",
+		enabled:    true,
+		provenance: &v1.Provenance{FeatureFlags: enabled},
+		want:       true,
+	}, {
+		desc:       "attestations disabled since the run",
+		provenance: &v1.Provenance{FeatureFlags: enabled},
+	}, {
+		desc:       "ran before attestations were enabled",
+		enabled:    true,
+		provenance: &v1.Provenance{FeatureFlags: &config.FeatureFlags{}},
+	}, {
+		desc:    "no provenance",
+		enabled: true,
+	}, {
+		desc:       "already attested",
+		enabled:    true,
+		provenance: &v1.Provenance{FeatureFlags: enabled, Attestation: "build-provenance"},
+	}, {
+		desc:       "attestation failed",
+		enabled:    true,
+		provenance: &v1.Provenance{FeatureFlags: enabled, AttestationError: "no signing key"},
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := config.ToContext(t.Context(), &config.Config{FeatureFlags: &config.FeatureFlags{EnableProvenanceAttestations: tc.enabled}})
+			if got := attestation.ShouldAttest(ctx, tc.provenance); got != tc.want {
+				t.Errorf("ShouldAttest() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
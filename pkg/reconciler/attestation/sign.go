@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/options"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/system"
+)
+
+const (
+	// PayloadType is the DSSE payload type of in-toto statements.
+	PayloadType = "application/vnd.in-toto+json"
+	// SigningKeyKey is the key of the PEM-encoded private key in the signing Secret.
+	SigningKeyKey = "cosign.key"
+	// SigningPasswordKey is the key of the password of the private key in the signing Secret.
+	SigningPasswordKey = "cosign.password"
+)
+
+// ErrSigningKeyNotConfigured indicates that the Secret holding the signing key is not configured.
+var ErrSigningKeyNotConfigured = errors.New("enable-provenance-attestations is set but default-provenance-signing-secret is not configured")
+
+// Envelope is a DSSE envelope holding a signed in-toto statement.
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     string      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is a signature of the payload of an Envelope.
+type Signature struct {
+	KeyID string `json:"keyid,omitempty"`
+	Sig   string `json:"sig"`
+}
+
+// LoadSigner returns the Signer of the key held by the Secret configured in config-defaults,
+// in the namespace of the controller.
+func LoadSigner(ctx context.Context, secretLister corev1listers.SecretLister) (signature.Signer, error) {
+	name := config.FromContextOrDefaults(ctx).Defaults.DefaultProvenanceSigningSecret
+	if name == "" {
+		return nil, ErrSigningKeyNotConfigured
+	}
+	secret, err := secretLister.Secrets(system.Namespace()).Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the provenance signing Secret %s: %w", name, err)
+	}
+	key, ok := secret.Data[SigningKeyKey]
+	if !ok {
+		return nil, fmt.Errorf("the provenance signing Secret %s has no %q key", name, SigningKeyKey)
+	}
+	privateKey, err := cryptoutils.UnmarshalPEMToPrivateKey(key, cryptoutils.StaticPasswordFunc(secret.Data[SigningPasswordKey]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the provenance signing key: %w", err)
+	}
+	return signature.LoadDefaultSigner(privateKey)
+}
+
+// Sign returns the DSSE envelope of the statement signed by the signer.
+func Sign(ctx context.Context, statement *Statement, signer signature.Signer) (*Envelope, error) {
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.SignMessage(bytes.NewReader(pae(PayloadType, payload)), options.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the provenance statement: %w", err)
+	}
+	return &Envelope{
+		PayloadType: PayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []Signature{{Sig: base64.StdEncoding.EncodeToString(sig)}},
+	}, nil
+}
+
+// Verify checks that the envelope is signed by the verifier and returns the statement it holds.
+func Verify(envelope *Envelope, verifier signature.Verifier) (*Statement, error) {
+	if envelope.PayloadType != PayloadType {
+		return nil, fmt.Errorf("unexpected payload type %q", envelope.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the payload: %w", err)
+	}
+	verified := false
+	for _, s := range envelope.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			continue
+		}
+		if verifier.VerifySignature(bytes.NewReader(sig), bytes.NewReader(pae(envelope.PayloadType, payload))) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("no signature of the envelope matches the key")
+	}
+	statement := &Statement{}
+	if err := json.Unmarshal(payload, statement); err != nil {
+		return nil, fmt.Errorf("failed to parse the statement: %w", err)
+	}
+	return statement, nil
+}
+
+// pae returns the DSSE pre-authentication encoding of the payload, which is what gets signed.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
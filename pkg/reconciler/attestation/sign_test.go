@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation_test
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	"github.com/tektoncd/pipeline/pkg/reconciler/attestation"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/system"
+	_ "knative.dev/pkg/system/testing" // Setup system.Namespace()
+)
+
+// signingSecret returns a signing Secret holding a new key encrypted with the password, along with the
+// verifier of the key.
+func signingSecret(t *testing.T, password string) (*corev1.Secret, signature.Verifier) {
+	t.Helper()
+	privPEM, pubPEM, err := cryptoutils.GeneratePEMEncodedECDSAKeyPair(elliptic.P256(), cryptoutils.StaticPasswordFunc([]byte(password)))
+	if err != nil {
+		t.Fatalf("GeneratePEMEncodedECDSAKeyPair() = %v", err)
+	}
+	pub, err := cryptoutils.UnmarshalPEMToPublicKey(pubPEM)
+	if err != nil {
+		t.Fatalf("UnmarshalPEMToPublicKey() = %v", err)
+	}
+	verifier, err := signature.LoadDefaultVerifier(pub)
+	if err != nil {
+		t.Fatalf("LoadDefaultVerifier() = %v", err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "signing-secrets", Namespace: system.Namespace()},
+		Data: map[string][]byte{
+			attestation.SigningKeyKey:      privPEM,
+			attestation.SigningPasswordKey: []byte(password),
+		},
+	}, verifier
+}
+
+// secretLister returns a SecretLister listing the secrets.
+func secretLister(t *testing.T, secrets ...*corev1.Secret) corev1listers.SecretLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, s := range secrets {
+		if err := indexer.Add(s); err != nil {
+			t.Fatalf("failed to add Secret %s: %v", s.Name, err)
+		}
+	}
+	return corev1listers.NewSecretLister(indexer)
+}
+
+func signingConfig(t *testing.T, secretName string) *config.Config {
+	t.Helper()
+	return &config.Config{
+		Defaults:     &config.Defaults{DefaultProvenanceSigningSecret: secretName},
+		FeatureFlags: &config.FeatureFlags{EnableProvenanceAttestations: true},
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	secret, verifier := signingSecret(t, "password")
+	ctx := config.ToContext(t.Context(), signingConfig(t, secret.Name))
+	signer, err := attestation.LoadSigner(ctx, secretLister(t, secret))
+	if err != nil {
+		t.Fatalf("LoadSigner() = %v", err)
+	}
+
+	statement := attestation.GenerateTaskRun(completedTaskRun())
+	envelope, err := attestation.Sign(ctx, statement, signer)
+	if err != nil {
+		t.Fatalf("Sign() = %v", err)
+	}
+	if envelope.PayloadType != attestation.PayloadType {
+		t.Errorf("PayloadType = %q, want %q", envelope.PayloadType, attestation.PayloadType)
+	}
+
+	got, err := attestation.Verify(envelope, verifier)
+	if err != nil {
+		t.Fatalf("Verify() = %v", err)
+	}
+	if d := cmp.Diff(statement.Subject, got.Subject); d != "" {
+		t.Errorf("verified subjects %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(statement.Predicate.RunDetails.Metadata.InvocationID, got.Predicate.RunDetails.Metadata.InvocationID); d != "" {
+		t.Errorf("verified invocation ID %s", diff.PrintWantGot(d))
+	}
+
+	_, otherVerifier := signingSecret(t, "other")
+	if _, err := attestation.Verify(envelope, otherVerifier); err == nil {
+		t.Error("expected the verification with another key to fail")
+	}
+	tampered := *envelope
+	tampered.Payload = envelope.Payload[:len(envelope.Payload)-4] + "AAAA"
+	if _, err := attestation.Verify(&tampered, verifier); err == nil {
+		t.Error("expected the verification of a tampered payload to fail")
+	}
+}
+
+func TestLoadSigner_Errors(t *testing.T) {
+	secret, _ := signingSecret(t, "password")
+	wrongPassword := secret.DeepCopy()
+	wrongPassword.Data[attestation.SigningPasswordKey] = []byte("wrong")
+	noKey := secret.DeepCopy()
+	delete(noKey.Data, attestation.SigningKeyKey)
+
+	for _, tc := range []struct {
+		desc       string
+		secretName string
+		secret     *corev1.Secret
+		wantErr    error
+	}{{
+		desc:    "secret not configured",
+		secret:  secret,
+		wantErr: attestation.ErrSigningKeyNotConfigured,
+	}, {
+		desc:       "secret not found",
+		secretName: "missing",
+		secret:     secret,
+	}, {
+		desc:       "wrong password",
+		secretName: secret.Name,
+		secret:     wrongPassword,
+	}, {
+		desc:       "no key",
+		secretName: secret.Name,
+		secret:     noKey,
+	}} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := config.ToContext(t.Context(), signingConfig(t, tc.secretName))
+			_, err := attestation.LoadSigner(ctx, secretLister(t, tc.secret))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("LoadSigner() = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
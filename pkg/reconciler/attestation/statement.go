@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/changeset"
+)
+
+const (
+	// StatementType is the type of in-toto v1 statements.
+	StatementType = "https://in-toto.io/Statement/v1"
+	// PredicateType is the type of SLSA v1 provenance predicates.
+	PredicateType = "https://slsa.dev/provenance/v1"
+	// TaskRunBuildType is the build type of the provenance of TaskRuns.
+	TaskRunBuildType = "https://tekton.dev/attestation/taskrun/v1"
+	// PipelineRunBuildType is the build type of the provenance of PipelineRuns.
+	PipelineRunBuildType = "https://tekton.dev/attestation/pipelinerun/v1"
+	// BuilderID identifies the Tekton Pipelines controller as the builder of the runs.
+	BuilderID = "https://tekton.dev/pipeline"
+
+	featureFlagsParameter = "tekton-pipelines-feature-flags"
+	imageURIPrefix        = "oci://"
+)
+
+// Statement is an in-toto statement about the subjects of a build, with its SLSA provenance as predicate.
+type Statement struct {
+	Type          string               `json:"_type"`
+	Subject       []ResourceDescriptor `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     Provenance           `json:"predicate"`
+}
+
+// ResourceDescriptor describes an artifact consumed or produced by a build.
+type ResourceDescriptor struct {
+	Name   string            `json:"name,omitempty"`
+	URI    string            `json:"uri,omitempty"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// Provenance is a SLSA v1 provenance predicate.
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+// BuildDefinition describes the inputs of a build.
+type BuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   map[string]any       `json:"externalParameters"`
+	InternalParameters   map[string]any       `json:"internalParameters,omitempty"`
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// RunDetails describes the execution of a build.
+type RunDetails struct {
+	Builder    Builder              `json:"builder"`
+	Metadata   BuildMetadata        `json:"metadata"`
+	Byproducts []ResourceDescriptor `json:"byproducts,omitempty"`
+}
+
+// Builder identifies the platform which ran a build.
+type Builder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+// BuildMetadata holds the identifier and the timestamps of a build.
+type BuildMetadata struct {
+	InvocationID string       `json:"invocationId,omitempty"`
+	StartedOn    *metav1.Time `json:"startedOn,omitempty"`
+	FinishedOn   *metav1.Time `json:"finishedOn,omitempty"`
+}
+
+// GenerateTaskRun returns the provenance statement of the completed TaskRun. Its subjects are the
+// output artifacts marked as build outputs, its dependencies are the source of the Task, the images
+// of its Steps and Sidecars and its input artifacts. The values of sensitive params are redacted.
+func GenerateTaskRun(tr *v1.TaskRun) *Statement {
+	spec := tr.Spec.DeepCopy()
+	if tr.Status.TaskSpec != nil {
+		spec.Params = spec.Params.RedactSensitiveParams(tr.Status.TaskSpec.Params)
+	}
+
+	var subjects, byproducts []ResourceDescriptor
+	dependencies := refSourceDependencies("task", tr.Status.Provenance)
+	for _, s := range tr.Status.Steps {
+		dependencies = appendUnique(dependencies, imageDependency(s.ImageID))
+	}
+	for _, s := range tr.Status.Sidecars {
+		dependencies = appendUnique(dependencies, imageDependency(s.ImageID))
+	}
+	if tr.Status.Artifacts != nil {
+		for _, a := range tr.Status.Artifacts.Inputs {
+			dependencies = appendUnique(dependencies, artifactDescriptors(a)...)
+		}
+		for _, a := range tr.Status.Artifacts.Outputs {
+			if a.BuildOutput {
+				subjects = appendUnique(subjects, artifactDescriptors(a)...)
+			} else {
+				byproducts = appendUnique(byproducts, artifactDescriptors(a)...)
+			}
+		}
+	}
+
+	return newStatement(TaskRunBuildType, tr.ObjectMeta, spec, tr.Status.Provenance, tr.Status.StartTime, tr.Status.CompletionTime,
+		subjects, dependencies, byproducts)
+}
+
+// GeneratePipelineRun returns the provenance statement of the completed PipelineRun, gathering the
+// subjects, dependencies and byproducts of its TaskRuns along with the source of the Pipeline.
+// The values of sensitive params are redacted.
+func GeneratePipelineRun(pr *v1.PipelineRun, taskRuns []*v1.TaskRun) *Statement {
+	spec := pr.Spec.DeepCopy()
+	if pr.Status.PipelineSpec != nil {
+		spec.Params = spec.Params.RedactSensitiveParams(pr.Status.PipelineSpec.Params)
+	}
+
+	var subjects, byproducts []ResourceDescriptor
+	dependencies := refSourceDependencies("pipeline", pr.Status.Provenance)
+	for _, tr := range taskRuns {
+		s := GenerateTaskRun(tr)
+		subjects = appendUnique(subjects, s.Subject...)
+		dependencies = appendUnique(dependencies, s.Predicate.BuildDefinition.ResolvedDependencies...)
+		byproducts = appendUnique(byproducts, s.Predicate.RunDetails.Byproducts...)
+	}
+
+	return newStatement(PipelineRunBuildType, pr.ObjectMeta, spec, pr.Status.Provenance, pr.Status.StartTime, pr.Status.CompletionTime,
+		subjects, dependencies, byproducts)
+}
+
+func newStatement(buildType string, meta metav1.ObjectMeta, spec any, provenance *v1.Provenance, startTime, completionTime *metav1.Time,
+	subjects, dependencies, byproducts []ResourceDescriptor) *Statement {
+	internalParameters := map[string]any{}
+	if provenance != nil && provenance.FeatureFlags != nil {
+		internalParameters[featureFlagsParameter] = provenance.FeatureFlags
+	}
+	if subjects == nil {
+		subjects = []ResourceDescriptor{}
+	}
+	return &Statement{
+		Type:          StatementType,
+		Subject:       subjects,
+		PredicateType: PredicateType,
+		Predicate: Provenance{
+			BuildDefinition: BuildDefinition{
+				BuildType:            buildType,
+				ExternalParameters:   map[string]any{"runSpec": spec},
+				InternalParameters:   internalParameters,
+				ResolvedDependencies: dependencies,
+			},
+			RunDetails: RunDetails{
+				Builder: Builder{
+					ID:      BuilderID,
+					Version: map[string]string{"tekton-pipelines": changeset.Get()},
+				},
+				Metadata: BuildMetadata{
+					InvocationID: string(meta.UID),
+					StartedOn:    startTime,
+					FinishedOn:   completionTime,
+				},
+				Byproducts: byproducts,
+			},
+		},
+	}
+}
+
+// refSourceDependencies returns the dependencies on the remote source of the Task or Pipeline and
+// on the remote source of the base Task it extends, if any.
+func refSourceDependencies(name string, provenance *v1.Provenance) []ResourceDescriptor {
+	if provenance == nil {
+		return nil
+	}
+	var dependencies []ResourceDescriptor
+	if provenance.RefSource != nil {
+		dependencies = append(dependencies, ResourceDescriptor{
+			Name:   name,
+			URI:    provenance.RefSource.URI,
+			Digest: provenance.RefSource.Digest,
+		})
+	}
+	if provenance.BaseRefSource != nil {
+		dependencies = append(dependencies, ResourceDescriptor{
+			Name:   "base-" + name,
+			URI:    provenance.BaseRefSource.URI,
+			Digest: provenance.BaseRefSource.Digest,
+		})
+	}
+	return dependencies
+}
+
+// imageDependency returns the dependency on the image of a container from its image ID,
+// such as "docker.io/library/alpine@sha256:...".
+func imageDependency(imageID string) ResourceDescriptor {
+	repository, digest, found := strings.Cut(strings.TrimPrefix(imageID, "docker-pullable://"), "@")
+	if !found {
+		return ResourceDescriptor{}
+	}
+	algorithm, value, found := strings.Cut(digest, ":")
+	if !found {
+		return ResourceDescriptor{}
+	}
+	return ResourceDescriptor{
+		URI:    imageURIPrefix + repository,
+		Digest: map[string]string{algorithm: value},
+	}
+}
+
+// artifactDescriptors returns the descriptors of the values of the artifact which have a digest.
+func artifactDescriptors(a v1.Artifact) []ResourceDescriptor {
+	var descriptors []ResourceDescriptor
+	for _, v := range a.Values {
+		if len(v.Digest) == 0 {
+			continue
+		}
+		digest := make(map[string]string, len(v.Digest))
+		for algorithm, value := range v.Digest {
+			digest[string(algorithm)] = value
+		}
+		descriptors = append(descriptors, ResourceDescriptor{Name: a.Name, URI: v.Uri, Digest: digest})
+	}
+	return descriptors
+}
+
+// appendUnique appends the descriptors which have a digest and are not in the list yet.
+func appendUnique(list []ResourceDescriptor, descriptors ...ResourceDescriptor) []ResourceDescriptor {
+	for _, d := range descriptors {
+		if len(d.Digest) == 0 {
+			continue
+		}
+		if slices.ContainsFunc(list, func(e ResourceDescriptor) bool {
+			return e.Name == d.Name && e.URI == d.URI && maps.Equal(e.Digest, d.Digest)
+		}) {
+			continue
+		}
+		list = append(list, d)
+	}
+	return list
+}
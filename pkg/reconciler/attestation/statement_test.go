@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/attestation"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/changeset"
+)
+
+var (
+	startTime      = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	completionTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 5, 0, 0, time.UTC))
+)
+
+func completedTaskRun() *v1.TaskRun {
+	return &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "ns", UID: "taskrun-uid"},
+		Spec: v1.TaskRunSpec{
+			TaskRef: &v1.TaskRef{Name: "build"},
+			Params: v1.Params{
+				{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")},
+				{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+			},
+		},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				StartTime:      &startTime,
+				CompletionTime: &completionTime,
+				TaskSpec: &v1.TaskSpec{
+					Params: v1.ParamSpecs{
+						{Name: "token", Type: v1.ParamTypeString, Sensitive: true},
+						{Name: "url", Type: v1.ParamTypeString},
+					},
+				},
+				Provenance: &v1.Provenance{
+					RefSource: &v1.RefSource{
+						URI:        "git+https://github.com/tektoncd/catalog.git",
+						Digest:     map[string]string{"sha1": "f99d13e554ffcb696dee719fa85b695cb5b0f428"},
+						EntryPoint: "task/build/build.yaml",
+					},
+					BaseRefSource: &v1.RefSource{
+						URI:        "git+https://github.com/tektoncd/catalog.git",
+						Digest:     map[string]string{"sha1": "0a6ccd2d4c3b2e9a5fa7b5f3e1d23ad4b8c3e6f1"},
+						EntryPoint: "task/base/base.yaml",
+					},
+					FeatureFlags: &config.FeatureFlags{EnableProvenanceAttestations: true},
+				},
+				Steps: []v1.StepState{
+					{Name: "compile", ImageID: "docker.io/library/golang@sha256:aaaa"},
+					{Name: "push", ImageID: "docker-pullable://gcr.io/go-containerregistry/crane@sha256:bbbb"},
+					{Name: "again", ImageID: "docker.io/library/golang@sha256:aaaa"},
+				},
+				Sidecars: []v1.SidecarState{
+					{Name: "registry", ImageID: "docker.io/library/registry@sha256:cccc"},
+				},
+				Artifacts: &v1.Artifacts{
+					Inputs: []v1.Artifact{{
+						Name:   "source",
+						Values: []v1.ArtifactValue{{Uri: "git+https://github.com/org/repo.git", Digest: map[v1.Algorithm]string{"sha1": "dddd"}}},
+					}},
+					Outputs: []v1.Artifact{{
+						Name:        "image",
+						BuildOutput: true,
+						Values:      []v1.ArtifactValue{{Uri: "pkg:oci/app?repository_url=gcr.io/org", Digest: map[v1.Algorithm]string{"sha256": "eeee"}}},
+					}, {
+						Name:   "logs",
+						Values: []v1.ArtifactValue{{Uri: "https://logs.example.com/build", Digest: map[v1.Algorithm]string{"sha256": "ffff"}}},
+					}, {
+						Name:        "no-digest",
+						BuildOutput: true,
+						Values:      []v1.ArtifactValue{{Uri: "pkg:oci/other"}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerateTaskRun(t *testing.T) {
+	tr := completedTaskRun()
+	got := attestation.GenerateTaskRun(tr)
+
+	wantSpec := tr.Spec.DeepCopy()
+	wantSpec.Params[0].Value = *v1.NewStructuredValues(v1.SensitiveParamRedactedValue)
+	want := &attestation.Statement{
+		Type: attestation.StatementType,
+		Subject: []attestation.ResourceDescriptor{
+			{Name: "image", URI: "pkg:oci/app?repository_url=gcr.io/org", Digest: map[string]string{"sha256": "eeee"}},
+		},
+		PredicateType: attestation.PredicateType,
+		Predicate: attestation.Provenance{
+			BuildDefinition: attestation.BuildDefinition{
+				BuildType:          attestation.TaskRunBuildType,
+				ExternalParameters: map[string]any{"runSpec": wantSpec},
+				InternalParameters: map[string]any{"tekton-pipelines-feature-flags": &config.FeatureFlags{EnableProvenanceAttestations: true}},
+				ResolvedDependencies: []attestation.ResourceDescriptor{
+					{Name: "task", URI: "git+https://github.com/tektoncd/catalog.git", Digest: map[string]string{"sha1": "f99d13e554ffcb696dee719fa85b695cb5b0f428"}},
+					{Name: "base-task", URI: "git+https://github.com/tektoncd/catalog.git", Digest: map[string]string{"sha1": "0a6ccd2d4c3b2e9a5fa7b5f3e1d23ad4b8c3e6f1"}},
+					{URI: "oci://docker.io/library/golang", Digest: map[string]string{"sha256": "aaaa"}},
+					{URI: "oci://gcr.io/go-containerregistry/crane", Digest: map[string]string{"sha256": "bbbb"}},
+					{URI: "oci://docker.io/library/registry", Digest: map[string]string{"sha256": "cccc"}},
+					{Name: "source", URI: "git+https://github.com/org/repo.git", Digest: map[string]string{"sha1": "dddd"}},
+				},
+			},
+			RunDetails: attestation.RunDetails{
+				Builder: attestation.Builder{ID: attestation.BuilderID, Version: map[string]string{"tekton-pipelines": changeset.Get()}},
+				Metadata: attestation.BuildMetadata{
+					InvocationID: "taskrun-uid",
+					StartedOn:    &startTime,
+					FinishedOn:   &completionTime,
+				},
+				Byproducts: []attestation.ResourceDescriptor{
+					{Name: "logs", URI: "https://logs.example.com/build", Digest: map[string]string{"sha256": "ffff"}},
+				},
+			},
+		},
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("GenerateTaskRun() %s", diff.PrintWantGot(d))
+	}
+	if tr.Spec.Params[0].Value.StringVal != "s3cr3t" {
+		t.Errorf("expected the params of the TaskRun to be left untouched, got %v", tr.Spec.Params)
+	}
+}
+
+func TestGeneratePipelineRun(t *testing.T) {
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "release", Namespace: "ns", UID: "pipelinerun-uid"},
+		Spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "release"},
+			Params:      v1.Params{{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")}},
+		},
+		Status: v1.PipelineRunStatus{
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				StartTime:      &startTime,
+				CompletionTime: &completionTime,
+				PipelineSpec: &v1.PipelineSpec{
+					Params: v1.ParamSpecs{{Name: "token", Type: v1.ParamTypeString, Sensitive: true}},
+				},
+				Provenance: &v1.Provenance{
+					RefSource: &v1.RefSource{URI: "oci://gcr.io/org/pipelines", Digest: map[string]string{"sha256": "9999"}},
+				},
+			},
+		},
+	}
+	test := completedTaskRun()
+	test.Name = "test"
+	test.Status.Artifacts = nil
+
+	got := attestation.GeneratePipelineRun(pr, []*v1.TaskRun{completedTaskRun(), test})
+
+	if d := cmp.Diff(attestation.PipelineRunBuildType, got.Predicate.BuildDefinition.BuildType); d != "" {
+		t.Errorf("build type %s", diff.PrintWantGot(d))
+	}
+	wantSpec := pr.Spec.DeepCopy()
+	wantSpec.Params[0].Value = *v1.NewStructuredValues(v1.SensitiveParamRedactedValue)
+	if d := cmp.Diff(map[string]any{"runSpec": wantSpec}, got.Predicate.BuildDefinition.ExternalParameters); d != "" {
+		t.Errorf("external parameters %s", diff.PrintWantGot(d))
+	}
+	wantSubjects := []attestation.ResourceDescriptor{
+		{Name: "image", URI: "pkg:oci/app?repository_url=gcr.io/org", Digest: map[string]string{"sha256": "eeee"}},
+	}
+	if d := cmp.Diff(wantSubjects, got.Subject); d != "" {
+		t.Errorf("subjects %s", diff.PrintWantGot(d))
+	}
+	wantDependencies := []attestation.ResourceDescriptor{
+		{Name: "pipeline", URI: "oci://gcr.io/org/pipelines", Digest: map[string]string{"sha256": "9999"}},
+		{Name: "task", URI: "git+https://github.com/tektoncd/catalog.git", Digest: map[string]string{"sha1": "f99d13e554ffcb696dee719fa85b695cb5b0f428"}},
+		{Name: "base-task", URI: "git+https://github.com/tektoncd/catalog.git", Digest: map[string]string{"sha1": "0a6ccd2d4c3b2e9a5fa7b5f3e1d23ad4b8c3e6f1"}},
+		{URI: "oci://docker.io/library/golang", Digest: map[string]string{"sha256": "aaaa"}},
+		{URI: "oci://gcr.io/go-containerregistry/crane", Digest: map[string]string{"sha256": "bbbb"}},
+		{URI: "oci://docker.io/library/registry", Digest: map[string]string{"sha256": "cccc"}},
+		{Name: "source", URI: "git+https://github.com/org/repo.git", Digest: map[string]string{"sha1": "dddd"}},
+	}
+	if d := cmp.Diff(wantDependencies, got.Predicate.BuildDefinition.ResolvedDependencies); d != "" {
+		t.Errorf("resolved dependencies %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff("pipelinerun-uid", got.Predicate.RunDetails.Metadata.InvocationID); d != "" {
+		t.Errorf("invocation ID %s", diff.PrintWantGot(d))
+	}
+}
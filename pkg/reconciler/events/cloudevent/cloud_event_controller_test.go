@@ -295,6 +295,58 @@ func TestEmitCloudEventsWhenConditionChange(t *testing.T) {
 	fakeClient.CheckCloudEventsUnordered(t, "with sink", wantCloudEvents)
 }
 
+// TestEmitCloudEvents_RedactsSensitiveParams verifies that the values of the params declared
+// sensitive by the Task of a TaskRun are not part of the cloud events sent for it.
+func TestEmitCloudEvents_RedactsSensitiveParams(t *testing.T) {
+	object := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			SelfLink: "/taskruns/test1",
+		},
+		Spec: v1.TaskRunSpec{
+			Params: v1.Params{
+				{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")},
+				{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+			},
+		},
+		Status: v1.TaskRunStatus{
+			Status: duckv1.Status{
+				Conditions: []apis.Condition{{
+					Type:   apis.ConditionSucceeded,
+					Status: corev1.ConditionUnknown,
+					Reason: v1.TaskRunReasonStarted.String(),
+				}},
+			},
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				TaskSpec: &v1.TaskSpec{
+					Params: v1.ParamSpecs{
+						{Name: "token", Type: v1.ParamTypeString, Sensitive: true},
+						{Name: "url", Type: v1.ParamTypeString},
+					},
+				},
+			},
+		},
+	}
+	wantCloudEvents := []string{`(?s)dev.tekton.event.taskrun.started.v1.*"name": "token",\s*"value": "\[REDACTED\]".*"name": "url",\s*"value": "https://tekton.dev"`}
+
+	ctx, _ := rtesting.SetupFakeContext(t)
+	ctx = cloudevent.WithFakeClient(ctx, &cloudevent.FakeClientBehaviour{SendSuccessfully: true}, len(wantCloudEvents))
+	fakeClient := cloudevent.Get(ctx).(cloudevent.FakeClient)
+
+	eventsConfig, _ := config.NewEventsFromMap(map[string]string{"sink": "http://mysink"})
+	cfg := &config.Config{
+		Events:       eventsConfig,
+		Defaults:     config.DefaultConfig.DeepCopy(),
+		FeatureFlags: config.DefaultFeatureFlags.DeepCopy(),
+	}
+	ctx = config.ToContext(ctx, cfg)
+
+	cloudevent.EmitCloudEvents(ctx, object)
+	fakeClient.CheckCloudEventsUnordered(t, "sensitive params", wantCloudEvents)
+	if object.Spec.Params[0].Value.StringVal != "s3cr3t" {
+		t.Errorf("EmitCloudEvents() mutated the TaskRun params: %v", object.Spec.Params)
+	}
+}
+
 // TestEmitCloudEvents_ExplicitTektonV1Format verifies that setting formats=tektonv1
 // explicitly in the config produces the same behaviour as the default (no formats key).
 func TestEmitCloudEvents_ExplicitTektonV1Format(t *testing.T) {
@@ -107,12 +107,20 @@ func newTektonCloudEventData(ctx context.Context, runObject v1beta1.RunObject) (
 	case *v1beta1.PipelineRun:
 		tektonCloudEventData.PipelineRun = v
 	case *v1.TaskRun:
+		if v.Status.TaskSpec != nil {
+			v = v.DeepCopy()
+			v.Spec.Params = v.Spec.Params.RedactSensitiveParams(v.Status.TaskSpec.Params)
+		}
 		v1beta1TaskRun := &v1beta1.TaskRun{}
 		if err := v1beta1TaskRun.ConvertFrom(ctx, v); err != nil {
 			return TektonCloudEventData{}, err
 		}
 		tektonCloudEventData.TaskRun = v1beta1TaskRun
 	case *v1.PipelineRun:
+		if v.Status.PipelineSpec != nil {
+			v = v.DeepCopy()
+			v.Spec.Params = v.Spec.Params.RedactSensitiveParams(v.Status.PipelineSpec.Params)
+		}
 		v1beta1PipelineRun := &v1beta1.PipelineRun{}
 		if err := v1beta1PipelineRun.ConvertFrom(ctx, v); err != nil {
 			return TektonCloudEventData{}, err
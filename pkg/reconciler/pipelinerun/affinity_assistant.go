@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
@@ -39,6 +40,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
 )
@@ -210,7 +212,8 @@ func (c *Reconciler) createOrUpdateAffinityAssistant(ctx context.Context, affini
 	return errs
 }
 
-// cleanupAffinityAssistantsAndPVCs deletes Affinity Assistant StatefulSets and PVCs created from VolumeClaimTemplates
+// cleanupAffinityAssistantsAndPVCs deletes Affinity Assistant StatefulSets and PVCs created from VolumeClaimTemplates,
+// or retains the PVCs following the retention policy of their workspace
 func (c *Reconciler) cleanupAffinityAssistantsAndPVCs(ctx context.Context, pr *v1.PipelineRun) error {
 	aaBehavior, err := aa.GetAffinityAssistantBehavior(ctx)
 	if err != nil {
@@ -231,11 +234,11 @@ func (c *Reconciler) cleanupAffinityAssistantsAndPVCs(ctx context.Context, pr *v
 				}
 			}
 
-			// Delete PVCs from volumeClaimTemplate if auto-cleanup is enabled.
+			// Delete PVCs from volumeClaimTemplate if auto-cleanup is enabled or the workspace has a retention policy.
 			// User-provided persistentVolumeClaim workspaces are never deleted.
-			if w.VolumeClaimTemplate != nil && autoCleanup {
+			if w.VolumeClaimTemplate != nil && (autoCleanup || w.RetentionPolicy != nil) {
 				pvcName := volumeclaim.GeneratePVCNameFromWorkspaceBinding(w.VolumeClaimTemplate.Name, w, *kmeta.NewControllerRef(pr))
-				if err := c.pvcHandler.PurgeFinalizerAndDeletePVCForWorkspace(ctx, pvcName, pr.Namespace); err != nil {
+				if err := c.releaseVolumeClaim(ctx, pr, w, pvcName); err != nil {
 					errs = append(errs, err)
 				}
 			}
@@ -250,18 +253,46 @@ func (c *Reconciler) cleanupAffinityAssistantsAndPVCs(ctx context.Context, pr *v
 		for _, w := range pr.Spec.Workspaces {
 			if w.VolumeClaimTemplate != nil {
 				pvcName := getPersistentVolumeClaimNameWithAffinityAssistant("", pr.Name, w, *kmeta.NewControllerRef(pr))
-				if err := c.pvcHandler.PurgeFinalizerAndDeletePVCForWorkspace(ctx, pvcName, pr.Namespace); err != nil {
+				if err := c.releaseVolumeClaim(ctx, pr, w, pvcName); err != nil {
 					errs = append(errs, err)
 				}
 			}
 		}
 	case aa.AffinityAssistantDisabled:
-		return nil
+		// PVCs from volumeClaimTemplate are deleted with the PipelineRun unless the workspace has a retention policy.
+		for _, w := range pr.Spec.Workspaces {
+			if w.VolumeClaimTemplate != nil && w.RetentionPolicy != nil {
+				pvcName := volumeclaim.GeneratePVCNameFromWorkspaceBinding(w.VolumeClaimTemplate.Name, w, *kmeta.NewControllerRef(pr))
+				if err := c.releaseVolumeClaim(ctx, pr, w, pvcName); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
 	}
 
 	return errorutils.NewAggregate(errs)
 }
 
+// releaseVolumeClaim deletes the PVC created from the volumeClaimTemplate of a workspace once the PipelineRun
+// completes, unless the retention policy of the workspace retains it. Retained PVCs are labeled with the names
+// of the PipelineRun and of the workspace.
+func (c *Reconciler) releaseVolumeClaim(ctx context.Context, pr *v1.PipelineRun, w v1.WorkspaceBinding, pvcName string) error {
+	if !volumeclaim.IsRetained(w.RetentionPolicy, pr.Status.GetCondition(apis.ConditionSucceeded).IsTrue()) {
+		return c.pvcHandler.PurgeFinalizerAndDeletePVCForWorkspace(ctx, pvcName, pr.Namespace)
+	}
+	var completionTime time.Time
+	if pr.Status.CompletionTime != nil {
+		completionTime = pr.Status.CompletionTime.Time
+	} else {
+		completionTime = c.Clock.Now()
+	}
+	labels := map[string]string{
+		pipeline.PipelineRunLabelKey:          pr.Name,
+		volumeclaim.RetainedWorkspaceLabelKey: w.Name,
+	}
+	return c.pvcHandler.RetainPVCForWorkspace(ctx, pvcName, pr.Namespace, labels, volumeclaim.RetainedUntil(w.RetentionPolicy, completionTime))
+}
+
 // getPersistentVolumeClaimNameWithAffinityAssistant returns the PersistentVolumeClaim name that is
 // created by the Affinity Assistant StatefulSet VolumeClaimTemplate when Affinity Assistant is enabled.
 // The PVCs created by StatefulSet VolumeClaimTemplates follow the format `<pvcName>-<affinityAssistantName>-0`
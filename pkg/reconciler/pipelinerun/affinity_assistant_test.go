@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"knative.dev/pkg/ptr"
 
@@ -47,6 +48,7 @@ import (
 	fakek8s "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/typed/core/v1/fake"
 	testing2 "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmeta"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/system"
@@ -1471,6 +1473,107 @@ func TestCleanupAffinityAssistants_AutoCleanupPVC(t *testing.T) {
 	}
 }
 
+// TestCleanupAffinityAssistants_RetentionPolicy tests that PVCs created from volumeClaimTemplate are
+// deleted or retained following the retention policy of their workspace in every coschedule mode.
+func TestCleanupAffinityAssistants_RetentionPolicy(t *testing.T) {
+	completionTime := metav1.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name            string
+		coschedule      string
+		policy          *v1.VolumeClaimRetentionPolicy
+		succeeded       corev1.ConditionStatus
+		expectDeleted   bool
+		expectRetained  bool
+		expectRetainTTL string
+	}{{
+		name:           "retained with affinity assistant per workspace",
+		coschedule:     config.CoscheduleWorkspaces,
+		policy:         &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionRetain},
+		succeeded:      corev1.ConditionTrue,
+		expectRetained: true,
+	}, {
+		name:          "retained on failure deleted when succeeded with affinity assistant per workspace",
+		coschedule:    config.CoscheduleWorkspaces,
+		policy:        &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionRetainOnFailure},
+		succeeded:     corev1.ConditionTrue,
+		expectDeleted: true,
+	}, {
+		name:            "retained on failure with a ttl with affinity assistant per pipelinerun",
+		coschedule:      config.CoschedulePipelineRuns,
+		policy:          &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionRetainOnFailure, TTL: &metav1.Duration{Duration: 24 * time.Hour}},
+		succeeded:       corev1.ConditionFalse,
+		expectRetained:  true,
+		expectRetainTTL: "1792238400",
+	}, {
+		name:          "deleted without affinity assistant",
+		coschedule:    config.CoscheduleDisabled,
+		policy:        &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionDelete},
+		succeeded:     corev1.ConditionFalse,
+		expectDeleted: true,
+	}, {
+		name:       "left to the owner reference without affinity assistant and without policy",
+		coschedule: config.CoscheduleDisabled,
+		succeeded:  corev1.ConditionFalse,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &v1.PipelineRun{
+				TypeMeta:   metav1.TypeMeta{Kind: "PipelineRun"},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pipelinerun", UID: "pipelinerun-uid"},
+				Spec: v1.PipelineRunSpec{Workspaces: []v1.WorkspaceBinding{{
+					Name:                "source",
+					VolumeClaimTemplate: &corev1.PersistentVolumeClaim{},
+					RetentionPolicy:     tc.policy,
+				}}},
+				Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{CompletionTime: &completionTime}},
+			}
+			pr.Status.SetCondition(&apis.Condition{Type: apis.ConditionSucceeded, Status: tc.succeeded})
+
+			pvcName := volumeclaim.GeneratePVCNameFromWorkspaceBinding("", pr.Spec.Workspaces[0], *kmeta.NewControllerRef(pr))
+			if tc.coschedule == config.CoschedulePipelineRuns {
+				pvcName = getPersistentVolumeClaimNameWithAffinityAssistant("", pr.Name, pr.Spec.Workspaces[0], *kmeta.NewControllerRef(pr))
+			}
+			_, c, cancel := seedTestData(Data{
+				PVCs: []*corev1.PersistentVolumeClaim{{
+					ObjectMeta: metav1.ObjectMeta{Name: pvcName, OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(pr)}},
+				}},
+			})
+			defer cancel()
+			ctx := cfgtesting.SetFeatureFlags(t.Context(), t, map[string]string{"coschedule": tc.coschedule})
+
+			pvcDeleteCalled := false
+			c.KubeClientSet.CoreV1().(*fake.FakeCoreV1).PrependReactor("delete", "persistentvolumeclaims",
+				func(action testing2.Action) (handled bool, ret runtime.Object, err error) {
+					pvcDeleteCalled = true
+					return true, nil, nil
+				})
+
+			if err := c.cleanupAffinityAssistantsAndPVCs(ctx, pr); err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if pvcDeleteCalled != tc.expectDeleted {
+				t.Errorf("PVC delete called = %v, want %v", pvcDeleteCalled, tc.expectDeleted)
+			}
+
+			pvc, err := c.KubeClientSet.CoreV1().PersistentVolumeClaims("").Get(ctx, pvcName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error when retrieving PVC: %v", err)
+			}
+			if retained := len(pvc.OwnerReferences) == 0; retained != tc.expectRetained {
+				t.Errorf("PVC retained = %v, want %v", retained, tc.expectRetained)
+			}
+			if tc.expectRetained {
+				wantLabels := map[string]string{pipeline.PipelineRunLabelKey: pr.Name, volumeclaim.RetainedWorkspaceLabelKey: "source"}
+				if tc.expectRetainTTL != "" {
+					wantLabels[volumeclaim.RetainedUntilLabelKey] = tc.expectRetainTTL
+				}
+				if d := cmp.Diff(wantLabels, pvc.Labels); d != "" {
+					t.Errorf("unexpected labels of the retained PVC %s", diff.PrintWantGot(d))
+				}
+			}
+		})
+	}
+}
+
 // TestCleanupAffinityAssistants_AutoCleanupMixedWorkspaces tests that only volumeClaimTemplate
 // PVCs are deleted when the annotation is set, while persistentVolumeClaim workspaces are preserved.
 func TestCleanupAffinityAssistants_AutoCleanupMixedWorkspaces(t *testing.T) {
@@ -28,6 +28,7 @@ import (
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	clientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	jsonpatch "gomodules.xyz/jsonpatch/v2"
@@ -39,7 +40,7 @@ import (
 	"knative.dev/pkg/apis"
 )
 
-var cancelTaskRunPatchBytes, cancelCustomRunPatchBytes []byte
+var cancelTaskRunPatchBytes, cancelCustomRunPatchBytes, cancelPipelineRunPatchBytes []byte
 
 func init() {
 	var err error
@@ -71,6 +72,15 @@ func init() {
 	if err != nil {
 		log.Fatalf("failed to marshal CustomRun cancel patch bytes: %v", err)
 	}
+	cancelPipelineRunPatchBytes, err = json.Marshal([]jsonpatch.JsonPatchOperation{
+		{
+			Operation: "add",
+			Path:      "/spec/status",
+			Value:     v1.PipelineRunSpecStatusCancelled,
+		}})
+	if err != nil {
+		log.Fatalf("failed to marshal PipelineRun cancel patch bytes: %v", err)
+	}
 }
 
 func cancelCustomRun(ctx context.Context, runName string, namespace string, clientSet clientset.Interface) error {
@@ -107,6 +117,21 @@ func cancelTaskRun(ctx context.Context, taskRunName string, namespace string, cl
 	return err
 }
 
+// requestPipelineRunCancellation sets the spec status of a PipelineRun to cancelled. The
+// PipelineRun is then cancelled by cancelPipelineRun the next time it is reconciled.
+func requestPipelineRunCancellation(ctx context.Context, pipelineRunName string, namespace string, clientSet clientset.Interface) error {
+	ctx, span := tracerFromContext(ctx).Start(ctx, "requestPipelineRunCancellation")
+	defer span.End()
+	span.SetAttributes(attribute.String("pipelinerun", pipelineRunName), attribute.String("namespace", namespace))
+
+	_, err := clientSet.TektonV1().PipelineRuns(namespace).Patch(ctx, pipelineRunName, types.JSONPatchType, cancelPipelineRunPatchBytes, metav1.PatchOptions{}, "")
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	recordSpanError(span, err)
+	return err
+}
+
 // cancelPipelineRun marks the PipelineRun as cancelled and any resolved TaskRun(s) too.
 func cancelPipelineRun(ctx context.Context, logger *zap.SugaredLogger, pr *v1.PipelineRun, clientSet clientset.Interface) error {
 	ctx, span := tracerFromContext(ctx).Start(ctx, "cancelPipelineRun")
@@ -151,11 +176,11 @@ func cancelPipelineTaskRuns(ctx context.Context, logger *zap.SugaredLogger, pr *
 	return cancelPipelineTaskRunsForTaskNames(ctx, logger, pr, clientSet, sets.NewString())
 }
 
-// cancelPipelineTaskRunsForTaskNames patches `TaskRun`s and `Run`s for the given task names, or all if no task names are given, with canceled status
+// cancelPipelineTaskRunsForTaskNames patches `TaskRun`s, `Run`s and child `PipelineRun`s for the given task names, or all if no task names are given, with canceled status
 func cancelPipelineTaskRunsForTaskNames(ctx context.Context, logger *zap.SugaredLogger, pr *v1.PipelineRun, clientSet clientset.Interface, taskNames sets.String) []string {
 	errs := []string{}
 
-	trNames, customRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
+	trNames, customRunNames, childPipelineRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
@@ -177,23 +202,73 @@ func cancelPipelineTaskRunsForTaskNames(ctx context.Context, logger *zap.Sugared
 			continue
 		}
 	}
+
+	for _, childPipelineRunName := range childPipelineRunNames {
+		logger.Infof("cancelling child PipelineRun %s", childPipelineRunName)
+
+		if err := requestPipelineRunCancellation(ctx, childPipelineRunName, pr.Namespace, clientSet); err != nil {
+			errs = append(errs, fmt.Errorf("failed to patch child PipelineRun `%s` with cancellation: %w", childPipelineRunName, err).Error())
+			continue
+		}
+	}
+	return errs
+}
+
+// cancelMatrixFailFastRuns patches the running `TaskRun`s and `CustomRun`s of the matrixed PipelineTasks
+// with failurePolicy.failFast which can no longer succeed with canceled status
+func cancelMatrixFailFastRuns(ctx context.Context, logger *zap.SugaredLogger, pr *v1.PipelineRun, state resources.PipelineRunState, clientSet clientset.Interface) []string {
+	errs := []string{}
+	for _, rpt := range state {
+		if !rpt.IsMatrixFailFastCancelling() {
+			continue
+		}
+		for _, taskRun := range rpt.TaskRuns {
+			if taskRun.IsDone() {
+				continue
+			}
+			logger.Infof("cancelling TaskRun %s of failing matrixed task %s", taskRun.Name, rpt.PipelineTask.Name)
+			if err := cancelTaskRun(ctx, taskRun.Name, pr.Namespace, clientSet); err != nil {
+				errs = append(errs, fmt.Errorf("failed to patch TaskRun `%s` with cancellation: %w", taskRun.Name, err).Error())
+			}
+		}
+		for _, run := range rpt.CustomRuns {
+			if run.IsDone() {
+				continue
+			}
+			logger.Infof("cancelling CustomRun %s of failing matrixed task %s", run.Name, rpt.PipelineTask.Name)
+			if err := cancelCustomRun(ctx, run.Name, pr.Namespace, clientSet); err != nil {
+				errs = append(errs, fmt.Errorf("failed to patch CustomRun `%s` with cancellation: %w", run.Name, err).Error())
+			}
+		}
+	}
 	return errs
 }
 
-// getChildObjectsFromPRStatusForTaskNames returns taskruns and customruns in the PipelineRunStatus's ChildReferences,
-// based on the given set of PipelineTask names. If that set is empty, all are returned.
-func getChildObjectsFromPRStatusForTaskNames(ctx context.Context, prs v1.PipelineRunStatus, taskNames sets.String) ([]string, []string, error) {
+// getChildObjectsFromPRStatusForTaskNames returns taskruns, customruns and child pipelineruns in the PipelineRunStatus's
+// ChildReferences, based on the given set of PipelineTask names. If that set is empty, all are returned.
+func getChildObjectsFromPRStatusForTaskNames(ctx context.Context, prs v1.PipelineRunStatus, taskNames sets.String) ([]string, []string, []string, error) {
 	var trNames []string
 	var customRunNames []string
+	var childPipelineRunNames []string
 	unknownChildKinds := make(map[string]string)
 
 	for _, cr := range prs.ChildReferences {
 		if taskNames.Len() == 0 || taskNames.Has(cr.PipelineTaskName) {
 			switch cr.Kind {
 			case taskRun:
+				// TaskRuns reused from the cache or carried over from a rerun PipelineRun belong
+				// to another PipelineRun and are already done
+				if cr.Cached || cr.CarriedOver {
+					continue
+				}
 				trNames = append(trNames, cr.Name)
 			case customRun:
+				if cr.CarriedOver {
+					continue
+				}
 				customRunNames = append(customRunNames, cr.Name)
+			case pipelineRun:
+				childPipelineRunNames = append(childPipelineRunNames, cr.Name)
 			default:
 				unknownChildKinds[cr.Name] = cr.Kind
 			}
@@ -205,7 +280,7 @@ func getChildObjectsFromPRStatusForTaskNames(ctx context.Context, prs v1.Pipelin
 		err = fmt.Errorf("found child objects of unknown kinds: %v", unknownChildKinds)
 	}
 
-	return trNames, customRunNames, err
+	return trNames, customRunNames, childPipelineRunNames, err
 }
 
 // gracefullyCancelPipelineRun marks any non-final resolved TaskRun(s) as cancelled and runs finally.
@@ -44,6 +44,7 @@ func TestCancelPipelineRun(t *testing.T) {
 		pipelineRun *v1.PipelineRun
 		taskRuns    []*v1.TaskRun
 		customRuns  []*v1beta1.CustomRun
+		childPRs    []*v1.PipelineRun
 		wantErr     bool
 	}{{
 		name: "no-resolved-taskrun",
@@ -279,6 +280,29 @@ func TestCancelPipelineRun(t *testing.T) {
 			{ObjectMeta: metav1.ObjectMeta{Name: "cr1"}},
 			{ObjectMeta: metav1.ObjectMeta{Name: "cr2"}},
 		},
+	}, {
+		name: "child-pipelineruns",
+		pipelineRun: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline-run-cancelled"},
+			Spec: v1.PipelineRunSpec{
+				Status: v1.PipelineRunSpecStatusCancelled,
+			},
+			Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				ChildReferences: []v1.ChildStatusReference{{
+					TypeMeta:         runtime.TypeMeta{Kind: pipelineRun},
+					Name:             "test-pipeline-run-cancelled-child-0",
+					PipelineTaskName: "child",
+				}, {
+					TypeMeta:         runtime.TypeMeta{Kind: pipelineRun},
+					Name:             "test-pipeline-run-cancelled-child-1",
+					PipelineTaskName: "child",
+				}},
+			}},
+		},
+		childPRs: []*v1.PipelineRun{
+			{ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline-run-cancelled-child-0"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline-run-cancelled-child-1"}},
+		},
 	}, {
 		name: "unknown-kind-on-child-references",
 		pipelineRun: &v1.PipelineRun{
@@ -299,7 +323,7 @@ func TestCancelPipelineRun(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			d := test.Data{
-				PipelineRuns: []*v1.PipelineRun{tc.pipelineRun},
+				PipelineRuns: append([]*v1.PipelineRun{tc.pipelineRun}, tc.childPRs...),
 				TaskRuns:     tc.taskRuns,
 				CustomRuns:   tc.customRuns,
 			}
@@ -354,6 +378,15 @@ func TestCancelPipelineRun(t *testing.T) {
 						}
 					}
 				}
+				for _, expectedChild := range tc.childPRs {
+					child, err := c.Pipeline.TektonV1().PipelineRuns("").Get(ctx, expectedChild.Name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatalf("couldn't get expected child PipelineRun %s, got error %s", expectedChild.Name, err)
+					}
+					if child.Spec.Status != v1.PipelineRunSpecStatusCancelled {
+						t.Errorf("expected child PipelineRun %q to be cancelled, was %q", child.Name, child.Spec.Status)
+					}
+				}
 			}
 		})
 	}
@@ -367,6 +400,7 @@ func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 		expectedTRNames        []string
 		expectedRunNames       []string
 		expectedCustomRunNames []string
+		expectedChildPRNames   []string
 		hasError               bool
 	}{
 		{
@@ -383,6 +417,63 @@ func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 			}},
 			expectedCustomRunNames: []string{"r1"},
 			hasError:               false,
+		}, {
+			name: "cached taskruns are not returned",
+			prStatus: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				ChildReferences: []v1.ChildStatusReference{{
+					TypeMeta:         runtime.TypeMeta{Kind: taskRun},
+					Name:             "t1",
+					PipelineTaskName: "task-1",
+				}, {
+					TypeMeta:         runtime.TypeMeta{Kind: taskRun},
+					Name:             "cached-t2",
+					PipelineTaskName: "task-2",
+					Cached:           true,
+				}},
+			}},
+			expectedTRNames: []string{"t1"},
+			hasError:        false,
+		}, {
+			name: "carried over runs are not returned",
+			prStatus: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				ChildReferences: []v1.ChildStatusReference{{
+					TypeMeta:         runtime.TypeMeta{Kind: taskRun},
+					Name:             "t1",
+					PipelineTaskName: "task-1",
+				}, {
+					TypeMeta:         runtime.TypeMeta{Kind: taskRun},
+					Name:             "previous-t2",
+					PipelineTaskName: "task-2",
+					CarriedOver:      true,
+				}, {
+					TypeMeta:         runtime.TypeMeta{Kind: customRun},
+					Name:             "previous-r3",
+					PipelineTaskName: "task-3",
+					CarriedOver:      true,
+				}},
+			}},
+			expectedTRNames: []string{"t1"},
+			hasError:        false,
+		}, {
+			name: "child pipelineruns",
+			prStatus: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				ChildReferences: []v1.ChildStatusReference{{
+					TypeMeta:         runtime.TypeMeta{Kind: taskRun},
+					Name:             "t1",
+					PipelineTaskName: "task-1",
+				}, {
+					TypeMeta:         runtime.TypeMeta{Kind: pipelineRun},
+					Name:             "pr-0",
+					PipelineTaskName: "pipeline-2",
+				}, {
+					TypeMeta:         runtime.TypeMeta{Kind: pipelineRun},
+					Name:             "pr-1",
+					PipelineTaskName: "pipeline-2",
+				}},
+			}},
+			expectedTRNames:      []string{"t1"},
+			expectedChildPRNames: []string{"pr-0", "pr-1"},
+			hasError:             false,
 		}, {
 			name: "unknown kind",
 			prStatus: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
@@ -405,7 +496,7 @@ func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx, _ := ttesting.SetupFakeContext(t)
-			trNames, customRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, tc.prStatus, tc.taskNames)
+			trNames, customRunNames, childPipelineRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, tc.prStatus, tc.taskNames)
 
 			if tc.hasError {
 				if err == nil {
@@ -421,6 +512,9 @@ func TestGetChildObjectsFromPRStatusForTaskNames(t *testing.T) {
 			if d := cmp.Diff(tc.expectedCustomRunNames, customRunNames); d != "" {
 				t.Errorf("expected to see CustomRun names %v. Diff %s", tc.expectedCustomRunNames, diff.PrintWantGot(d))
 			}
+			if d := cmp.Diff(tc.expectedChildPRNames, childPipelineRunNames); d != "" {
+				t.Errorf("expected to see child PipelineRun names %v. Diff %s", tc.expectedChildPRNames, diff.PrintWantGot(d))
+			}
 		})
 	}
 }
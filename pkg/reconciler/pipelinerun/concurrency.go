@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	listers "github.com/tektoncd/pipeline/pkg/client/listers/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// concurrencyGroupLabelValue returns the value of the concurrency group label of a
+// PipelineRun. Groups are hashed since they are not valid label values in general.
+func concurrencyGroupLabelValue(group string) string {
+	sum := sha256.Sum256([]byte(group))
+	return hex.EncodeToString(sum[:])[:validation.LabelValueMaxLength]
+}
+
+// createdBefore orders PipelineRuns by creation time, then by name.
+func createdBefore(a, b *v1.PipelineRun) int {
+	if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+		return c
+	}
+	return strings.Compare(a.Name, b.Name)
+}
+
+// isConcurrencyQueued returns true if the PipelineRun is held pending by the controller until
+// a slot frees up in its concurrency group.
+func isConcurrencyQueued(pr *v1.PipelineRun) bool {
+	_, ok := pr.Annotations[pipeline.ConcurrencyQueuedAnnotationKey]
+	return ok && pr.IsPending()
+}
+
+// admitConcurrency decides whether a PipelineRun that has not started yet can start, given the
+// other PipelineRuns of its concurrency group. It returns true if the PipelineRun must not start
+// in this reconcile:
+//   - with the queue strategy, a PipelineRun is held by patching its spec status to pending when
+//     the group is full, and released by patching its spec status back once a slot is free;
+//   - with the cancel-older strategy, the oldest PipelineRuns of the group are cancelled to make
+//     room, and the PipelineRun starts unless it is itself among the oldest;
+//   - with the cancel-newer strategy, the PipelineRun itself is cancelled when the group is full.
+//
+// The PipelineRun joins its group by persisting the group label before the group is listed from
+// the API server, so that of two PipelineRuns admitted at the same time, at least the second one
+// to join sees the first one.
+func (c *Reconciler) admitConcurrency(ctx context.Context, pr *v1.PipelineRun) (bool, error) {
+	logger := logging.FromContext(ctx)
+	group := resources.GetConcurrencyGroup(pr)
+	groupLabel := concurrencyGroupLabelValue(group)
+
+	if pr.Labels[pipeline.ConcurrencyGroupLabelKey] != groupLabel {
+		if err := c.patchConcurrencyGroupLabel(ctx, pr, groupLabel); err != nil {
+			return false, fmt.Errorf("failed to label PipelineRun %s with concurrency group %q: %w", pr.Name, group, err)
+		}
+		if pr.Labels == nil {
+			pr.Labels = map[string]string{}
+		}
+		pr.Labels[pipeline.ConcurrencyGroupLabelKey] = groupLabel
+	}
+
+	// The group is listed from the API server: the lister may not see yet a PipelineRun that
+	// joined the group or was started in the meantime.
+	members, err := c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{pipeline.ConcurrencyGroupLabelKey: groupLabel}).String(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list the PipelineRuns of concurrency group %q: %w", group, err)
+	}
+
+	// PipelineRuns that are neither started nor queued are being admitted, or were released and
+	// are starting, so they are counted as running whatever their age. PipelineRuns made pending
+	// by users don't hold a slot.
+	var running []*v1.PipelineRun
+	waitingAhead := 0
+	for i := range members.Items {
+		m := &members.Items[i]
+		switch {
+		case m.Name == pr.Name, m.IsDone(), m.IsCancelled():
+		case m.IsPending() && !isConcurrencyQueued(m):
+		case m.HasStarted(), !isConcurrencyQueued(m):
+			running = append(running, m)
+		case createdBefore(m, pr) < 0:
+			waitingAhead++
+		}
+	}
+
+	maxInFlight := pr.Spec.Concurrency.GetMaxInFlight()
+	switch pr.Spec.Concurrency.GetStrategy() {
+	case v1.ConcurrencyStrategyCancelOlder:
+		// The PipelineRun competes with the running ones by age, so that two PipelineRuns admitted
+		// at the same time agree on which one is superseded.
+		contenders := append(running, pr)
+		slices.SortFunc(contenders, createdBefore)
+		superseded := false
+		for _, older := range contenders[:max(0, len(contenders)-maxInFlight)] {
+			logger.Infof("Cancelling PipelineRun %s superseded in concurrency group %q", older.Name, group)
+			if err := requestPipelineRunCancellation(ctx, older.Name, older.Namespace, c.PipelineClientSet); err != nil {
+				return false, fmt.Errorf("failed to cancel PipelineRun %s superseded in concurrency group %q: %w", older.Name, group, err)
+			}
+			superseded = superseded || older == pr
+		}
+		if superseded {
+			pr.Status.MarkFailed(v1.PipelineRunReasonCancelled.String(),
+				"PipelineRun %q was cancelled since it is superseded in concurrency group %q", pr.Name, group)
+			return true, nil
+		}
+	case v1.ConcurrencyStrategyCancelNewer:
+		if len(running) >= maxInFlight {
+			logger.Infof("Cancelling PipelineRun %s since concurrency group %q is full", pr.Name, group)
+			if err := requestPipelineRunCancellation(ctx, pr.Name, pr.Namespace, c.PipelineClientSet); err != nil {
+				return false, fmt.Errorf("failed to cancel PipelineRun %s in full concurrency group %q: %w", pr.Name, group, err)
+			}
+			// The PipelineRun has no TaskRun yet, so it is cancelled without being started
+			pr.Status.MarkFailed(v1.PipelineRunReasonCancelled.String(),
+				"PipelineRun %q was cancelled since concurrency group %q is full", pr.Name, group)
+			return true, nil
+		}
+	default:
+		full := len(running)+waitingAhead >= maxInFlight
+		switch {
+		case full && !isConcurrencyQueued(pr):
+			logger.Infof("Queueing PipelineRun %s in concurrency group %q", pr.Name, group)
+			if err := c.patchConcurrencyQueued(ctx, pr, groupLabel, true); err != nil {
+				return false, fmt.Errorf("failed to queue PipelineRun %s in concurrency group %q: %w", pr.Name, group, err)
+			}
+		case !full && isConcurrencyQueued(pr):
+			logger.Infof("Releasing PipelineRun %s queued in concurrency group %q", pr.Name, group)
+			// The PipelineRun starts when it is reconciled again after the patch
+			return true, c.patchConcurrencyQueued(ctx, pr, groupLabel, false)
+		}
+		if full {
+			pr.Status.MarkRunning(v1.PipelineRunReasonPending.String(),
+				"PipelineRun %q is queued in concurrency group %q: %d running, %d queued ahead", pr.Name, group, len(running), waitingAhead)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// patchConcurrencyGroupLabel persists the concurrency group label of a PipelineRun.
+func (c *Reconciler) patchConcurrencyGroupLabel(ctx context.Context, pr *v1.PipelineRun, groupLabel string) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{pipeline.ConcurrencyGroupLabelKey: groupLabel},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).Patch(ctx, pr.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// patchConcurrencyQueued holds a PipelineRun pending, labeled with its concurrency group, or
+// releases it by clearing its spec status.
+func (c *Reconciler) patchConcurrencyQueued(ctx context.Context, pr *v1.PipelineRun, groupLabel string, queued bool) error {
+	var annotation, status any
+	if queued {
+		annotation, status = "true", v1.PipelineRunSpecStatusPending
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels":      map[string]any{pipeline.ConcurrencyGroupLabelKey: groupLabel},
+			"annotations": map[string]any{pipeline.ConcurrencyQueuedAnnotationKey: annotation},
+		},
+		"spec": map[string]any{"status": status},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).Patch(ctx, pr.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// enqueueConcurrencyGroup returns an event handler that enqueues the queued PipelineRuns of a
+// concurrency group when a PipelineRun of the group completes or is deleted, freeing a slot, or
+// when it is queued, since PipelineRuns admitted at the same time may all have queued.
+func enqueueConcurrencyGroup(impl *controller.Impl, lister listers.PipelineRunLister) cache.ResourceEventHandler {
+	enqueueQueued := func(pr *v1.PipelineRun) {
+		groupLabel, ok := pr.Labels[pipeline.ConcurrencyGroupLabelKey]
+		if !ok {
+			return
+		}
+		members, err := lister.PipelineRuns(pr.Namespace).List(labels.SelectorFromSet(labels.Set{
+			pipeline.ConcurrencyGroupLabelKey: groupLabel,
+		}))
+		if err != nil {
+			return
+		}
+		for _, m := range members {
+			if !m.HasStarted() && !m.IsDone() {
+				impl.Enqueue(m)
+			}
+		}
+	}
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, obj interface{}) {
+			pr, ok := obj.(*v1.PipelineRun)
+			if !ok {
+				return
+			}
+			old, ok := oldObj.(*v1.PipelineRun)
+			if pr.IsDone() || (ok && !isConcurrencyQueued(old) && isConcurrencyQueued(pr)) {
+				enqueueQueued(pr)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if pr, ok := obj.(*v1.PipelineRun); ok {
+				enqueueQueued(pr)
+			}
+		},
+	}
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	th "github.com/tektoncd/pipeline/pkg/reconciler/testing"
+	"github.com/tektoncd/pipeline/test"
+	"github.com/tektoncd/pipeline/test/diff"
+	"github.com/tektoncd/pipeline/test/parse"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestReconcileWithConcurrency(t *testing.T) {
+	deployProd := concurrencyGroupLabelValue("deploy-prod")
+	runningMember := parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: running
+  namespace: foo
+  creationTimestamp: "2021-12-31T23:00:00Z"
+  labels:
+    %s: %s
+spec:
+  pipelineRef:
+    name: test-pipeline
+  concurrency:
+    group: deploy-prod
+status:
+  startTime: "2021-12-31T23:00:00Z"
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: Running
+`, pipeline.ConcurrencyGroupLabelKey, deployProd))
+	queuedMember := parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: queued
+  namespace: foo
+  creationTimestamp: "2021-12-31T23:30:00Z"
+  labels:
+    %s: %s
+  annotations:
+    %s: "true"
+spec:
+  status: PipelineRunPending
+  pipelineRef:
+    name: test-pipeline
+  concurrency:
+    group: deploy-prod
+status:
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: PipelineRunPending
+`, pipeline.ConcurrencyGroupLabelKey, deployProd, pipeline.ConcurrencyQueuedAnnotationKey))
+	pendingMember := parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: pending
+  namespace: foo
+  creationTimestamp: "2021-12-31T23:30:00Z"
+  labels:
+    %s: %s
+spec:
+  status: PipelineRunPending
+  pipelineRef:
+    name: test-pipeline
+  concurrency:
+    group: deploy-prod
+`, pipeline.ConcurrencyGroupLabelKey, deployProd))
+	admittingMember := parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: admitting
+  namespace: foo
+  creationTimestamp: "2022-01-01T00:10:00Z"
+  labels:
+    %s: %s
+spec:
+  pipelineRef:
+    name: test-pipeline
+  concurrency:
+    group: deploy-prod
+`, pipeline.ConcurrencyGroupLabelKey, deployProd))
+	newPipelineRun := func(environment, concurrency string) *v1.PipelineRun {
+		return parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+  creationTimestamp: "2022-01-01T00:00:00Z"
+spec:
+  params:
+  - name: environment
+    value: %s
+  pipelineRef:
+    name: test-pipeline
+  concurrency:
+    group: deploy-$(params.environment)
+%s`, environment, concurrency))
+	}
+	queuedPipelineRun := newPipelineRun("prod", "    maxInFlight: 2")
+	queuedPipelineRun.Spec.Status = v1.PipelineRunSpecStatusPending
+	queuedPipelineRun.Labels = map[string]string{pipeline.ConcurrencyGroupLabelKey: deployProd}
+	queuedPipelineRun.Annotations = map[string]string{pipeline.ConcurrencyQueuedAnnotationKey: "true"}
+	labelPatch := func(groupLabel string) string {
+		return `{"metadata":{"labels":{"tekton.dev/concurrencyGroup":"` + groupLabel + `"}}}`
+	}
+
+	for _, tc := range []struct {
+		name          string
+		pipelineRun   *v1.PipelineRun
+		members       []*v1.PipelineRun
+		wantStatus    corev1.ConditionStatus
+		wantReason    string
+		wantStarted   bool
+		wantPatches   []string
+		wantCancelled []string
+		wantEvents    []string
+	}{{
+		name:        "first run of the group starts",
+		pipelineRun: newPipelineRun("prod", ""),
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonRunning.String(),
+		wantStarted: true,
+		wantPatches: []string{labelPatch(deployProd)},
+	}, {
+		name:        "group is full",
+		pipelineRun: newPipelineRun("prod", ""),
+		members:     []*v1.PipelineRun{runningMember},
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonPending.String(),
+		wantPatches: []string{labelPatch(deployProd), `{"metadata":{"annotations":{"tekton.dev/concurrencyQueued":"true"},"labels":{"tekton.dev/concurrencyGroup":"` + deployProd + `"}},"spec":{"status":"PipelineRunPending"}}`},
+	}, {
+		name:        "other group",
+		pipelineRun: newPipelineRun("staging", ""),
+		members:     []*v1.PipelineRun{runningMember},
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonRunning.String(),
+		wantStarted: true,
+		wantPatches: []string{labelPatch(concurrencyGroupLabelValue("deploy-staging"))},
+	}, {
+		name:        "group has a free slot",
+		pipelineRun: newPipelineRun("prod", "    maxInFlight: 2"),
+		members:     []*v1.PipelineRun{runningMember},
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonRunning.String(),
+		wantStarted: true,
+		wantPatches: []string{labelPatch(deployProd)},
+	}, {
+		name:        "free slot is taken by an older queued run",
+		pipelineRun: newPipelineRun("prod", "    maxInFlight: 2"),
+		members:     []*v1.PipelineRun{runningMember, queuedMember},
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonPending.String(),
+		wantPatches: []string{labelPatch(deployProd), `{"metadata":{"annotations":{"tekton.dev/concurrencyQueued":"true"},"labels":{"tekton.dev/concurrencyGroup":"` + deployProd + `"}},"spec":{"status":"PipelineRunPending"}}`},
+	}, {
+		name:        "pending run does not hold a slot",
+		pipelineRun: newPipelineRun("prod", "    maxInFlight: 2"),
+		members:     []*v1.PipelineRun{runningMember, pendingMember},
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonRunning.String(),
+		wantStarted: true,
+		wantPatches: []string{labelPatch(deployProd)},
+	}, {
+		name:        "run being admitted holds a slot",
+		pipelineRun: newPipelineRun("prod", ""),
+		members:     []*v1.PipelineRun{admittingMember},
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonPending.String(),
+		wantPatches: []string{labelPatch(deployProd), `{"metadata":{"annotations":{"tekton.dev/concurrencyQueued":"true"},"labels":{"tekton.dev/concurrencyGroup":"` + deployProd + `"}},"spec":{"status":"PipelineRunPending"}}`},
+	}, {
+		name:        "queued run is released",
+		pipelineRun: queuedPipelineRun,
+		members:     []*v1.PipelineRun{runningMember},
+		wantPatches: []string{`{"metadata":{"annotations":{"tekton.dev/concurrencyQueued":null},"labels":{"tekton.dev/concurrencyGroup":"` + deployProd + `"}},"spec":{"status":null}}`},
+	}, {
+		name:        "queued run stays queued",
+		pipelineRun: queuedPipelineRun,
+		members:     []*v1.PipelineRun{runningMember, queuedMember},
+		wantStatus:  corev1.ConditionUnknown,
+		wantReason:  v1.PipelineRunReasonPending.String(),
+	}, {
+		name:          "cancel-older",
+		pipelineRun:   newPipelineRun("prod", "    strategy: cancel-older"),
+		members:       []*v1.PipelineRun{runningMember},
+		wantStatus:    corev1.ConditionUnknown,
+		wantReason:    v1.PipelineRunReasonRunning.String(),
+		wantStarted:   true,
+		wantPatches:   []string{labelPatch(deployProd)},
+		wantCancelled: []string{"running"},
+	}, {
+		name:        "cancel-older superseded by a newer run being admitted",
+		pipelineRun: newPipelineRun("prod", "    strategy: cancel-older"),
+		members:     []*v1.PipelineRun{admittingMember},
+		wantStatus:  corev1.ConditionFalse,
+		wantReason:  v1.PipelineRunReasonCancelled.String(),
+		wantPatches: []string{labelPatch(deployProd), `[{"op":"add","path":"/spec/status","value":"Cancelled"}]`},
+		wantEvents: []string{
+			`Warning Failed PipelineRun "test-pipeline-run" was cancelled since it is superseded in concurrency group "deploy-prod"`,
+		},
+	}, {
+		name:        "cancel-newer",
+		pipelineRun: newPipelineRun("prod", "    strategy: cancel-newer"),
+		members:     []*v1.PipelineRun{runningMember},
+		wantStatus:  corev1.ConditionFalse,
+		wantReason:  v1.PipelineRunReasonCancelled.String(),
+		wantPatches: []string{labelPatch(deployProd), `[{"op":"add","path":"/spec/status","value":"Cancelled"}]`},
+		wantEvents: []string{
+			`Warning Failed PipelineRun "test-pipeline-run" was cancelled since concurrency group "deploy-prod" is full`,
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prt := newPipelineRunTest(t, test.Data{
+				PipelineRuns: append([]*v1.PipelineRun{tc.pipelineRun}, tc.members...),
+				Pipelines:    []*v1.Pipeline{simpleHelloWorldPipeline},
+				Tasks:        []*v1.Task{simpleHelloWorldTask},
+				ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+			})
+			defer prt.Cancel()
+
+			reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run", tc.wantEvents, false)
+
+			if tc.wantReason != "" {
+				th.CheckPipelineRunConditionStatusAndReason(t, reconciledRun.Status, tc.wantStatus, tc.wantReason)
+			}
+			if started := reconciledRun.Status.StartTime != nil; started != tc.wantStarted {
+				t.Errorf("expected the PipelineRun to be started: %t, but got %t", tc.wantStarted, started)
+			}
+			// The fake clientset doesn't implement the status subresource: the status update overwrites
+			// the patched spec and metadata of the PipelineRun, so the patches are checked instead.
+			var patches []string
+			for _, a := range clients.Pipeline.Actions() {
+				if action, ok := a.(ktesting.PatchAction); ok && action.GetName() == "test-pipeline-run" {
+					patches = append(patches, string(action.GetPatch()))
+				}
+			}
+			if d := cmp.Diff(tc.wantPatches, patches); d != "" {
+				t.Errorf("unexpected patches of the PipelineRun %s", diff.PrintWantGot(d))
+			}
+			if tc.wantStarted {
+				wantLabel := concurrencyGroupLabelValue("deploy-" + reconciledRun.Spec.Params[0].Value.StringVal)
+				if got := reconciledRun.Labels[pipeline.ConcurrencyGroupLabelKey]; got != wantLabel {
+					t.Errorf("expected concurrency group label %s but got %s", wantLabel, got)
+				}
+			}
+			if !tc.wantStarted {
+				if len(getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run")) != 0 {
+					t.Error("expected no TaskRun to be created for a PipelineRun that is not started")
+				}
+			}
+			for _, name := range tc.wantCancelled {
+				cancelled, err := clients.Pipeline.TektonV1().PipelineRuns("foo").Get(prt.TestAssets.Ctx, name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("failed to get PipelineRun %s: %v", name, err)
+				}
+				if cancelled.Spec.Status != v1.PipelineRunSpecStatusCancelled {
+					t.Errorf("expected PipelineRun %s to be cancelled but its spec status is %q", name, cancelled.Spec.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileWithConcurrency_SimultaneousRuns(t *testing.T) {
+	newPipelineRun := func(name, creationTimestamp string) *v1.PipelineRun {
+		return parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: %s
+  namespace: foo
+  creationTimestamp: %q
+spec:
+  pipelineRef:
+    name: test-pipeline
+  concurrency:
+    group: deploy-prod
+`, name, creationTimestamp))
+	}
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: []*v1.PipelineRun{
+			newPipelineRun("first", "2022-01-01T00:00:00Z"),
+			newPipelineRun("second", "2022-01-01T00:00:01Z"),
+		},
+		Pipelines:  []*v1.Pipeline{simpleHelloWorldPipeline},
+		Tasks:      []*v1.Task{simpleHelloWorldTask},
+		ConfigMaps: th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+
+	// The informers are not updated between the two reconciles, as when both PipelineRuns are
+	// reconciled before either update is observed: the second one must still see the first one.
+	first, _ := prt.reconcileRun("foo", "first", nil, false)
+	second, clients := prt.reconcileRun("foo", "second", nil, false)
+
+	if first.Status.StartTime == nil {
+		t.Error("expected the first PipelineRun to be started")
+	}
+	if second.Status.StartTime != nil {
+		t.Error("expected the second PipelineRun not to be started")
+	}
+	th.CheckPipelineRunConditionStatusAndReason(t, second.Status, corev1.ConditionUnknown, v1.PipelineRunReasonPending.String())
+	if len(getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "second")) != 0 {
+		t.Error("expected no TaskRun to be created for the second PipelineRun")
+	}
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	th "github.com/tektoncd/pipeline/pkg/reconciler/testing"
+	"github.com/tektoncd/pipeline/test"
+	"github.com/tektoncd/pipeline/test/diff"
+	"github.com/tektoncd/pipeline/test/parse"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/system"
+)
+
+// matrixTestTask returns the Task run by the matrixed PipelineTasks of the tests below.
+func matrixTestTask(t *testing.T) *v1.Task {
+	t.Helper()
+	return parse.MustParseV1Task(t, `
+metadata:
+  name: build
+  namespace: foo
+spec:
+  params:
+  - name: platform
+  steps:
+  - name: build
+    image: busybox
+    script: echo $(params.platform)
+`)
+}
+
+// newMatrixTestPipeline returns test-pipeline, running the build Task over three platforms with the
+// given additional matrix fields.
+func newMatrixTestPipeline(t *testing.T, matrix string) *v1.Pipeline {
+	t.Helper()
+	return parse.MustParseV1Pipeline(t, fmt.Sprintf(`
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: build
+    taskRef:
+      name: build
+    matrix:
+      params:
+      - name: platform
+        value: [linux, mac, windows]
+%s`, matrix))
+}
+
+// newMatrixTestTaskRun returns the TaskRun of the i-th combination of the build PipelineTask,
+// with the given Succeeded condition status.
+func newMatrixTestTaskRun(t *testing.T, i int, status string) *v1.TaskRun {
+	t.Helper()
+	return parse.MustParseV1TaskRun(t, fmt.Sprintf(`
+metadata:
+  name: test-pipeline-run-build-%d
+  namespace: foo
+  labels:
+    tekton.dev/pipelineRun: test-pipeline-run
+    tekton.dev/pipelineTask: build
+  ownerReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: test-pipeline-run
+    controller: true
+spec:
+  taskRef:
+    name: build
+status:
+  conditions:
+  - type: Succeeded
+    status: %q
+`, i, status))
+}
+
+// newMatrixTestPipelineRun returns a PipelineRun of test-pipeline which created the given TaskRuns.
+func newMatrixTestPipelineRun(t *testing.T, taskRuns ...*v1.TaskRun) *v1.PipelineRun {
+	t.Helper()
+	pr := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+`)
+	if len(taskRuns) > 0 {
+		pr.Status.StartTime = &metav1.Time{Time: now}
+		pr.Status.MarkRunning(v1.PipelineRunReasonRunning.String(), "")
+	}
+	for _, tr := range taskRuns {
+		pr.Status.ChildReferences = append(pr.Status.ChildReferences, v1.ChildStatusReference{
+			TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+			Name:             tr.Name,
+			PipelineTaskName: "build",
+		})
+	}
+	return pr
+}
+
+func TestReconcileWithMatrixMaxConcurrency(t *testing.T) {
+	task := matrixTestTask(t)
+	defaultsWithMaxMatrixConcurrency := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: config.GetDefaultsConfigName(), Namespace: system.Namespace()},
+		Data:       map[string]string{"default-max-matrix-concurrency": "1"},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		pipeline     *v1.Pipeline
+		taskRuns     []*v1.TaskRun
+		configMaps   []*corev1.ConfigMap
+		wantTaskRuns []string
+		wantReason   string
+	}{{
+		name:     "first window",
+		pipeline: newMatrixTestPipeline(t, "      maxConcurrency: 2"),
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+		},
+		wantReason: v1.PipelineRunReasonRunning.String(),
+	}, {
+		name:     "window full",
+		pipeline: newMatrixTestPipeline(t, "      maxConcurrency: 2"),
+		taskRuns: []*v1.TaskRun{newMatrixTestTaskRun(t, 0, "Unknown"), newMatrixTestTaskRun(t, 1, "Unknown")},
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+		},
+		wantReason: v1.PipelineRunReasonRunning.String(),
+	}, {
+		name:     "next combination starts when an earlier one fails",
+		pipeline: newMatrixTestPipeline(t, "      maxConcurrency: 2"),
+		taskRuns: []*v1.TaskRun{newMatrixTestTaskRun(t, 0, "False"), newMatrixTestTaskRun(t, 1, "Unknown")},
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+			"test-pipeline-run-build-2",
+		},
+		wantReason: v1.PipelineRunReasonRunning.String(),
+	}, {
+		name:     "all combinations done",
+		pipeline: newMatrixTestPipeline(t, "      maxConcurrency: 2"),
+		taskRuns: []*v1.TaskRun{newMatrixTestTaskRun(t, 0, "True"), newMatrixTestTaskRun(t, 1, "True"), newMatrixTestTaskRun(t, 2, "True")},
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+			"test-pipeline-run-build-2",
+		},
+		wantReason: v1.PipelineRunReasonSuccessful.String(),
+	}, {
+		name:         "cluster default",
+		pipeline:     newMatrixTestPipeline(t, ""),
+		configMaps:   []*corev1.ConfigMap{defaultsWithMaxMatrixConcurrency},
+		wantTaskRuns: []string{"test-pipeline-run-build-0"},
+		wantReason:   v1.PipelineRunReasonRunning.String(),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prt := newPipelineRunTest(t, test.Data{
+				PipelineRuns: []*v1.PipelineRun{newMatrixTestPipelineRun(t, tc.taskRuns...)},
+				Pipelines:    []*v1.Pipeline{tc.pipeline},
+				Tasks:        []*v1.Task{task},
+				TaskRuns:     tc.taskRuns,
+				ConfigMaps:   append(th.NewAlphaFeatureFlagsConfigMapInSlice(), tc.configMaps...),
+			})
+			defer prt.Cancel()
+
+			reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run", nil, false)
+
+			if got := reconciledRun.Status.GetCondition("Succeeded").Reason; got != tc.wantReason {
+				t.Errorf("expected reason %s but got %s", tc.wantReason, got)
+			}
+			taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run")
+			if d := cmp.Diff(tc.wantTaskRuns, sets.List(sets.KeySet(taskRuns))); d != "" {
+				t.Errorf("unexpected TaskRuns %s", diff.PrintWantGot(d))
+			}
+			if len(reconciledRun.Status.ChildReferences) != len(tc.wantTaskRuns) {
+				t.Errorf("expected %d child references but got %d", len(tc.wantTaskRuns), len(reconciledRun.Status.ChildReferences))
+			}
+		})
+	}
+}
+
+func TestReconcileWithMatrixExcludeAndFailurePolicy(t *testing.T) {
+	task := matrixTestTask(t)
+	cancelled := func(tr *v1.TaskRun) *v1.TaskRun {
+		tr.Status.Conditions[0].Reason = v1.TaskRunReasonCancelled.String()
+		return tr
+	}
+
+	for _, tc := range []struct {
+		name          string
+		matrix        string
+		taskRuns      []*v1.TaskRun
+		wantTaskRuns  []string
+		wantCancelled []string
+		wantReason    string
+	}{{
+		name: "excluded combination is not created",
+		matrix: `      exclude:
+      - params:
+        - name: platform
+          value: mac
+`,
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+		},
+		wantReason: v1.PipelineRunReasonRunning.String(),
+	}, {
+		name: "failFast cancels the remaining combinations",
+		matrix: `      failurePolicy:
+        failFast: true
+`,
+		taskRuns: []*v1.TaskRun{newMatrixTestTaskRun(t, 0, "False"), newMatrixTestTaskRun(t, 1, "Unknown"), newMatrixTestTaskRun(t, 2, "Unknown")},
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+			"test-pipeline-run-build-2",
+		},
+		wantCancelled: []string{"test-pipeline-run-build-1", "test-pipeline-run-build-2"},
+		wantReason:    v1.PipelineRunReasonRunning.String(),
+	}, {
+		name: "failFast fails the PipelineRun once the combinations are cancelled",
+		matrix: `      failurePolicy:
+        failFast: true
+`,
+		taskRuns: []*v1.TaskRun{
+			newMatrixTestTaskRun(t, 0, "False"),
+			cancelled(newMatrixTestTaskRun(t, 1, "False")),
+			cancelled(newMatrixTestTaskRun(t, 2, "False")),
+		},
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+			"test-pipeline-run-build-2",
+		},
+		wantReason: v1.PipelineRunReasonFailed.String(),
+	}, {
+		name: "minSuccessful succeeds partially",
+		matrix: `      failurePolicy:
+        minSuccessful: 60%
+`,
+		taskRuns: []*v1.TaskRun{newMatrixTestTaskRun(t, 0, "False"), newMatrixTestTaskRun(t, 1, "True"), newMatrixTestTaskRun(t, 2, "True")},
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+			"test-pipeline-run-build-2",
+		},
+		wantReason: v1.PipelineRunReasonSuccessful.String(),
+	}, {
+		name: "minSuccessful not reached",
+		matrix: `      failurePolicy:
+        minSuccessful: 3
+`,
+		taskRuns: []*v1.TaskRun{newMatrixTestTaskRun(t, 0, "False"), newMatrixTestTaskRun(t, 1, "True"), newMatrixTestTaskRun(t, 2, "True")},
+		wantTaskRuns: []string{
+			"test-pipeline-run-build-0",
+			"test-pipeline-run-build-1",
+			"test-pipeline-run-build-2",
+		},
+		wantReason: v1.PipelineRunReasonFailed.String(),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prt := newPipelineRunTest(t, test.Data{
+				PipelineRuns: []*v1.PipelineRun{newMatrixTestPipelineRun(t, tc.taskRuns...)},
+				Pipelines:    []*v1.Pipeline{newMatrixTestPipeline(t, tc.matrix)},
+				Tasks:        []*v1.Task{task},
+				TaskRuns:     tc.taskRuns,
+				ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+			})
+			defer prt.Cancel()
+
+			reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run", nil, false)
+
+			if got := reconciledRun.Status.GetCondition("Succeeded").Reason; got != tc.wantReason {
+				t.Errorf("expected reason %s but got %s", tc.wantReason, got)
+			}
+			taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run")
+			if d := cmp.Diff(tc.wantTaskRuns, sets.List(sets.KeySet(taskRuns))); d != "" {
+				t.Errorf("unexpected TaskRuns %s", diff.PrintWantGot(d))
+			}
+			var gotCancelled []string
+			for name, tr := range taskRuns {
+				if tr.Spec.Status == v1.TaskRunSpecStatusCancelled {
+					gotCancelled = append(gotCancelled, name)
+				}
+			}
+			if d := cmp.Diff(tc.wantCancelled, gotCancelled, cmpopts.SortSlices(func(a, b string) bool { return a < b })); d != "" {
+				t.Errorf("unexpected cancelled TaskRuns %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
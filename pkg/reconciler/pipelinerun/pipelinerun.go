@@ -30,10 +30,12 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	pipelineErrors "github.com/tektoncd/pipeline/pkg/apis/pipeline/errors"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	clientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	pipelinerunreconciler "github.com/tektoncd/pipeline/pkg/client/injection/reconciler/pipeline/v1/pipelinerun"
@@ -47,10 +49,12 @@ import (
 	"github.com/tektoncd/pipeline/pkg/pipelinerunmetrics"
 	tknreconciler "github.com/tektoncd/pipeline/pkg/reconciler"
 	"github.com/tektoncd/pipeline/pkg/reconciler/apiserver"
+	"github.com/tektoncd/pipeline/pkg/reconciler/attestation"
 	"github.com/tektoncd/pipeline/pkg/reconciler/events"
 	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
 	rprp "github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/pipelinespec"
 	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/pkg/reconciler/sensitiveparams"
 	"github.com/tektoncd/pipeline/pkg/reconciler/taskrun"
 	tresources "github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
 	"github.com/tektoncd/pipeline/pkg/reconciler/volumeclaim"
@@ -73,6 +77,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/clock"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/controller"
@@ -173,10 +178,12 @@ type Reconciler struct {
 	taskRunLister            listers.TaskRunLister
 	customRunLister          beta1listers.CustomRunLister
 	verificationPolicyLister alpha1listers.VerificationPolicyLister
+	secretLister             corev1listers.SecretLister
 	metrics                  *pipelinerunmetrics.Recorder
 	pvcHandler               volumeclaim.PvcHandler
 	resolutionRequester      resolution.Requester
 	tracerProvider           trace.TracerProvider
+	resultsCache             *objectstorageresults.Cache
 }
 
 var (
@@ -244,9 +251,25 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pr *v1.PipelineRun) (rec
 		if err != nil {
 			logger.Errorf("Failed to delete StatefulSet or PVC for PipelineRun %s: %v", pr.Name, err)
 		}
+		if attestErr := c.attestProvenance(ctx, pr); attestErr != nil {
+			logger.Errorf("Failed to attest the provenance of PipelineRun %s: %v", pr.Name, attestErr)
+			err = errors.Join(err, attestErr)
+		}
 		return c.emitReconcileEvents(ctx, pr, before, err)
 	}
 
+	// A PipelineRun with a concurrency group waits for a slot in the group before it starts
+	if !pr.HasStarted() && (!pr.IsPending() || isConcurrencyQueued(pr)) && !pr.IsCancelled() && pr.Spec.Concurrency != nil {
+		held, err := c.admitConcurrency(ctx, pr)
+		if err != nil {
+			logger.Errorf("Failed to admit PipelineRun %s in its concurrency group: %v", pr.Name, err)
+			return c.emitReconcileEvents(ctx, pr, before, err)
+		}
+		if held {
+			return c.emitReconcileEvents(ctx, pr, before, nil)
+		}
+	}
+
 	if !pr.HasStarted() && !pr.IsPending() {
 		pr.Status.InitializeConditions(c.Clock)
 		// In case node time was not synchronized, when controller has been scheduled to other nodes.
@@ -267,6 +290,9 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pr *v1.PipelineRun) (rec
 		before = pr.Status.GetCondition(apis.ConditionSucceeded)
 	}
 
+	// Keep track of the time spent paused, which doesn't count against the timeouts
+	updatePausedTime(pr, c.Clock)
+
 	// list VerificationPolicies for trusted resources
 	vp, err := c.verificationPolicyLister.VerificationPolicies(pr.Namespace).List(labels.Everything())
 	if err != nil {
@@ -312,9 +338,18 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pr *v1.PipelineRun) (rec
 		return err
 	}
 
-	if pr.Status.StartTime != nil {
-		// Compute the time since the pipeline started.
-		elapsed := c.Clock.Since(pr.Status.StartTime.Time)
+	// Snooze a queued PipelineRun until its queue timeout has elapsed. Its other timeouts only start
+	// counting down once the first Step of its TaskRuns starts, which triggers another reconcile.
+	if pr.IsQueued() {
+		if pr.Spec.Timeouts.Queue.Duration == config.NoTimeoutDuration {
+			return nil
+		}
+		return controller.NewRequeueAfter(pr.Spec.Timeouts.Queue.Duration - (c.Clock.Since(pr.Status.StartTime.Time) - pr.PausedFor(c.Clock)))
+	}
+
+	if startTime := pr.TimeoutStartTime(); startTime != nil {
+		// Compute the time since the pipeline started, not counting the time it was paused.
+		elapsed := c.Clock.Since(startTime.Time) - pr.PausedFor(c.Clock)
 		// Snooze this resource until the appropriate timeout has elapsed.
 		timeout := pr.PipelineTimeout(ctx)
 		taskTimeout := pr.TasksTimeout()
@@ -360,6 +395,18 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, pr *v1.PipelineRun) (rec
 	return nil
 }
 
+// updatePausedTime records when the PipelineRun is paused, and adds the duration of the pause
+// to its paused duration when it is resumed.
+func updatePausedTime(pr *v1.PipelineRun, c clock.PassiveClock) {
+	switch {
+	case pr.IsPaused() && pr.Status.PausedTime == nil:
+		pr.Status.PausedTime = &metav1.Time{Time: c.Now()}
+	case !pr.IsPaused() && pr.Status.PausedTime != nil:
+		pr.Status.PausedDuration = &metav1.Duration{Duration: pr.PausedFor(c)}
+		pr.Status.PausedTime = nil
+	}
+}
+
 func (c *Reconciler) durationAndCountMetrics(ctx context.Context, pr *v1.PipelineRun, beforeCondition *apis.Condition) {
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "durationAndCountMetrics")
 	defer span.End()
@@ -385,6 +432,23 @@ func (c *Reconciler) emitReconcileEvents(ctx context.Context, pr *v1.PipelineRun
 	return previousError
 }
 
+// extendResolvedTask merges the resolved Task into the base Task it extends, if any, so the
+// params and results of the base Task are known when validating the PipelineTasks.
+func (c *Reconciler) extendResolvedTask(ctx context.Context, pr *v1.PipelineRun, trName string, rpt *resources.ResolvedPipelineTask, vp []*v1alpha1.VerificationPolicy) error {
+	rt := rpt.ResolvedTask
+	if rt == nil || rt.TaskSpec == nil || rt.TaskSpec.Extends == nil {
+		return nil
+	}
+	baseRef := rt.TaskSpec.Extends.TaskRef.DeepCopy()
+	getBaseTask := tresources.GetTaskFunc(ctx, c.KubeClientSet, c.PipelineClientSet, c.resolutionRequester, pr, baseRef, trName, pr.Namespace, pr.Spec.TaskRunTemplate.ServiceAccountName, vp)
+	taskSpec, _, err := tresources.ExtendTaskSpec(ctx, rt.TaskSpec, getBaseTask)
+	if err != nil {
+		return err
+	}
+	rt.TaskSpec = taskSpec
+	return nil
+}
+
 // resolvePipelineState will attempt to resolve each referenced pipeline task in the pipeline's spec and all of the resources
 // specified by those tasks.
 func (c *Reconciler) resolvePipelineState(
@@ -520,6 +584,16 @@ func (c *Reconciler) resolvePipelineState(
 			return nil, controller.NewPermanentError(err)
 		}
 
+		if err := c.extendResolvedTask(ctx, pr, trName, resolvedTask, vp); err != nil {
+			if resolutioncommon.IsErrTransient(err) || errors.Is(err, remote.ErrRequestInProgress) {
+				return nil, err
+			}
+			pr.Status.MarkFailed(v1.PipelineRunReasonCouldntGetTask.String(),
+				"Pipeline %s/%s can't be Run; the Task of PipelineTask %s can't extend its base Task: %s",
+				pipelineMeta.Namespace, pipelineMeta.Name, pipelineTask.Name, pipelineErrors.WrapUserError(err))
+			return nil, controller.NewPermanentError(err)
+		}
+
 		if resolvedTask.ResolvedTask != nil && resolvedTask.ResolvedTask.VerificationResult != nil {
 			cond, err := conditionFromVerificationResult(resolvedTask.ResolvedTask.VerificationResult, pr, pipelineTask.Name)
 			pr.Status.SetCondition(cond)
@@ -542,6 +616,25 @@ func (c *Reconciler) resolvePipelineState(
 	return pst, nil
 }
 
+// expandPipelineImports inlines the Pipelines imported by the PipelineSpec, fetching them
+// either from the cluster or through remote resolution.
+func (c *Reconciler) expandPipelineImports(ctx context.Context, pr *v1.PipelineRun, pipelineSpec *v1.PipelineSpec) (*v1.PipelineSpec, error) {
+	if len(pipelineSpec.Imports) == 0 {
+		return pipelineSpec, nil
+	}
+	// An embedded PipelineSpec isn't read from the status, unlike a referenced Pipeline.
+	if pr.Status.PipelineSpec != nil && len(pr.Status.PipelineSpec.Imports) == 0 {
+		return pr.Status.PipelineSpec.DeepCopy(), nil
+	}
+	vp, err := c.verificationPolicyLister.VerificationPolicies(pr.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VerificationPolicies from namespace %s with error %w", pr.Namespace, err)
+	}
+	return resources.ExpandPipelineImports(ctx, pipelineSpec, func(ref *v1.PipelineRef) rprp.GetPipeline {
+		return resources.GetChildPipelineFunc(ctx, c.KubeClientSet, c.PipelineClientSet, c.resolutionRequester, pr, ref.DeepCopy(), vp)
+	})
+}
+
 func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipelineFunc rprp.GetPipeline) error {
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "reconcile")
 	defer span.End()
@@ -574,11 +667,27 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 			"Error retrieving pipeline for pipelinerun %s/%s: %s",
 			pr.Namespace, pr.Name, err)
 		return controller.NewPermanentError(err)
-	default:
-		// Store the fetched PipelineSpec on the PipelineRun for auditing
-		if err := storePipelineSpecAndMergeMeta(ctx, pr, pipelineSpec, pipelineMeta); err != nil {
-			logger.Errorf("Failed to store PipelineSpec on PipelineRun.Status for pipelinerun %s: %v", pr.Name, err)
-		}
+	}
+
+	// Inline the imported Pipelines before anything is derived from the PipelineSpec. The expanded
+	// PipelineSpec is stored in the status, so the imports are only fetched once.
+	pipelineSpec, err = c.expandPipelineImports(ctx, pr, pipelineSpec)
+	switch {
+	case errors.Is(err, remote.ErrRequestInProgress):
+		message := fmt.Sprintf("PipelineRun %s/%s awaiting remote resource", pr.Namespace, pr.Name)
+		pr.Status.MarkRunning(v1.PipelineRunReasonResolvingPipelineRef.String(), message)
+		return controller.NewRequeueAfter(remoteResolutionRequeueAfter)
+	case err != nil:
+		logger.Errorf("Failed to import Pipelines for pipelinerun %s: %v", pr.Name, err)
+		pr.Status.MarkFailed(v1.PipelineRunReasonCouldntGetPipeline.String(),
+			"Error importing pipelines for pipelinerun %s/%s: %s",
+			pr.Namespace, pr.Name, err)
+		return controller.NewPermanentError(err)
+	}
+
+	// Store the fetched PipelineSpec on the PipelineRun for auditing
+	if err := storePipelineSpecAndMergeMeta(ctx, pr, pipelineSpec, pipelineMeta); err != nil {
+		logger.Errorf("Failed to store PipelineSpec on PipelineRun.Status for pipelinerun %s: %v", pr.Name, err)
 	}
 
 	if pipelineMeta.VerificationResult != nil {
@@ -649,6 +758,30 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		}
 	}
 
+	if err := taskrun.ValidateParamSchema(ctx, pr.Spec.Params, pipelineSpec.Params); err != nil {
+		logger.Errorf("PipelineRun %q Param Schema validation failed: %v", pr.Name, err)
+		pr.Status.MarkFailed(v1.PipelineRunReasonInvalidParamValue.String(),
+			"PipelineRun %s/%s parameters have invalid value: %s",
+			pr.Namespace, pr.Name, err)
+		return controller.NewPermanentError(err)
+	}
+
+	// Move the values of the sensitive params to a Secret; the updated params are persisted by syncMetadata
+	params, err := sensitiveparams.MoveToSecret(ctx, c.KubeClientSet, pr.Spec.Params, pipelineSpec.Params,
+		*kmeta.NewControllerRef(pr), pr.Namespace, map[string]string{pipeline.PipelineRunLabelKey: pr.Name})
+	if err != nil {
+		logger.Errorf("Failed to move the sensitive params of PipelineRun %q to a Secret: %v", pr.Name, err)
+		return err
+	}
+	pr.Spec.Params = params
+
+	if err := resources.ValidateSensitiveParamReferences(pipelineSpec); err != nil {
+		pr.Status.MarkFailed(v1.PipelineRunReasonFailedValidation.String(),
+			"PipelineRun %s/%s doesn't reference the sensitive params of Pipeline %s/%s correctly: %s",
+			pr.Namespace, pr.Name, pr.Namespace, pipelineMeta.Name, pipelineErrors.WrapUserError(err))
+		return controller.NewPermanentError(err)
+	}
+
 	// Ensure that the keys of an object param declared in PipelineSpec are not missed in the PipelineRunSpec
 	if err = resources.ValidateObjectParamRequiredKeys(pipelineSpec.Params, pr.Spec.Params); err != nil {
 		// This Run has failed, so we need to mark it as failed and stop reconciling it
@@ -722,6 +855,13 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		tasks = append(tasks, pipelineSpec.Finally...)
 	}
 
+	// Carry over the runs of the PipelineRun to rerun before scheduling any PipelineTask
+	if pr.Spec.RerunOf != nil && len(pr.Status.ChildReferences) == 0 && len(pr.Status.CarriedOverTasks) == 0 {
+		if err := c.carryOverRerunOf(ctx, pr, pipelineSpec.Tasks, d); err != nil {
+			return err
+		}
+	}
+
 	// We split tasks in two lists:
 	// - those with a completed (Task|Custom)Run reference (i.e. those that finished running)
 	// - those without a (Task|Custom)Run reference
@@ -759,6 +899,12 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 	default:
 	}
 
+	// Fetch the values of the results stored in object storage, before they are used to resolve
+	// the PipelineTasks not started yet
+	if err := c.fetchStoredResults(ctx, pr, pipelineRunState); err != nil {
+		return err
+	}
+
 	// Second iteration
 	pipelineRunState, err = c.resolvePipelineState(ctx, notStartedTasks, pipelineMeta.ObjectMeta, pr, pipelineRunState)
 	switch {
@@ -781,9 +927,20 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		TimeoutsState: resources.PipelineRunTimeoutsState{
 			Clock: c.Clock,
 		},
+		CarriedOverSkips: sets.Set[string]{},
+	}
+	for _, ct := range pr.Status.CarriedOverTasks {
+		if ct.Skipped {
+			pipelineRunFacts.CarriedOverSkips.Insert(ct.Name)
+		}
 	}
-	if pr.Status.StartTime != nil {
-		pipelineRunFacts.TimeoutsState.StartTime = &pr.Status.StartTime.Time
+	if pr.Status.ExecutionStartTime == nil {
+		pr.Status.ExecutionStartTime = pipelineRunState.ExecutionStartTime()
+	}
+	if timeoutStartTime := pr.TimeoutStartTime(); timeoutStartTime != nil {
+		// Shift the start time by the time spent paused, so that it isn't counted against the timeouts
+		startTime := timeoutStartTime.Add(pr.PausedFor(c.Clock))
+		pipelineRunFacts.TimeoutsState.StartTime = &startTime
 	}
 	if pr.Status.FinallyStartTime != nil {
 		pipelineRunFacts.TimeoutsState.FinallyStartTime = &pr.Status.FinallyStartTime.Time
@@ -930,6 +1087,12 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 		}
 	}
 
+	if errs := cancelMatrixFailFastRuns(ctx, logger, pr, pipelineRunFacts.State, c.PipelineClientSet); len(errs) > 0 {
+		errString := strings.Join(errs, "\n")
+		logger.Errorf("Failed to cancel the remaining combinations of matrixed tasks for PipelineRun %s/%s: %s", pr.Namespace, pr.Name, errString)
+		return fmt.Errorf("error(s) from cancelling TaskRun(s) from PipelineRun %s: %s", pr.Name, errString)
+	}
+
 	if err := c.runNextSchedulableTask(ctx, pr, pipelineRunFacts); err != nil {
 		return err
 	}
@@ -938,7 +1101,7 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 	pipelineRunFacts.ResetSkippedCache()
 
 	// If the pipelinerun has timed out, mark tasks as timed out and update status
-	if pr.HasTimedOut(ctx, c.Clock) {
+	if pr.HasTimedOut(ctx, c.Clock) || pr.HasQueueTimedOut(c.Clock) {
 		if err := timeoutPipelineRun(ctx, logger, pr, c.PipelineClientSet); err != nil {
 			return err
 		}
@@ -960,6 +1123,8 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 	pr.Status.ChildReferences = pipelineRunFacts.GetChildReferences()
 
 	pr.Status.SkippedTasks = pipelineRunFacts.GetSkippedTasks()
+	pr.Status.CachedTasks = pipelineRunFacts.GetCachedTasks()
+	pr.Status.CarriedOverTasks = pipelineRunFacts.GetCarriedOverTasks(pr.Spec.RerunOf)
 	pipelineTaskStatus := pipelineRunFacts.GetPipelineTaskStatus()
 	finalPipelineTaskStatus := pipelineRunFacts.GetPipelineFinalTaskStatus()
 	pipelineTaskStatus = kmap.Union(pipelineTaskStatus, finalPipelineTaskStatus)
@@ -977,6 +1142,12 @@ func (c *Reconciler) reconcile(ctx context.Context, pr *v1.PipelineRun, getPipel
 				pr.Name, err)
 			return err
 		}
+		if err := resources.ValidatePipelineResultsSchemas(pipelineSpec.Results, pr.Status.Results); err != nil {
+			pr.Status.MarkFailed(v1.PipelineRunReasonInvalidResultValue.String(),
+				"PipelineResults of PipelineRun %s have invalid values: %s",
+				pr.Name, err)
+			return err
+		}
 	}
 
 	logger.Infof("PipelineRun %s status is being set to %s", pr.Name, after)
@@ -1140,9 +1311,19 @@ func (c *Reconciler) createChildPipelineRuns(
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createChildPipelineRuns")
 	defer span.End()
 
-	var childPipelineRuns []*v1.PipelineRun
-	for _, childPipelineRunName := range rpt.ChildPipelineRunNames {
-		childPipelineRun, err := c.createChildPipelineRun(ctx, childPipelineRunName, rpt, pr, facts)
+	var matrixCombinations []v1.Params
+	if rpt.PipelineTask.IsMatrixed() {
+		matrixCombinations = rpt.PipelineTask.Matrix.FanOut()
+	}
+
+	// a matrixed PipelineTask running with a bounded maxConcurrency adds child PipelineRuns to the ones created earlier
+	childPipelineRuns := rpt.ChildPipelineRuns
+	for _, i := range rpt.RunsToSchedule() {
+		var params v1.Params
+		if len(matrixCombinations) > i {
+			params = matrixCombinations[i]
+		}
+		childPipelineRun, err := c.createChildPipelineRun(ctx, rpt.ChildPipelineRunNames[i], params, rpt, pr, facts)
 		if err != nil {
 			err := c.handleRunCreationError(pr, err)
 			return nil, err
@@ -1156,6 +1337,7 @@ func (c *Reconciler) createChildPipelineRuns(
 func (c *Reconciler) createChildPipelineRun(
 	ctx context.Context,
 	childPipelineRunName string,
+	params v1.Params,
 	rpt *resources.ResolvedPipelineTask,
 	pr *v1.PipelineRun,
 	facts *resources.PipelineRunFacts,
@@ -1165,6 +1347,7 @@ func (c *Reconciler) createChildPipelineRun(
 
 	logger := logging.FromContext(ctx)
 	rpt.PipelineTask = resources.ApplyPipelineTaskContexts(rpt.PipelineTask, pr.Status, facts)
+	params = append(params, rpt.PipelineTask.Params...)
 
 	// For PipelineRef tasks, detect and prevent pipeline-in-pipeline cycles
 	// by walking up the ownerReferences chain and checking tekton.dev/pipeline labels.
@@ -1192,7 +1375,7 @@ func (c *Reconciler) createChildPipelineRun(
 
 	childSpec := v1.PipelineRunSpec{
 		TaskRunTemplate: pr.Spec.TaskRunTemplate,
-		Params:          rpt.PipelineTask.Params,
+		Params:          params,
 		Workspaces:      childWorkspaces,
 	}
 	if rpt.PipelineTask.PipelineRef != nil {
@@ -1338,13 +1521,14 @@ func (c *Reconciler) createTaskRuns(ctx context.Context, rpt *resources.Resolved
 		}
 	}
 
-	var taskRuns []*v1.TaskRun
-	for i, taskRunName := range rpt.TaskRunNames {
+	// a matrixed PipelineTask running with a bounded maxConcurrency adds TaskRuns to the ones created earlier
+	taskRuns := rpt.TaskRuns
+	for _, i := range rpt.RunsToSchedule() {
 		var params v1.Params
 		if len(matrixCombinations) > i {
 			params = matrixCombinations[i]
 		}
-		taskRun, err := c.createTaskRun(ctx, taskRunName, params, rpt, pr, facts)
+		taskRun, err := c.createTaskRun(ctx, rpt.TaskRunNames[i], params, rpt, pr, facts)
 		if err != nil {
 			err := c.handleRunCreationError(pr, err)
 			return nil, err
@@ -1368,6 +1552,24 @@ func (c *Reconciler) createTaskRun(ctx context.Context, taskRunName string, para
 	rpt.PipelineTask = resources.ApplyPipelineTaskContexts(rpt.PipelineTask, pr.Status, facts)
 	taskRunSpec := pr.GetTaskRunSpec(rpt.PipelineTask.Name)
 	params = append(params, rpt.PipelineTask.Params...)
+
+	var cacheKey string
+	if rpt.PipelineTask.Cache != nil {
+		cacheKey, err = resources.GetCacheKey(rpt.ResolvedTask.TaskSpec, params, taskRunSpec, rpt.PipelineTask.Cache)
+		if err != nil {
+			return nil, err
+		}
+		cached, err := c.getCachedTaskRun(pr.Namespace, cacheKey)
+		if err != nil {
+			return nil, err
+		}
+		if cached != nil {
+			logger.Infof("Reusing TaskRun %s with cache key %s for pipeline task %s", cached.Name, cacheKey, rpt.PipelineTask.Name)
+			rpt.Cached = true
+			return cached, nil
+		}
+	}
+
 	tr := &v1.TaskRun{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            taskRunName,
@@ -1378,6 +1580,7 @@ func (c *Reconciler) createTaskRun(ctx context.Context, taskRunName string, para
 		},
 		Spec: v1.TaskRunSpec{
 			Retries:            rpt.PipelineTask.Retries,
+			RetryPolicy:        rpt.PipelineTask.RetryPolicy,
 			Params:             params,
 			ServiceAccountName: taskRunSpec.ServiceAccountName,
 			PodTemplate:        taskRunSpec.PodTemplate,
@@ -1395,6 +1598,9 @@ func (c *Reconciler) createTaskRun(ctx context.Context, taskRunName string, para
 	if rpt.PipelineTask.OnError == v1.PipelineTaskContinue {
 		tr.Annotations[v1.PipelineTaskOnErrorAnnotation] = string(v1.PipelineTaskContinue)
 	}
+	if cacheKey != "" {
+		tr.Labels[pipeline.CacheKeyLabelKey] = cacheKey
+	}
 
 	if rpt.PipelineTask.Timeout != nil {
 		tr.Spec.Timeout = rpt.PipelineTask.Timeout
@@ -1407,6 +1613,11 @@ func (c *Reconciler) createTaskRun(ctx context.Context, taskRunName string, para
 		tr.Spec.Timeout = taskRunSpec.Timeout
 	}
 
+	// Each TaskRun waits for its first Step to start within the queue timeout of the PipelineRun
+	if pr.Spec.Timeouts != nil && pr.Spec.Timeouts.Queue != nil {
+		tr.Spec.Timeouts = &v1.TaskRunTimeouts{Queue: pr.Spec.Timeouts.Queue}
+	}
+
 	if rpt.ResolvedTask.TaskName != "" {
 		// We pass the entire, original task ref because it may contain additional references like a Bundle url.
 		tr.Spec.TaskRef = rpt.PipelineTask.TaskRef
@@ -1419,6 +1630,12 @@ func (c *Reconciler) createTaskRun(ctx context.Context, taskRunName string, para
 	if err != nil {
 		return nil, err
 	}
+	if err := setWorkspaceTransfers(ctx, pr, rpt, facts, taskRunName, tr.Spec.Workspaces); err != nil {
+		return nil, err
+	}
+	if err := setWorkspaceCaches(rpt, tr.Spec.Workspaces); err != nil {
+		return nil, err
+	}
 
 	aaBehavior, err := affinityassistant.GetAffinityAssistantBehavior(ctx)
 	if err != nil {
@@ -1459,6 +1676,24 @@ func (c *Reconciler) createTaskRun(ctx context.Context, taskRunName string, para
 	return result, nil
 }
 
+// getCachedTaskRun returns the most recently completed successful TaskRun labeled with the given cache key, if any.
+func (c *Reconciler) getCachedTaskRun(namespace, cacheKey string) (*v1.TaskRun, error) {
+	taskRuns, err := c.taskRunLister.TaskRuns(namespace).List(k8slabels.SelectorFromSet(map[string]string{pipeline.CacheKeyLabelKey: cacheKey}))
+	if err != nil {
+		return nil, fmt.Errorf("could not list TaskRuns with cache key %s: %w", cacheKey, err)
+	}
+	var cached *v1.TaskRun
+	for _, tr := range taskRuns {
+		if !tr.IsSuccessful() || tr.Status.CompletionTime == nil || tr.DeletionTimestamp != nil {
+			continue
+		}
+		if cached == nil || tr.Status.CompletionTime.After(cached.Status.CompletionTime.Time) {
+			cached = tr
+		}
+	}
+	return cached, nil
+}
+
 // handleRunCreationError marks the PipelineRun as failed and returns a permanent error if the run creation error is not retryable
 func (c *Reconciler) handleRunCreationError(pr *v1.PipelineRun, err error) error {
 	if controller.IsPermanentError(err) {
@@ -1475,7 +1710,7 @@ func (c *Reconciler) handleRunCreationError(pr *v1.PipelineRun, err error) error
 }
 
 func (c *Reconciler) createCustomRuns(ctx context.Context, rpt *resources.ResolvedPipelineTask, pr *v1.PipelineRun, facts *resources.PipelineRunFacts) ([]*v1beta1.CustomRun, error) {
-	var customRuns []*v1beta1.CustomRun
+	customRuns := rpt.CustomRuns
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "createCustomRuns")
 	defer span.End()
 	var matrixCombinations []v1.Params
@@ -1483,12 +1718,12 @@ func (c *Reconciler) createCustomRuns(ctx context.Context, rpt *resources.Resolv
 	if rpt.PipelineTask.IsMatrixed() {
 		matrixCombinations = rpt.PipelineTask.Matrix.FanOut()
 	}
-	for i, customRunName := range rpt.CustomRunNames {
+	for _, i := range rpt.RunsToSchedule() {
 		var params v1.Params
 		if len(matrixCombinations) > i {
 			params = matrixCombinations[i]
 		}
-		customRun, err := c.createCustomRun(ctx, customRunName, params, rpt, pr, facts)
+		customRun, err := c.createCustomRun(ctx, rpt.CustomRunNames[i], params, rpt, pr, facts)
 		if err != nil {
 			err := c.handleRunCreationError(pr, err)
 			return nil, err
@@ -1927,7 +2162,8 @@ func addMetadataByPrecedence(metadata map[string]string, addedMetadata map[strin
 	}
 }
 
-// syncMetadata persists label and annotation changes made during reconciliation.
+// syncMetadata persists label and annotation changes made during reconciliation, along
+// with the sensitive params whose values were moved to a Secret.
 // Knative's generated reconciler only calls UpdateStatus() after ReconcileKind returns,
 // so metadata changes must be persisted separately. This is called via defer in
 // ReconcileKind to ensure it runs on every exit path.
@@ -1944,13 +2180,16 @@ func (c *Reconciler) syncMetadata(ctx context.Context, pr *v1.PipelineRun) error
 	mergedLabels := kmap.Union(existing.Labels, pr.Labels)
 	mergedAnnotations := kmap.Union(existing.Annotations, pr.Annotations)
 
-	if maps.Equal(mergedLabels, existing.ObjectMeta.Labels) && maps.Equal(mergedAnnotations, existing.ObjectMeta.Annotations) {
+	params, paramsMoved := existing.Spec.Params.ReplaceMovedSensitiveParams(pr.Spec.Params)
+
+	if maps.Equal(mergedLabels, existing.ObjectMeta.Labels) && maps.Equal(mergedAnnotations, existing.ObjectMeta.Annotations) && !paramsMoved {
 		return nil
 	}
 
 	updated := existing.DeepCopy()
 	updated.Labels = mergedLabels
 	updated.Annotations = mergedAnnotations
+	updated.Spec.Params = params
 	_, err = c.PipelineClientSet.TektonV1().PipelineRuns(pr.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
 	return err
 }
@@ -1994,6 +2233,44 @@ func storePipelineSpecAndMergeMeta(ctx context.Context, pr *v1.PipelineRun, ps *
 	return nil
 }
 
+// attestProvenance stores the signed provenance attestation of the completed PipelineRun, covering
+// its TaskRuns, in a ConfigMap referenced by its status. PipelineRuns which ran with
+// enable-provenance-attestations set are attested once. A PipelineRun that cannot be signed for lack
+// of a usable signing key is not attested again: the failure is recorded in its status and reported
+// in a warning event.
+func (c *Reconciler) attestProvenance(ctx context.Context, pr *v1.PipelineRun) error {
+	if !attestation.ShouldAttest(ctx, pr.Status.Provenance) {
+		return nil
+	}
+	signer, err := attestation.LoadSigner(ctx, c.secretLister)
+	if err != nil {
+		pr.Status.Provenance.AttestationError = err.Error()
+		controller.GetEventRecorder(ctx).Event(pr, corev1.EventTypeWarning, attestation.ReasonFailed, err.Error())
+		return nil
+	}
+	var taskRuns []*v1.TaskRun
+	for _, cr := range pr.Status.ChildReferences {
+		if cr.Kind != taskRun {
+			continue
+		}
+		tr, err := c.taskRunLister.TaskRuns(pr.Namespace).Get(cr.Name)
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to get TaskRun %s: %w", cr.Name, err)
+		}
+		taskRuns = append(taskRuns, tr)
+	}
+	name, err := attestation.Attest(ctx, c.KubeClientSet, signer, attestation.GeneratePipelineRun(pr, taskRuns),
+		*kmeta.NewControllerRef(pr), pr.Namespace, map[string]string{pipeline.PipelineRunLabelKey: pr.Name})
+	if err != nil {
+		return err
+	}
+	pr.Status.Provenance.Attestation = name
+	return nil
+}
+
 func (c *Reconciler) updatePipelineRunStatusFromInformer(ctx context.Context, pr *v1.PipelineRun) error {
 	ctx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "updatePipelineRunStatusFromInformer")
 	defer span.End()
@@ -3,6 +3,7 @@ package pipelinerun
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/tektoncd/pipeline/test"
 	"github.com/tektoncd/pipeline/test/diff"
 	"github.com/tektoncd/pipeline/test/names"
+	"github.com/tektoncd/pipeline/test/parse"
 	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ktesting "k8s.io/client-go/testing"
 )
@@ -946,7 +949,7 @@ func TestCreateChildPipelineRun_WorkspaceResolutionError(t *testing.T) {
 		ResolvedPipeline: resources.ResolvedPipeline{PipelineSpec: &v1.PipelineSpec{}},
 	}
 
-	if _, err := c.createChildPipelineRun(ctx, "child-pr", rpt, pr, nil); err == nil {
+	if _, err := c.createChildPipelineRun(ctx, "child-pr", nil, rpt, pr, nil); err == nil {
 		t.Fatal("createChildPipelineRun() expected a workspace resolution error, got nil")
 	}
 }
@@ -1176,3 +1179,338 @@ func TestDetectPipelineRefCycle_ParentLookupError(t *testing.T) {
 		t.Fatalf("error message missing expected context: %v", err)
 	}
 }
+
+// TestReconcile_MatrixedChildPipelineRuns verifies that a matrixed PipelineTask referencing a
+// child Pipeline creates one child PipelineRun per Combination, with the params of the Combination.
+func TestReconcile_MatrixedChildPipelineRuns(t *testing.T) {
+	names.TestingSeed()
+	namespace := "foo"
+	parentPipelineRunName := "parent-pipeline-run"
+
+	parentPipeline := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: parent-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: deploy
+    params:
+    - name: version
+      value: v1
+    matrix:
+      params:
+      - name: region
+        value:
+        - us-east
+        - eu-west
+    pipelineRef:
+      name: deploy-pipeline
+`)
+	childPipeline := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: deploy-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: region
+  - name: version
+  tasks:
+  - name: deploy
+    taskRef:
+      name: deploy-task
+`)
+	parentPipelineRun := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: parent-pipeline-run
+  namespace: foo
+spec:
+  pipelineRef:
+    name: parent-pipeline
+`)
+
+	testData := test.Data{
+		PipelineRuns: []*v1.PipelineRun{parentPipelineRun},
+		Pipelines:    []*v1.Pipeline{parentPipeline, childPipeline},
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	}
+
+	reconciledRun, childPipelineRuns := reconcileOncePinP(
+		t,
+		testData,
+		namespace,
+		parentPipelineRunName,
+		[]string{"Normal Started", "Normal Running Tasks Completed: 0"},
+	)
+
+	th.VerifyChildPipelineRunStatusesNames(t, reconciledRun.Status, "parent-pipeline-run-deploy-0", "parent-pipeline-run-deploy-1")
+	validateChildPipelineRunCount(t, childPipelineRuns, 2)
+	for name, region := range map[string]string{
+		"parent-pipeline-run-deploy-0": "us-east",
+		"parent-pipeline-run-deploy-1": "eu-west",
+	} {
+		wantParams := v1.Params{{
+			Name: "region", Value: *v1.NewStructuredValues(region),
+		}, {
+			Name: "version", Value: *v1.NewStructuredValues("v1"),
+		}}
+		childPipelineRun := getChildPipelineRunByName(t, childPipelineRuns, name)
+		if d := cmp.Diff(wantParams, childPipelineRun.Spec.Params); d != "" {
+			t.Errorf("expected child PipelineRun %s params %s", name, diff.PrintWantGot(d))
+		}
+	}
+}
+
+// TestReconcile_MatrixedChildPipelineRunsMaxConcurrency verifies that the child PipelineRuns of a
+// matrixed PipelineTask are created within its maxConcurrency, the next one once an earlier one is done.
+func TestReconcile_MatrixedChildPipelineRunsMaxConcurrency(t *testing.T) {
+	names.TestingSeed()
+	namespace := "foo"
+	parentPipelineRunName := "parent-pipeline-run"
+
+	parentPipeline := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: parent-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: deploy
+    matrix:
+      maxConcurrency: 1
+      params:
+      - name: region
+        value:
+        - us-east
+        - eu-west
+    pipelineRef:
+      name: deploy-pipeline
+`)
+	childPipeline := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: deploy-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: region
+  tasks:
+  - name: deploy
+    taskRef:
+      name: deploy-task
+`)
+	newParentPipelineRun := func(childReferences string) *v1.PipelineRun {
+		return parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: parent-pipeline-run
+  namespace: foo
+  uid: parent-uid
+spec:
+  pipelineRef:
+    name: parent-pipeline
+`+childReferences)
+	}
+	firstChildPipelineRun := func(status string) *v1.PipelineRun {
+		return parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: parent-pipeline-run-deploy-0
+  namespace: foo
+  labels:
+    tekton.dev/pipelineRun: parent-pipeline-run
+    tekton.dev/pipelineTask: deploy
+  ownerReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: parent-pipeline-run
+    uid: parent-uid
+    controller: true
+spec:
+  params:
+  - name: region
+    value: us-east
+  pipelineRef:
+    name: deploy-pipeline
+status:
+  conditions:
+  - type: Succeeded
+    status: %q
+    reason: Running
+`, status))
+	}
+	startedWithFirstChild := `status:
+  startTime: "2022-01-01T00:00:00Z"
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: Running
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: parent-pipeline-run-deploy-0
+    pipelineTaskName: deploy
+`
+
+	for _, tc := range []struct {
+		name                  string
+		parentPipelineRun     *v1.PipelineRun
+		childPipelineRuns     []*v1.PipelineRun
+		wantChildPipelineRuns []string
+	}{{
+		name:                  "first window",
+		parentPipelineRun:     newParentPipelineRun(""),
+		wantChildPipelineRuns: []string{"parent-pipeline-run-deploy-0"},
+	}, {
+		name:                  "window full",
+		parentPipelineRun:     newParentPipelineRun(startedWithFirstChild),
+		childPipelineRuns:     []*v1.PipelineRun{firstChildPipelineRun("Unknown")},
+		wantChildPipelineRuns: []string{"parent-pipeline-run-deploy-0"},
+	}, {
+		name:                  "next combination starts when an earlier one is done",
+		parentPipelineRun:     newParentPipelineRun(startedWithFirstChild),
+		childPipelineRuns:     []*v1.PipelineRun{firstChildPipelineRun("True")},
+		wantChildPipelineRuns: []string{"parent-pipeline-run-deploy-0", "parent-pipeline-run-deploy-1"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			testData := test.Data{
+				PipelineRuns: append([]*v1.PipelineRun{tc.parentPipelineRun}, tc.childPipelineRuns...),
+				Pipelines:    []*v1.Pipeline{parentPipeline, childPipeline},
+				ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+			}
+			reconciledRun, childPipelineRuns := reconcileOncePinP(t, testData, namespace, parentPipelineRunName, nil)
+
+			if d := cmp.Diff(tc.wantChildPipelineRuns, sets.List(sets.KeySet(childPipelineRuns))); d != "" {
+				t.Errorf("unexpected child PipelineRuns %s", diff.PrintWantGot(d))
+			}
+			th.VerifyChildPipelineRunStatusesNames(t, reconciledRun.Status, tc.wantChildPipelineRuns...)
+			th.CheckPipelineRunConditionStatusAndReason(t, reconciledRun.Status, corev1.ConditionUnknown, v1.PipelineRunReasonRunning.String())
+		})
+	}
+}
+
+// TestReconcile_MatrixedChildPipelineRunsResults verifies that the results of the child PipelineRuns
+// of a matrixed PipelineTask are aggregated into arrays consumed by the next PipelineTask.
+func TestReconcile_MatrixedChildPipelineRunsResults(t *testing.T) {
+	names.TestingSeed()
+	namespace := "foo"
+	parentPipelineRunName := "parent-pipeline-run"
+
+	parentPipeline := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: parent-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: deploy
+    matrix:
+      params:
+      - name: region
+        value:
+        - us-east
+        - eu-west
+    pipelineRef:
+      name: deploy-pipeline
+  - name: report
+    params:
+    - name: urls
+      value: $(tasks.deploy.results.url[*])
+    taskRef:
+      name: report-task
+`)
+	childPipeline := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: deploy-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: region
+  tasks:
+  - name: deploy
+    taskRef:
+      name: deploy-task
+`)
+	reportTask := parse.MustParseV1Task(t, `
+metadata:
+  name: report-task
+  namespace: foo
+spec:
+  params:
+  - name: urls
+    type: array
+  steps:
+  - image: alpine
+    args: ["$(params.urls[*])"]
+`)
+	parentPipelineRun := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: parent-pipeline-run
+  namespace: foo
+  uid: parent-uid
+spec:
+  pipelineRef:
+    name: parent-pipeline
+status:
+  startTime: "2022-01-01T00:00:00Z"
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: Running
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: parent-pipeline-run-deploy-0
+    pipelineTaskName: deploy
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: parent-pipeline-run-deploy-1
+    pipelineTaskName: deploy
+`)
+	var childPipelineRuns []*v1.PipelineRun
+	for i, region := range []string{"us-east", "eu-west"} {
+		childPipelineRuns = append(childPipelineRuns, parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: parent-pipeline-run-deploy-%d
+  namespace: foo
+  labels:
+    tekton.dev/pipelineRun: parent-pipeline-run
+    tekton.dev/pipelineTask: deploy
+  ownerReferences:
+  - apiVersion: tekton.dev/v1
+    kind: PipelineRun
+    name: parent-pipeline-run
+    uid: parent-uid
+    controller: true
+spec:
+  params:
+  - name: region
+    value: %s
+  pipelineRef:
+    name: deploy-pipeline
+status:
+  conditions:
+  - type: Succeeded
+    status: "True"
+    reason: Succeeded
+  results:
+  - name: url
+    value: https://%s.example.com
+`, i, region, region)))
+	}
+
+	testData := test.Data{
+		PipelineRuns: append([]*v1.PipelineRun{parentPipelineRun}, childPipelineRuns...),
+		Pipelines:    []*v1.Pipeline{parentPipeline, childPipeline},
+		Tasks:        []*v1.Task{reportTask},
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	}
+	prt := newPipelineRunTest(t, testData)
+	defer prt.Cancel()
+
+	_, clients := prt.reconcileRun(namespace, parentPipelineRunName, []string{}, false)
+
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, namespace, parentPipelineRunName)
+	validateTaskRunsCount(t, taskRuns, 1)
+	reportTaskRun := getTaskRunByName(t, taskRuns, "parent-pipeline-run-report")
+	wantParams := v1.Params{{
+		Name: "urls", Value: *v1.NewStructuredValues("https://us-east.example.com", "https://eu-west.example.com"),
+	}}
+	if d := cmp.Diff(wantParams, reportTaskRun.Spec.Params); d != "" {
+		t.Errorf("expected TaskRun %s params %s", reportTaskRun.Name, diff.PrintWantGot(d))
+	}
+}
@@ -19,6 +19,7 @@ package pipelinerun
 import (
 	"bytes"
 	"context"
+	"crypto/elliptic"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	"github.com/sigstore/sigstore/pkg/signature"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
@@ -1507,6 +1509,129 @@ status:
 	checkTaskRunStatusFromChildRefs(prt.TestAssets.Ctx, t, "foo", clients, reconciledRun.Status.ChildReferences, expectedTaskRunsStatus)
 }
 
+func TestReconcileOnCompletedPipelineRun_ProvenanceAttestation(t *testing.T) {
+	pipelineRunName := "test-pipeline-run-completed"
+	taskRunName := "test-pipeline-run-completed-hello-world-task-run"
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: %s
+  namespace: foo
+  uid: test-pipeline-run-uid
+spec:
+  pipelineRef:
+    name: test-pipeline
+status:
+  conditions:
+  - message: All Tasks have completed executing
+    reason: Succeeded
+    status: "True"
+    type: Succeeded
+  childReferences:
+    - name: %s
+      pipelineTaskName: hello-world-1
+      kind: TaskRun
+      apiVersion: tekton.dev/v1
+  provenance:
+    featureFlags:
+      enableProvenanceAttestations: true
+`, pipelineRunName, taskRunName))}
+	trs := []*v1.TaskRun{createHelloWorldTaskRunWithStatus(t, taskRunName, "foo",
+		pipelineRunName, "test-pipeline", "",
+		apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionTrue,
+		})}
+	privPEM, _, err := cryptoutils.GeneratePEMEncodedECDSAKeyPair(elliptic.P256(), cryptoutils.SkipPassword)
+	if err != nil {
+		t.Fatalf("GeneratePEMEncodedECDSAKeyPair: %v", err)
+	}
+	signingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: "signing-secrets"},
+		Data:       map[string][]byte{"cosign.key": privPEM},
+	}
+	for _, tc := range []struct {
+		name            string
+		annotations     map[string]string
+		provenance      *v1.Provenance
+		secrets         []*corev1.Secret
+		wantAttestation string
+		wantError       bool
+		wantEvents      []string
+	}{{
+		name:            "completed",
+		secrets:         []*corev1.Secret{signingSecret},
+		wantAttestation: "test-pipeline-run-completed-provenance",
+	}, {
+		name:            "attestation annotation preset by the user",
+		annotations:     map[string]string{"attestation.tekton.dev/provenance": "forged"},
+		secrets:         []*corev1.Secret{signingSecret},
+		wantAttestation: "test-pipeline-run-completed-provenance",
+	}, {
+		name:       "completed before attestations were enabled",
+		provenance: &v1.Provenance{FeatureFlags: &config.FeatureFlags{}},
+		secrets:    []*corev1.Secret{signingSecret},
+	}, {
+		name: "already attested",
+		provenance: &v1.Provenance{
+			FeatureFlags: &config.FeatureFlags{EnableProvenanceAttestations: true},
+			Attestation:  "existing-provenance",
+		},
+		secrets:         []*corev1.Secret{signingSecret},
+		wantAttestation: "existing-provenance",
+	}, {
+		name:       "signing secret missing",
+		wantError:  true,
+		wantEvents: []string{"Warning ProvenanceAttestationFailed failed to get the provenance signing Secret signing-secrets: secret \"signing-secrets\" not found"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := prs[0].DeepCopy()
+			pr.Annotations = tc.annotations
+			if tc.provenance != nil {
+				pr.Status.Provenance = tc.provenance
+			}
+			d := test.Data{
+				PipelineRuns: []*v1.PipelineRun{pr},
+				Pipelines:    []*v1.Pipeline{simpleHelloWorldPipeline},
+				Tasks:        []*v1.Task{simpleHelloWorldTask},
+				TaskRuns:     trs,
+				ConfigMaps: []*corev1.ConfigMap{{
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetFeatureFlagsConfigName()},
+					Data:       map[string]string{"enable-provenance-attestations": "true"},
+				}, {
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetDefaultsConfigName()},
+					Data:       map[string]string{"default-provenance-signing-secret": "signing-secrets"},
+				}},
+				Secrets: tc.secrets,
+			}
+			prt := newPipelineRunTest(t, d)
+			defer prt.Cancel()
+
+			reconciledRun, clients := prt.reconcileRun("foo", pipelineRunName, tc.wantEvents, false)
+
+			if reconciledRun.Status.Provenance.Attestation != tc.wantAttestation {
+				t.Errorf("Expected the PipelineRun to reference the provenance attestation %q, got %q", tc.wantAttestation, reconciledRun.Status.Provenance.Attestation)
+			}
+			if (reconciledRun.Status.Provenance.AttestationError != "") != tc.wantError {
+				t.Errorf("Expected attestation error %t, got %q", tc.wantError, reconciledRun.Status.Provenance.AttestationError)
+			}
+			if tc.wantAttestation != "test-pipeline-run-completed-provenance" {
+				if cms, err := clients.Kube.CoreV1().ConfigMaps("foo").List(prt.TestAssets.Ctx, metav1.ListOptions{}); err != nil || len(cms.Items) != 0 {
+					t.Errorf("Expected no provenance attestation to be created, got %v, %v", cms, err)
+				}
+				return
+			}
+			cmName := reconciledRun.Status.Provenance.Attestation
+			cm, err := clients.Kube.CoreV1().ConfigMaps("foo").Get(prt.TestAssets.Ctx, cmName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Expected the ConfigMap %s of the provenance attestation to exist: %v", cmName, err)
+			}
+			if !strings.Contains(cm.Data["attestation.json"], `"payloadType":"application/vnd.in-toto+json"`) {
+				t.Errorf("Expected the ConfigMap to hold an in-toto envelope, got %v", cm.Data)
+			}
+		})
+	}
+}
+
 // TestReconcileOnCancelledPipelineRun runs "Reconcile" on a PipelineRun that
 // has been cancelled.  It verifies that reconcile is successful, the pipeline
 // status updated and events generated.
@@ -4272,6 +4397,117 @@ spec:
 
 // TestReconcileTaskRunSpecTimeout tests that timeout specified in taskRunSpecs
 // takes precedence over pipeline task timeout
+func TestReconcileWithTimeouts_Queue(t *testing.T) {
+	// TestReconcileWithTimeouts_Queue runs "Reconcile" on a PipelineRun that has a queue timeout and whose
+	// TaskRun Steps did not start within it, although its pipeline timeout was not exceeded.
+	ps := []*v1.Pipeline{simpleHelloWorldPipeline}
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run-with-queue-timeout
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+  taskRunTemplate:
+    serviceAccountName: test-sa
+  timeouts:
+    pipeline: 12h0m0s
+    queue: 10m0s
+status:
+  startTime: "2021-12-31T23:00:00Z"
+  childReferences:
+  - name: test-pipeline-run-with-queue-timeout-hello-world-1
+    pipelineTaskName: hello-world-1
+    kind: TaskRun
+`)}
+	ts := []*v1.Task{simpleHelloWorldTask}
+
+	trs := []*v1.TaskRun{parse.MustParseTaskRunWithObjectMeta(t, taskRunObjectMeta("test-pipeline-run-with-queue-timeout-hello-world-1", "foo", "test-pipeline-run-with-queue-timeout",
+		"test-pipeline", "hello-world-1", false), `
+spec:
+  serviceAccountName: test-sa
+  taskRef:
+    name: hello-world
+    kind: Task
+  timeouts:
+    queue: 10m0s
+status:
+  conditions:
+  - status: Unknown
+    type: Succeeded
+  startTime: "2021-12-31T23:00:00Z"
+`)}
+
+	d := test.Data{
+		PipelineRuns: prs,
+		Pipelines:    ps,
+		Tasks:        ts,
+		TaskRuns:     trs,
+	}
+	prt := newPipelineRunTest(t, d)
+	defer prt.Cancel()
+
+	wantEvents := []string{
+		"Warning Failed PipelineRun \"test-pipeline-run-with-queue-timeout\" did not start executing within the queue timeout \"10m0s\"",
+	}
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run-with-queue-timeout", wantEvents, false)
+
+	if reconciledRun.Status.CompletionTime == nil {
+		t.Errorf("Expected a CompletionTime on queue timed out PipelineRun but was nil")
+	}
+	if reconciledRun.Status.GetCondition(apis.ConditionSucceeded).Reason != v1.PipelineRunReasonQueueTimedOut.String() {
+		t.Errorf("Expected PipelineRun to be queue timed out, but condition reason is %s", reconciledRun.Status.GetCondition(apis.ConditionSucceeded))
+	}
+
+	updatedTaskRun, err := clients.Pipeline.TektonV1().TaskRuns("foo").Get(t.Context(), trs[0].Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting updated TaskRun: %#v", err)
+	}
+	if updatedTaskRun.Spec.Status != v1.TaskRunSpecStatusCancelled {
+		t.Errorf("expected existing TaskRun Spec.Status to be set to %s, but was %s", v1.TaskRunSpecStatusCancelled, updatedTaskRun.Spec.Status)
+	}
+}
+
+func TestReconcileQueueTimeoutPropagatedToTaskRun(t *testing.T) {
+	names.TestingSeed()
+
+	namespace := "foo"
+	prName := "test-pipeline-run"
+	trName := "test-pipeline-run-hello-world-1"
+
+	ps := []*v1.Pipeline{simpleHelloWorldPipeline}
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+  timeouts:
+    queue: 5m0s
+`)}
+	ts := []*v1.Task{simpleHelloWorldTask}
+
+	d := test.Data{
+		PipelineRuns: prs,
+		Pipelines:    ps,
+		Tasks:        ts,
+	}
+	prt := newPipelineRunTest(t, d)
+	defer prt.Cancel()
+
+	_, clients := prt.reconcileRun("foo", prName, []string{}, false)
+
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, namespace, prName)
+	validateTaskRunsCount(t, taskRuns, 1)
+
+	actual := getTaskRunByName(t, taskRuns, trName)
+	expected := &v1.TaskRunTimeouts{Queue: &metav1.Duration{Duration: 5 * time.Minute}}
+	if d := cmp.Diff(expected, actual.Spec.Timeouts); d != "" {
+		t.Errorf("expected TaskRun queue timeout to be propagated %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestReconcileTaskRunSpecTimeout(t *testing.T) {
 	names.TestingSeed()
 
@@ -19855,3 +20091,396 @@ status:
 		t.Errorf("Expected PipelineRun to be marked Failed for generic failed TaskRun, got status %s reason %s", condition.Status, condition.Reason)
 	}
 }
+
+func TestReconcileWithTaskCache(t *testing.T) {
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: revision
+    type: string
+  results:
+  - name: coverage
+    value: $(tasks.unit-tests.results.coverage)
+  tasks:
+  - name: unit-tests
+    cache: {}
+    params:
+    - name: revision
+      value: $(params.revision)
+    taskRef:
+      name: unit-tests
+`)}
+	ts := []*v1.Task{parse.MustParseV1Task(t, `
+metadata:
+  name: unit-tests
+  namespace: foo
+spec:
+  params:
+  - name: revision
+    type: string
+  results:
+  - name: coverage
+  steps:
+  - name: test
+    image: golang
+    script: go test ./...
+`)}
+	newPipelineRun := func(name string) *v1.PipelineRun {
+		return parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: %s
+  namespace: foo
+spec:
+  params:
+  - name: revision
+    value: abc123
+  pipelineRef:
+    name: test-pipeline
+`, name))
+	}
+
+	// The first PipelineRun does not find a cached TaskRun and creates one labeled with its cache key
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: []*v1.PipelineRun{newPipelineRun("test-pipeline-run-cache-miss")},
+		Pipelines:    ps,
+		Tasks:        ts,
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run-cache-miss", []string{"Normal Started", "Normal Running Tasks Completed: 0"}, false)
+
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run-cache-miss")
+	validateTaskRunsCount(t, taskRuns, 1)
+	cachedTaskRun := getTaskRunByName(t, taskRuns, "test-pipeline-run-cache-miss-unit-tests")
+	cacheKey := cachedTaskRun.Labels[pipeline.CacheKeyLabelKey]
+	if cacheKey == "" {
+		t.Fatalf("expected TaskRun %s to be labeled with %s", cachedTaskRun.Name, pipeline.CacheKeyLabelKey)
+	}
+	if len(reconciledRun.Status.CachedTasks) != 0 {
+		t.Errorf("expected no cached tasks, got %v", reconciledRun.Status.CachedTasks)
+	}
+
+	// The second PipelineRun reuses the TaskRun of the first one once it succeeded
+	cachedTaskRun.Status = v1.TaskRunStatus{
+		Status: duckv1.Status{Conditions: duckv1.Conditions{{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionTrue,
+			Reason: v1.TaskRunReasonSuccessful.String(),
+		}}},
+		TaskRunStatusFields: v1.TaskRunStatusFields{
+			StartTime:      &metav1.Time{Time: now.Add(-time.Minute)},
+			CompletionTime: &metav1.Time{Time: now},
+			Results: []v1.TaskRunResult{{
+				Name:  "coverage",
+				Type:  v1.ResultsTypeString,
+				Value: *v1.NewStructuredValues("87%"),
+			}},
+		},
+	}
+	prt = newPipelineRunTest(t, test.Data{
+		PipelineRuns: []*v1.PipelineRun{newPipelineRun("test-pipeline-run-cache-hit")},
+		Pipelines:    ps,
+		Tasks:        ts,
+		TaskRuns:     []*v1.TaskRun{cachedTaskRun},
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients = prt.reconcileRun("foo", "test-pipeline-run-cache-hit", []string{"Normal Started", "Normal Succeeded Tasks Completed: 1 (Failed: 0, Cancelled 0), Skipped: 0"}, false)
+
+	validateTaskRunsCount(t, getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run-cache-hit"), 0)
+	wantChildRefs := []v1.ChildStatusReference{{
+		TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+		Name:             "test-pipeline-run-cache-miss-unit-tests",
+		PipelineTaskName: "unit-tests",
+		Cached:           true,
+	}}
+	if d := cmp.Diff(wantChildRefs, reconciledRun.Status.ChildReferences); d != "" {
+		t.Errorf("unexpected child references %s", diff.PrintWantGot(d))
+	}
+	wantCachedTasks := []v1.CachedTask{{
+		Name:        "unit-tests",
+		TaskRunName: "test-pipeline-run-cache-miss-unit-tests",
+		CacheKey:    cacheKey,
+	}}
+	if d := cmp.Diff(wantCachedTasks, reconciledRun.Status.CachedTasks); d != "" {
+		t.Errorf("unexpected cached tasks %s", diff.PrintWantGot(d))
+	}
+	wantResults := []v1.PipelineRunResult{{
+		Name:  "coverage",
+		Value: *v1.NewStructuredValues("87%"),
+	}}
+	if d := cmp.Diff(wantResults, reconciledRun.Status.Results); d != "" {
+		t.Errorf("unexpected pipeline results %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestReconcileWithPausedPipelineRun(t *testing.T) {
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: build
+    taskRef:
+      name: hello-world
+  - name: deploy
+    runAfter:
+    - build
+    taskRef:
+      name: hello-world
+`)}
+	trs := []*v1.TaskRun{parse.MustParseTaskRunWithObjectMeta(t,
+		taskRunObjectMeta("test-pipeline-run-paused-build", "foo", "test-pipeline-run-paused", "test-pipeline", "build", false), `
+spec:
+  taskRef:
+    name: hello-world
+status:
+  conditions:
+  - type: Succeeded
+    status: "True"
+    reason: Succeeded
+`)}
+	newPipelineRun := func(specStatus string, pausedTime *metav1.Time) *v1.PipelineRun {
+		pr := parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: test-pipeline-run-paused
+  namespace: foo
+spec:
+  status: %q
+  pipelineRef:
+    name: test-pipeline
+status:
+  startTime: "2021-12-31T23:00:00Z"
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: Running
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: test-pipeline-run-paused-build
+    pipelineTaskName: build
+`, specStatus))
+		pr.Status.PausedTime = pausedTime
+		return pr
+	}
+
+	// A paused PipelineRun doesn't schedule the next task
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: []*v1.PipelineRun{newPipelineRun(v1.PipelineRunSpecStatusPaused, nil)},
+		Pipelines:    ps,
+		Tasks:        []*v1.Task{simpleHelloWorldTask},
+		TaskRuns:     trs,
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run-paused", []string{"Normal PipelineRunPaused"}, false)
+
+	validateTaskRunsCount(t, getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run-paused"), 1)
+	th.CheckPipelineRunConditionStatusAndReason(t, reconciledRun.Status, corev1.ConditionUnknown, v1.PipelineRunReasonPaused.String())
+	if d := cmp.Diff(&metav1.Time{Time: now}, reconciledRun.Status.PausedTime); d != "" {
+		t.Errorf("unexpected paused time %s", diff.PrintWantGot(d))
+	}
+
+	// Once resumed, the PipelineRun schedules the next task and records how long it was paused
+	prt = newPipelineRunTest(t, test.Data{
+		PipelineRuns: []*v1.PipelineRun{newPipelineRun("", &metav1.Time{Time: now.Add(-10 * time.Minute)})},
+		Pipelines:    ps,
+		Tasks:        []*v1.Task{simpleHelloWorldTask},
+		TaskRuns:     trs,
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients = prt.reconcileRun("foo", "test-pipeline-run-paused", []string{"Normal Running"}, false)
+
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run-paused")
+	validateTaskRunsCount(t, taskRuns, 2)
+	getTaskRunByName(t, taskRuns, "test-pipeline-run-paused-deploy")
+	th.CheckPipelineRunConditionStatusAndReason(t, reconciledRun.Status, corev1.ConditionUnknown, v1.PipelineRunReasonRunning.String())
+	if reconciledRun.Status.PausedTime != nil {
+		t.Errorf("expected no paused time, got %v", reconciledRun.Status.PausedTime)
+	}
+	if d := cmp.Diff(&metav1.Duration{Duration: 10 * time.Minute}, reconciledRun.Status.PausedDuration); d != "" {
+		t.Errorf("unexpected paused duration %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestReconcileWithRunAfterTriggers(t *testing.T) {
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  tasks:
+  - name: deploy
+    taskRef:
+      name: hello-world
+  - name: verify
+    runAfter:
+    - deploy
+    taskRef:
+      name: hello-world
+  - name: rollback
+    runAfter:
+    - deploy
+    runAfterTriggers:
+    - task: deploy
+      condition: onFailure
+    taskRef:
+      name: hello-world
+`)}
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run-triggers
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+status:
+  startTime: "2021-12-31T23:55:00Z"
+  conditions:
+  - type: Succeeded
+    status: Unknown
+    reason: Running
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: test-pipeline-run-triggers-deploy
+    pipelineTaskName: deploy
+`)}
+	trs := []*v1.TaskRun{parse.MustParseTaskRunWithObjectMeta(t,
+		taskRunObjectMeta("test-pipeline-run-triggers-deploy", "foo", "test-pipeline-run-triggers", "test-pipeline", "deploy", false), `
+spec:
+  taskRef:
+    name: hello-world
+status:
+  conditions:
+  - type: Succeeded
+    status: "False"
+    reason: Failed
+`)}
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: prs,
+		Pipelines:    ps,
+		Tasks:        []*v1.Task{simpleHelloWorldTask},
+		TaskRuns:     trs,
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run-triggers", []string{}, false)
+
+	// The failure of deploy triggers rollback, while verify is skipped
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run-triggers")
+	validateTaskRunsCount(t, taskRuns, 2)
+	getTaskRunByName(t, taskRuns, "test-pipeline-run-triggers-rollback")
+	th.CheckPipelineRunConditionStatusAndReason(t, reconciledRun.Status, corev1.ConditionUnknown, v1.PipelineRunReasonStopping.String())
+	wantSkippedTasks := []v1.SkippedTask{{
+		Name:   "verify",
+		Reason: v1.StoppingSkip,
+	}}
+	if d := cmp.Diff(wantSkippedTasks, reconciledRun.Status.SkippedTasks); d != "" {
+		t.Errorf("unexpected skipped tasks %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestReconcileWithPipelineImports(t *testing.T) {
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: build-and-scan
+  namespace: foo
+spec:
+  params:
+  - name: image
+  tasks:
+  - name: build
+    taskRef:
+      name: hello-world
+    params:
+    - name: image
+      value: $(params.image)
+  - name: scan
+    runAfter:
+    - build
+    taskRef:
+      name: hello-world
+`)}
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run-imports
+  namespace: foo
+spec:
+  pipelineSpec:
+    imports:
+    - name: ci
+      pipelineRef:
+        name: build-and-scan
+      params:
+      - name: image
+        value: registry/app
+    tasks:
+    - name: publish
+      runAfter:
+      - ci-scan
+      taskRef:
+        name: hello-world
+`)}
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: prs,
+		Pipelines:    ps,
+		Tasks:        []*v1.Task{simpleHelloWorldTask},
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "test-pipeline-run-imports", []string{}, false)
+
+	// Only the first imported task is started, the others run after it
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "test-pipeline-run-imports")
+	validateTaskRunsCount(t, taskRuns, 1)
+	tr := getTaskRunByName(t, taskRuns, "test-pipeline-run-imports-ci-build")
+	wantParams := v1.Params{{Name: "image", Value: *v1.NewStructuredValues("registry/app")}}
+	if d := cmp.Diff(wantParams, tr.Spec.Params); d != "" {
+		t.Errorf("unexpected TaskRun params %s", diff.PrintWantGot(d))
+	}
+
+	// The expanded PipelineSpec is stored in the status
+	var names []string
+	for _, pt := range reconciledRun.Status.PipelineSpec.Tasks {
+		names = append(names, pt.Name)
+	}
+	if d := cmp.Diff([]string{"publish", "ci-build", "ci-scan"}, names); d != "" {
+		t.Errorf("unexpected PipelineTasks in the status %s", diff.PrintWantGot(d))
+	}
+	if len(reconciledRun.Status.PipelineSpec.Imports) != 0 {
+		t.Errorf("expected the imports to be expanded, got %v", reconciledRun.Status.PipelineSpec.Imports)
+	}
+}
+
+func TestReconcileWithPipelineImports_Missing(t *testing.T) {
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: test-pipeline-run-imports
+  namespace: foo
+spec:
+  pipelineSpec:
+    imports:
+    - name: ci
+      pipelineRef:
+        name: build-and-scan
+`)}
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: prs,
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	wantEvents := []string{
+		"Normal Started",
+		`Warning Failed Error importing pipelines for pipelinerun foo/test-pipeline-run-imports: failed to get the Pipeline imported as "ci"`,
+		"Warning InternalError",
+	}
+	reconciledRun, _ := prt.reconcileRun("foo", "test-pipeline-run-imports", wantEvents, true)
+	th.CheckPipelineRunConditionStatusAndReason(t, reconciledRun.Status, corev1.ConditionFalse, v1.PipelineRunReasonCouldntGetPipeline.String())
+}
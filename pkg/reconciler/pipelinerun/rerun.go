@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+)
+
+// carryOverRerunOf seeds the status of a PipelineRun rerunning the PipelineRun referenced by
+// rerunOf, before any of its runs is created. The runs of the PipelineTasks which succeeded in
+// the previous PipelineRun are added to its child references, and the PipelineTasks skipped
+// because of their when expressions are recorded to be skipped again, unless they are
+// downstream of a PipelineTask which has to run again.
+func (c *Reconciler) carryOverRerunOf(ctx context.Context, pr *v1.PipelineRun, tasks []v1.PipelineTask, d *dag.Graph) error {
+	logger := logging.FromContext(ctx)
+	previous, err := c.pipelineRunLister.PipelineRuns(pr.Namespace).Get(pr.Spec.RerunOf.Name)
+	switch {
+	case kerrors.IsNotFound(err):
+		pr.Status.MarkFailed(v1.PipelineRunReasonCouldntGetRerunOf.String(),
+			"PipelineRun %s/%s can't rerun PipelineRun %s: %s", pr.Namespace, pr.Name, pr.Spec.RerunOf.Name, err)
+		return controller.NewPermanentError(err)
+	case err != nil:
+		return fmt.Errorf("failed to get PipelineRun %s to rerun: %w", pr.Spec.RerunOf.Name, err)
+	case !previous.IsDone():
+		err := fmt.Errorf("PipelineRun %s is not done", previous.Name)
+		pr.Status.MarkFailed(v1.PipelineRunReasonCouldntGetRerunOf.String(),
+			"PipelineRun %s/%s can't rerun PipelineRun %s: %s", pr.Namespace, pr.Name, previous.Name, err)
+		return controller.NewPermanentError(err)
+	}
+
+	// The results of the previous runs can only be reused if they ran the same Pipeline with the same params
+	previousDigest, err := c.rerunDigest(ctx, previous)
+	if err != nil {
+		return fmt.Errorf("failed to compute the digest of PipelineRun %s to rerun: %w", previous.Name, err)
+	}
+	digest, err := c.rerunDigest(ctx, pr)
+	if err != nil {
+		return fmt.Errorf("failed to compute the digest of PipelineRun %s: %w", pr.Name, err)
+	}
+	if digest != previousDigest {
+		err := fmt.Errorf("the Pipeline spec or the params of PipelineRun %s differ", previous.Name)
+		pr.Status.MarkFailed(v1.PipelineRunReasonCouldntGetRerunOf.String(),
+			"PipelineRun %s/%s can't rerun PipelineRun %s: %s", pr.Namespace, pr.Name, previous.Name, err)
+		return controller.NewPermanentError(err)
+	}
+
+	succeeded := map[string][]v1.ChildStatusReference{}
+	failed := sets.Set[string]{}
+	for _, cr := range previous.Status.ChildReferences {
+		ok, err := c.isChildSuccessful(pr.Namespace, cr)
+		if err != nil {
+			return err
+		}
+		if ok {
+			succeeded[cr.PipelineTaskName] = append(succeeded[cr.PipelineTaskName], cr)
+		} else {
+			failed.Insert(cr.PipelineTaskName)
+		}
+	}
+	skipped := sets.Set[string]{}
+	for _, st := range previous.Status.SkippedTasks {
+		if st.Reason == v1.WhenExpressionsSkip {
+			skipped.Insert(st.Name)
+		}
+	}
+
+	// Run again the PipelineTasks which did not succeed, and all the PipelineTasks downstream of them
+	var rerun []string
+	for _, pt := range tasks {
+		if _, ok := succeeded[pt.Name]; (ok && !failed.Has(pt.Name)) || skipped.Has(pt.Name) {
+			continue
+		}
+		rerun = append(rerun, pt.Name)
+	}
+	downstream := sets.Set[string]{}
+	for len(rerun) > 0 {
+		name := rerun[0]
+		rerun = rerun[1:]
+		if downstream.Has(name) {
+			continue
+		}
+		downstream.Insert(name)
+		if node, ok := d.Nodes[name]; ok {
+			for _, next := range node.Next {
+				rerun = append(rerun, next.Key)
+			}
+		}
+	}
+
+	// The carried over runs are adopted before the status references them, so that an adoption
+	// failing is retried on the next reconcile.
+	for _, pt := range tasks {
+		if downstream.Has(pt.Name) || skipped.Has(pt.Name) {
+			continue
+		}
+		for _, cr := range succeeded[pt.Name] {
+			if err := c.adoptCarriedOverRun(ctx, pr, cr); err != nil {
+				return err
+			}
+		}
+	}
+	for _, pt := range tasks {
+		switch {
+		case downstream.Has(pt.Name):
+			continue
+		case skipped.Has(pt.Name):
+			pr.Status.CarriedOverTasks = append(pr.Status.CarriedOverTasks, v1.CarriedOverTask{
+				Name:            pt.Name,
+				PipelineRunName: previous.Name,
+				Skipped:         true,
+			})
+		default:
+			for _, cr := range succeeded[pt.Name] {
+				cr.Cached = false
+				cr.CarriedOver = true
+				pr.Status.ChildReferences = append(pr.Status.ChildReferences, cr)
+			}
+			pr.Status.CarriedOverTasks = append(pr.Status.CarriedOverTasks, v1.CarriedOverTask{
+				Name:            pt.Name,
+				PipelineRunName: previous.Name,
+			})
+		}
+	}
+	logger.Infof("PipelineRun %s carries over %d tasks from PipelineRun %s", pr.Name, len(pr.Status.CarriedOverTasks), previous.Name)
+	return nil
+}
+
+// adoptCarriedOverRun adds the PipelineRun to the owners of a run carried over from the previous
+// PipelineRun, so that the run is not garbage collected along with the previous PipelineRun while
+// the PipelineRun still references it. The previous PipelineRun remains its controller.
+func (c *Reconciler) adoptCarriedOverRun(ctx context.Context, pr *v1.PipelineRun, cr v1.ChildStatusReference) error {
+	var run metav1.Object
+	var err error
+	switch cr.Kind {
+	case pipeline.TaskRunControllerName:
+		run, err = c.taskRunLister.TaskRuns(pr.Namespace).Get(cr.Name)
+	case pipeline.CustomRunControllerName:
+		run, err = c.customRunLister.CustomRuns(pr.Namespace).Get(cr.Name)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get %s %s: %w", cr.Kind, cr.Name, err)
+	}
+	owners := run.GetOwnerReferences()
+	if slices.ContainsFunc(owners, func(o metav1.OwnerReference) bool { return o.UID == pr.UID }) {
+		return nil
+	}
+	owner := *kmeta.NewControllerRef(pr)
+	owner.Controller = nil
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"ownerReferences": append(slices.Clone(owners), owner),
+			"resourceVersion": run.GetResourceVersion(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if cr.Kind == pipeline.TaskRunControllerName {
+		_, err = c.PipelineClientSet.TektonV1().TaskRuns(pr.Namespace).Patch(ctx, cr.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	} else {
+		_, err = c.PipelineClientSet.TektonV1beta1().CustomRuns(pr.Namespace).Patch(ctx, cr.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add PipelineRun %s to the owners of %s %s: %w", pr.Name, cr.Kind, cr.Name, err)
+	}
+	return nil
+}
+
+// isChildSuccessful returns true if the TaskRun or CustomRun referenced by the child reference
+// of a previous PipelineRun exists and succeeded. Child PipelineRuns are never carried over.
+func (c *Reconciler) isChildSuccessful(namespace string, cr v1.ChildStatusReference) (bool, error) {
+	switch cr.Kind {
+	case pipeline.TaskRunControllerName:
+		tr, err := c.taskRunLister.TaskRuns(namespace).Get(cr.Name)
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("failed to get TaskRun %s: %w", cr.Name, err)
+		}
+		return tr.IsSuccessful(), nil
+	case pipeline.CustomRunControllerName:
+		run, err := c.customRunLister.CustomRuns(namespace).Get(cr.Name)
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("failed to get CustomRun %s: %w", cr.Name, err)
+		}
+		return run.IsSuccessful(), nil
+	default:
+		return false, nil
+	}
+}
+
+// rerunDigest returns a digest of the resolved Pipeline spec and the params of a PipelineRun.
+// The values of the sensitive params are read from the Secrets they were moved to, since each
+// PipelineRun has its own Secret.
+func (c *Reconciler) rerunDigest(ctx context.Context, pr *v1.PipelineRun) (string, error) {
+	params := make(map[string]v1.ParamValue, len(pr.Spec.Params))
+	for _, p := range pr.Spec.Params {
+		if p.ValueFrom == nil || p.ValueFrom.SecretKeyRef == nil {
+			params[p.Name] = p.Value
+			continue
+		}
+		ref := p.ValueFrom.SecretKeyRef
+		secret, err := c.secretLister.Secrets(pr.Namespace).Get(ref.Name)
+		if kerrors.IsNotFound(err) {
+			// The Secret of the PipelineRun being reconciled may have just been created by MoveToSecret,
+			// before the informer observed it.
+			secret, err = c.KubeClientSet.CoreV1().Secrets(pr.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		}
+		if err != nil {
+			return "", err
+		}
+		params[p.Name] = *v1.NewStructuredValues(string(secret.Data[ref.Key]))
+	}
+	b, err := json.Marshal(struct {
+		PipelineSpec *v1.PipelineSpec         `json:"pipelineSpec"`
+		Params       map[string]v1.ParamValue `json:"params"`
+	}{pr.Status.PipelineSpec, params})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
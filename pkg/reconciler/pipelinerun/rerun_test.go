@@ -0,0 +1,438 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	th "github.com/tektoncd/pipeline/pkg/reconciler/testing"
+	"github.com/tektoncd/pipeline/test"
+	"github.com/tektoncd/pipeline/test/diff"
+	"github.com/tektoncd/pipeline/test/parse"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+)
+
+func TestReconcileWithRerunOf(t *testing.T) {
+	ps := []*v1.Pipeline{parse.MustParseV1Pipeline(t, `
+metadata:
+  name: test-pipeline
+  namespace: foo
+spec:
+  params:
+  - name: environment
+    type: string
+  tasks:
+  - name: build
+    taskRef:
+      name: hello-world
+      kind: Task
+  - name: test
+    taskRef:
+      name: hello-world
+      kind: Task
+  - name: deploy
+    runAfter:
+    - test
+    taskRef:
+      name: hello-world
+      kind: Task
+  - name: notify
+    when:
+    - input: "false"
+      operator: in
+      values: ["true"]
+    taskRef:
+      name: hello-world
+      kind: Task
+`)}
+	ts := []*v1.Task{simpleHelloWorldTask}
+	trs := []*v1.TaskRun{
+		parse.MustParseTaskRunWithObjectMeta(t, taskRunObjectMeta("previous-build", "foo", "previous", "test-pipeline", "build", false), `
+spec:
+  taskRef:
+    name: hello-world
+status:
+  conditions:
+  - type: Succeeded
+    status: "True"
+    reason: Succeeded
+  results:
+  - name: image
+    type: string
+    value: registry/app@sha256:abc
+`),
+		parse.MustParseTaskRunWithObjectMeta(t, taskRunObjectMeta("previous-test", "foo", "previous", "test-pipeline", "test", false), `
+spec:
+  taskRef:
+    name: hello-world
+status:
+  conditions:
+  - type: Succeeded
+    status: "False"
+    reason: Failed
+`),
+	}
+	previous := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: previous
+  namespace: foo
+spec:
+  pipelineRef:
+    name: test-pipeline
+status:
+  conditions:
+  - type: Succeeded
+    status: "False"
+    reason: Failed
+  childReferences:
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: previous-build
+    pipelineTaskName: build
+  - apiVersion: tekton.dev/v1
+    kind: TaskRun
+    name: previous-test
+    pipelineTaskName: test
+  skippedTasks:
+  - name: deploy
+    reason: PipelineRun was stopping
+  - name: notify
+    reason: When Expressions evaluated to false
+`)
+	previous.Status.PipelineSpec = &ps[0].Spec
+	previous.Spec.Params = v1.Params{{Name: "environment", Value: *v1.NewStructuredValues("production")}}
+	newRerun := func(environment string) *v1.PipelineRun {
+		return parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: rerun
+  namespace: foo
+  uid: rerun-uid
+spec:
+  rerunOf:
+    name: previous
+  params:
+  - name: environment
+    value: %s
+  pipelineRef:
+    name: test-pipeline
+`, environment))
+	}
+	rerun := newRerun("production")
+
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: []*v1.PipelineRun{previous, rerun},
+		Pipelines:    ps,
+		Tasks:        ts,
+		TaskRuns:     trs,
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "rerun", []string{"Normal Started", "Normal Running Tasks Completed: 1 (Failed: 0, Cancelled 0), Incomplete: 2, Skipped: 1"}, false)
+
+	// Only the failed PipelineTask runs again, the PipelineTask downstream of it is pending
+	taskRuns := getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "rerun")
+	validateTaskRunsCount(t, taskRuns, 1)
+	getTaskRunByName(t, taskRuns, "rerun-test")
+
+	wantCarriedOverRef := v1.ChildStatusReference{
+		TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+		Name:             "previous-build",
+		PipelineTaskName: "build",
+		CarriedOver:      true,
+	}
+	if d := cmp.Diff(wantCarriedOverRef, reconciledRun.Status.ChildReferences[0]); d != "" {
+		t.Errorf("unexpected carried over child reference %s", diff.PrintWantGot(d))
+	}
+	// The carried over TaskRun is also owned by the rerun, so that it is not deleted along with the previous PipelineRun
+	carriedOver, err := clients.Pipeline.TektonV1().TaskRuns("foo").Get(prt.TestAssets.Ctx, "previous-build", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the carried over TaskRun: %v", err)
+	}
+	wantOwners := []metav1.OwnerReference{
+		carriedOver.OwnerReferences[0],
+		{APIVersion: "tekton.dev/v1", Kind: "PipelineRun", Name: "rerun", UID: "rerun-uid", BlockOwnerDeletion: ptr.To(true)},
+	}
+	if d := cmp.Diff(wantOwners, carriedOver.OwnerReferences); d != "" {
+		t.Errorf("unexpected owners of the carried over TaskRun %s", diff.PrintWantGot(d))
+	}
+	wantCarriedOverTasks := []v1.CarriedOverTask{{
+		Name:            "build",
+		PipelineRunName: "previous",
+	}, {
+		Name:            "notify",
+		PipelineRunName: "previous",
+		Skipped:         true,
+	}}
+	if d := cmp.Diff(wantCarriedOverTasks, reconciledRun.Status.CarriedOverTasks); d != "" {
+		t.Errorf("unexpected carried over tasks %s", diff.PrintWantGot(d))
+	}
+	wantSkippedTasks := []v1.SkippedTask{{
+		Name:   "notify",
+		Reason: v1.WhenExpressionsSkip,
+		WhenExpressions: v1.WhenExpressions{{
+			Input:    "false",
+			Operator: "in",
+			Values:   []string{"true"},
+		}},
+	}}
+	if d := cmp.Diff(wantSkippedTasks, reconciledRun.Status.SkippedTasks); d != "" {
+		t.Errorf("unexpected skipped tasks %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestReconcileWithRerunOfNotFound(t *testing.T) {
+	prs := []*v1.PipelineRun{parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: rerun
+  namespace: foo
+spec:
+  rerunOf:
+    name: missing
+  pipelineSpec:
+    tasks:
+    - name: build
+      taskRef:
+        name: hello-world
+`)}
+	prt := newPipelineRunTest(t, test.Data{
+		PipelineRuns: prs,
+		Tasks:        []*v1.Task{simpleHelloWorldTask},
+		ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+	})
+	defer prt.Cancel()
+	reconciledRun, clients := prt.reconcileRun("foo", "rerun", []string{
+		"Normal Started",
+		"Warning Failed PipelineRun foo/rerun can't rerun PipelineRun missing",
+		"Warning InternalError",
+	}, true)
+
+	validateTaskRunsCount(t, getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "rerun"), 0)
+	condition := reconciledRun.Status.GetCondition(apis.ConditionSucceeded)
+	if !condition.IsFalse() || condition.Reason != v1.PipelineRunReasonCouldntGetRerunOf.String() {
+		t.Errorf("expected PipelineRun to fail with reason %s, got %v", v1.PipelineRunReasonCouldntGetRerunOf, condition)
+	}
+}
+
+func TestReconcileWithRerunOfChanged(t *testing.T) {
+	previous := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: previous
+  namespace: foo
+spec:
+  params:
+  - name: environment
+    value: production
+  pipelineSpec:
+    params:
+    - name: environment
+      type: string
+    tasks:
+    - name: build
+      taskRef:
+        name: hello-world
+        kind: Task
+status:
+  conditions:
+  - type: Succeeded
+    status: "False"
+    reason: Failed
+  pipelineSpec:
+    params:
+    - name: environment
+      type: string
+    tasks:
+    - name: build
+      taskRef:
+        name: hello-world
+        kind: Task
+`)
+	for _, tc := range []struct {
+		name  string
+		rerun string
+	}{{
+		name: "different params",
+		rerun: `
+metadata:
+  name: rerun
+  namespace: foo
+spec:
+  rerunOf:
+    name: previous
+  params:
+  - name: environment
+    value: staging
+  pipelineSpec:
+    params:
+    - name: environment
+      type: string
+    tasks:
+    - name: build
+      taskRef:
+        name: hello-world
+        kind: Task
+`,
+	}, {
+		name: "different pipeline spec",
+		rerun: `
+metadata:
+  name: rerun
+  namespace: foo
+spec:
+  rerunOf:
+    name: previous
+  params:
+  - name: environment
+    value: production
+  pipelineSpec:
+    params:
+    - name: environment
+      type: string
+    tasks:
+    - name: build
+      timeout: 1h
+      taskRef:
+        name: hello-world
+        kind: Task
+`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			prt := newPipelineRunTest(t, test.Data{
+				PipelineRuns: []*v1.PipelineRun{previous, parse.MustParseV1PipelineRun(t, tc.rerun)},
+				Tasks:        []*v1.Task{simpleHelloWorldTask},
+				ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+			})
+			defer prt.Cancel()
+			reconciledRun, clients := prt.reconcileRun("foo", "rerun", []string{
+				"Normal Started",
+				"Warning Failed PipelineRun foo/rerun can't rerun PipelineRun previous: the Pipeline spec or the params of PipelineRun previous differ",
+				"Warning InternalError",
+			}, true)
+
+			validateTaskRunsCount(t, getTaskRunsForPipelineRun(prt.TestAssets.Ctx, t, clients, "foo", "rerun"), 0)
+			condition := reconciledRun.Status.GetCondition(apis.ConditionSucceeded)
+			if !condition.IsFalse() || condition.Reason != v1.PipelineRunReasonCouldntGetRerunOf.String() {
+				t.Errorf("expected PipelineRun to fail with reason %s, got %v", v1.PipelineRunReasonCouldntGetRerunOf, condition)
+			}
+		})
+	}
+}
+
+func TestReconcileWithRerunOfSensitiveParams(t *testing.T) {
+	previous := parse.MustParseV1PipelineRun(t, `
+metadata:
+  name: previous
+  namespace: foo
+spec:
+  params:
+  - name: token
+    value: "[REDACTED]"
+    valueFrom:
+      secretKeyRef:
+        name: previous-sensitive-params
+        key: token
+  pipelineSpec:
+    params:
+    - name: token
+      type: string
+      sensitive: true
+    tasks:
+    - name: build
+      taskRef:
+        name: hello-world
+        kind: Task
+status:
+  conditions:
+  - type: Succeeded
+    status: "False"
+    reason: Failed
+  pipelineSpec:
+    params:
+    - name: token
+      type: string
+      sensitive: true
+    tasks:
+    - name: build
+      taskRef:
+        name: hello-world
+        kind: Task
+`)
+	for _, tc := range []struct {
+		name       string
+		token      string
+		wantEvents []string
+		wantFailed bool
+	}{{
+		name:       "same value",
+		token:      "s3cr3t",
+		wantEvents: []string{"Normal Started", "Normal Running Tasks Completed: 0 (Failed: 0, Cancelled 0), Incomplete: 1, Skipped: 0"},
+	}, {
+		name:  "different value",
+		token: "other",
+		wantEvents: []string{
+			"Normal Started",
+			"Warning Failed PipelineRun foo/rerun can't rerun PipelineRun previous: the Pipeline spec or the params of PipelineRun previous differ",
+			"Warning InternalError",
+		},
+		wantFailed: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			rerun := parse.MustParseV1PipelineRun(t, fmt.Sprintf(`
+metadata:
+  name: rerun
+  namespace: foo
+spec:
+  rerunOf:
+    name: previous
+  params:
+  - name: token
+    value: %s
+  pipelineSpec:
+    params:
+    - name: token
+      type: string
+      sensitive: true
+    tasks:
+    - name: build
+      taskRef:
+        name: hello-world
+        kind: Task
+`, tc.token))
+			prt := newPipelineRunTest(t, test.Data{
+				PipelineRuns: []*v1.PipelineRun{previous, rerun},
+				Tasks:        []*v1.Task{simpleHelloWorldTask},
+				ConfigMaps:   th.NewAlphaFeatureFlagsConfigMapInSlice(),
+				Secrets: []*corev1.Secret{{
+					ObjectMeta: metav1.ObjectMeta{Name: "previous-sensitive-params", Namespace: "foo"},
+					Data:       map[string][]byte{"token": []byte("s3cr3t")},
+				}},
+			})
+			defer prt.Cancel()
+			reconciledRun, _ := prt.reconcileRun("foo", "rerun", tc.wantEvents, tc.wantFailed)
+
+			if failed := reconciledRun.Status.GetCondition(apis.ConditionSucceeded).IsFalse(); failed != tc.wantFailed {
+				t.Errorf("expected the rerun to fail %t, got condition %v", tc.wantFailed, reconciledRun.Status.GetCondition(apis.ConditionSucceeded))
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package resources
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,8 @@ import (
 	"github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
 	"github.com/tektoncd/pipeline/pkg/substitution"
 	"github.com/tektoncd/pipeline/pkg/workspace"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 const (
@@ -125,9 +128,112 @@ func ApplyParameters(p *v1.PipelineSpec, pr *v1.PipelineRun) (*v1.PipelineSpec,
 	}
 
 	// ===== Phase 6: Apply all replacements to PipelineSpec =====
+	p = propagateSensitiveParams(p, pr.Spec.Params)
 	return ApplyReplacements(p, resolvedStringParams, resolvedArrayParams, resolvedObjectParams), nil
 }
 
+// propagateSensitiveParams passes the Secret references of the sensitive params of a PipelineRun on to its
+// PipelineTasks: a PipelineTask param whose value is a reference to a sensitive param references the same
+// Secret, and a sensitive param used by an embedded Task without being passed to it is added to its params.
+// The references to sensitive params are otherwise left as-is, since their values are not known.
+func propagateSensitiveParams(p *v1.PipelineSpec, params v1.Params) *v1.PipelineSpec {
+	var sensitive v1.Params
+	for _, param := range params {
+		if param.ValueFrom != nil {
+			sensitive = append(sensitive, param)
+		}
+	}
+	if len(sensitive) == 0 {
+		return p
+	}
+
+	p = p.DeepCopy()
+	propagateSensitiveParamsToPipelineTasks(p.Tasks, sensitive)
+	propagateSensitiveParamsToPipelineTasks(p.Finally, sensitive)
+	return p
+}
+
+func propagateSensitiveParamsToPipelineTasks(tasks []v1.PipelineTask, sensitive v1.Params) {
+	for i := range tasks {
+		declared := sets.NewString()
+		for j, param := range tasks[i].Params {
+			declared.Insert(param.Name)
+			for _, sp := range sensitive {
+				if isParamReference(param.Value.StringVal, sp.Name) {
+					tasks[i].Params[j].Value = sp.Value
+					tasks[i].Params[j].ValueFrom = sp.ValueFrom.DeepCopy()
+				}
+			}
+		}
+		if tasks[i].TaskSpec == nil {
+			continue
+		}
+		for _, sp := range sensitive {
+			if !declared.Has(sp.Name) && taskSpecReferencesParam(&tasks[i].TaskSpec.TaskSpec, sp.Name) {
+				tasks[i].Params = append(tasks[i].Params, *sp.DeepCopy())
+			}
+		}
+	}
+}
+
+// ValidateSensitiveParamReferences returns an error if the PipelineSpec references a sensitive param other
+// than as the whole value of a PipelineTask param or in an embedded Task, the only references whose value
+// can be passed on to the TaskRuns. The value of a sensitive param isn't known by the PipelineRun, so it
+// can't be substituted in a string or evaluated in a when expression.
+func ValidateSensitiveParamReferences(p *v1.PipelineSpec) error {
+	var sensitive []string
+	for _, ps := range p.Params {
+		if ps.Sensitive {
+			sensitive = append(sensitive, ps.Name)
+		}
+	}
+	if len(sensitive) == 0 {
+		return nil
+	}
+
+	// Leave out the references which are passed on to the TaskRuns
+	p = p.DeepCopy()
+	for _, tasks := range [][]v1.PipelineTask{p.Tasks, p.Finally} {
+		for i := range tasks {
+			tasks[i].TaskSpec = nil
+			tasks[i].Params = slices.DeleteFunc(tasks[i].Params, func(param v1.Param) bool {
+				return slices.ContainsFunc(sensitive, func(name string) bool {
+					return isParamReference(param.Value.StringVal, name)
+				})
+			})
+		}
+	}
+	for _, name := range sensitive {
+		replacements := map[string]string{}
+		for _, pattern := range paramPatterns {
+			replacements[fmt.Sprintf(pattern, name)] = ""
+		}
+		if !equality.Semantic.DeepEqual(ApplyReplacements(p, replacements, nil, nil), p) {
+			return fmt.Errorf("sensitive param %q can only be referenced as the whole value of a PipelineTask param or in an embedded Task", name)
+		}
+	}
+	return nil
+}
+
+// isParamReference returns true if the value is a whole reference to the param of the given name.
+func isParamReference(value string, paramName string) bool {
+	for _, pattern := range paramPatterns {
+		if value == "$("+fmt.Sprintf(pattern, paramName)+")" {
+			return true
+		}
+	}
+	return false
+}
+
+// taskSpecReferencesParam returns true if the TaskSpec references the param of the given name.
+func taskSpecReferencesParam(ts *v1.TaskSpec, paramName string) bool {
+	replacements := map[string]string{}
+	for _, pattern := range paramPatterns {
+		replacements[fmt.Sprintf(pattern, paramName)] = ""
+	}
+	return !equality.Semantic.DeepEqual(resources.ApplyReplacements(ts, replacements, nil, nil), ts)
+}
+
 func paramsFromPipelineRun(pr *v1.PipelineRun) (map[string]string, map[string][]string, map[string]map[string]string) {
 	// stringReplacements is used for standard single-string stringReplacements,
 	// while arrayReplacements/objectReplacements contains arrays/objects that need to be further processed.
@@ -136,6 +242,10 @@ func paramsFromPipelineRun(pr *v1.PipelineRun) (map[string]string, map[string][]
 	objectReplacements := map[string]map[string]string{}
 
 	for _, p := range pr.Spec.Params {
+		// the values of sensitive params are only known by the TaskRuns they are passed to
+		if p.ValueFrom != nil {
+			continue
+		}
 		switch p.Value.Type {
 		case v1.ParamTypeArray:
 			for _, pattern := range paramPatterns {
@@ -385,6 +495,21 @@ func resolveObjectParam(paramKey string, paramValue map[string]string, resolvedS
 	}
 }
 
+// GetConcurrencyGroup returns the concurrency group of a PipelineRun, with the string params
+// of the PipelineRun substituted. It returns "" if the PipelineRun has no concurrency.
+func GetConcurrencyGroup(pr *v1.PipelineRun) string {
+	if pr.Spec.Concurrency == nil {
+		return ""
+	}
+	replacements := map[string]string{}
+	for _, p := range pr.Spec.Params {
+		if p.Value.Type == v1.ParamTypeString {
+			addPatternEntry(p.Name, p.Value.StringVal, replacements)
+		}
+	}
+	return substitution.ApplyReplacements(pr.Spec.Concurrency.Group, replacements)
+}
+
 // GetContextReplacements returns the pipelineRun context which can be used to replace context variables in the specifications
 func GetContextReplacements(pipelineName string, pr *v1.PipelineRun) map[string]string {
 	return map[string]string{
@@ -474,6 +599,9 @@ func ApplyPipelineTaskContexts(pt *v1.PipelineTask, pipelineRunStatus v1.Pipelin
 		for i := range pt.Matrix.Include {
 			pt.Matrix.Include[i].Params = pt.Matrix.Include[i].Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
 		}
+		for i := range pt.Matrix.Exclude {
+			pt.Matrix.Exclude[i].Params = pt.Matrix.Exclude[i].Params.ReplaceVariables(replacements, map[string][]string{}, map[string]map[string]string{})
+		}
 	}
 	pt.DisplayName = substitution.ApplyReplacements(pt.DisplayName, replacements)
 	return pt
@@ -497,6 +625,10 @@ func ApplyTaskResults(targets PipelineRunState, resolvedResultRefs ResolvedResul
 					// matrix include parameters can only be type string
 					pipelineTask.Matrix.Include[i].Params = pipelineTask.Matrix.Include[i].Params.ReplaceVariables(stringReplacements, nil, nil)
 				}
+				for i := range pipelineTask.Matrix.Exclude {
+					// matrix exclude parameters can only be type string
+					pipelineTask.Matrix.Exclude[i].Params = pipelineTask.Matrix.Exclude[i].Params.ReplaceVariables(stringReplacements, nil, nil)
+				}
 			}
 			pipelineTask.When = pipelineTask.When.ReplaceVariables(stringReplacements, arrayReplacements)
 			if pipelineTask.TaskRef != nil {
@@ -509,6 +641,7 @@ func ApplyTaskResults(targets PipelineRunState, resolvedResultRefs ResolvedResul
 			for i, workspace := range pipelineTask.Workspaces {
 				pipelineTask.Workspaces[i].SubPath = substitution.ApplyReplacements(workspace.SubPath, stringReplacements)
 			}
+			applyCacheReplacements(pipelineTask.Cache, stringReplacements)
 			resolvedPipelineRunTask.PipelineTask = pipelineTask
 		}
 	}
@@ -559,12 +692,16 @@ func replaceVariablesInPipelineTasks(tasks []v1.PipelineTask, replacements map[s
 			for j := range tasks[i].Matrix.Include {
 				tasks[i].Matrix.Include[j].Params = tasks[i].Matrix.Include[j].Params.ReplaceVariables(replacements, nil, nil)
 			}
+			for j := range tasks[i].Matrix.Exclude {
+				tasks[i].Matrix.Exclude[j].Params = tasks[i].Matrix.Exclude[j].Params.ReplaceVariables(replacements, nil, nil)
+			}
 		} else {
 			tasks[i].DisplayName = substitution.ApplyReplacements(tasks[i].DisplayName, replacements)
 		}
 		for j := range tasks[i].Workspaces {
 			tasks[i].Workspaces[j].SubPath = substitution.ApplyReplacements(tasks[i].Workspaces[j].SubPath, replacements)
 		}
+		applyCacheReplacements(tasks[i].Cache, replacements)
 		tasks[i].When = tasks[i].When.ReplaceVariables(replacements, arrayReplacements)
 		if tasks[i].TaskRef != nil {
 			if tasks[i].TaskRef.Params != nil {
@@ -577,6 +714,16 @@ func replaceVariablesInPipelineTasks(tasks []v1.PipelineTask, replacements map[s
 	}
 }
 
+// applyCacheReplacements replaces placeholders in the workspace digests of a cached PipelineTask
+func applyCacheReplacements(cache *v1.TaskCache, replacements map[string]string) {
+	if cache == nil {
+		return
+	}
+	for i := range cache.Workspaces {
+		cache.Workspaces[i].Digest = substitution.ApplyReplacements(cache.Workspaces[i].Digest, replacements)
+	}
+}
+
 // ApplyReplacements replaces placeholders for declared parameters with the specified replacements.
 func ApplyReplacements(p *v1.PipelineSpec, replacements map[string]string, arrayReplacements map[string][]string, objectReplacements map[string]map[string]string) *v1.PipelineSpec {
 	p = p.DeepCopy()
@@ -841,6 +988,30 @@ func ApplyTaskResultsToPipelineResults(
 	return runResults, nil
 }
 
+// ValidatePipelineResultsSchemas validates the values of the PipelineRun results against the JSON Schema
+// of their specified results, if any.
+func ValidatePipelineResultsSchemas(results []v1.PipelineResult, runResults []v1.PipelineRunResult) error {
+	schemas := make(map[string]*v1.JSONSchema)
+	for _, r := range results {
+		if r.Schema != nil {
+			schemas[r.Name] = r.Schema
+		}
+	}
+
+	var invalidPipelineResults []string
+	for _, runResult := range runResults {
+		if schema, ok := schemas[runResult.Name]; ok {
+			if err := schema.ValidateValue(runResult.Value); err != nil {
+				invalidPipelineResults = append(invalidPipelineResults, fmt.Sprintf("%q: %v", runResult.Name, err))
+			}
+		}
+	}
+	if len(invalidPipelineResults) > 0 {
+		return fmt.Errorf("invalid pipelineresults values, they don't match their schema: %s", strings.Join(invalidPipelineResults, ", "))
+	}
+	return nil
+}
+
 // taskResultValue returns the result value for a given pipeline task name and result name in a map of TaskRunResults for
 // pipeline task names. It returns nil if either the pipeline task name isn't present in the map, or if there is no
 // result with the result name in the pipeline task name's slice of results.
@@ -2210,6 +2210,163 @@ func TestApplyParameters_Errors(t *testing.T) {
 	}
 }
 
+func TestApplyParameters_SensitiveParams(t *testing.T) {
+	secretKeyRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "pr-sensitive-params"},
+		Key:                  "token",
+	}
+	token := v1.Param{
+		Name:      "token",
+		Value:     *v1.NewStructuredValues(v1.SensitiveParamRedactedValue),
+		ValueFrom: &v1.ParamValueSource{SecretKeyRef: secretKeyRef},
+	}
+	original := v1.PipelineSpec{
+		Params: v1.ParamSpecs{
+			{Name: "token", Type: v1.ParamTypeString, Sensitive: true},
+			{Name: "url", Type: v1.ParamTypeString},
+		},
+		Tasks: []v1.PipelineTask{{
+			Name:    "passed-through",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{
+				{Name: "api-token", Value: *v1.NewStructuredValues("$(params.token)")},
+				{Name: "url", Value: *v1.NewStructuredValues("$(params.url)")},
+			},
+		}, {
+			Name: "embedded",
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name:   "call",
+					Image:  "curl",
+					Script: "curl -H \"Authorization: Bearer $(params.token)\" $(params.url)",
+				}},
+			}},
+		}},
+		Finally: []v1.PipelineTask{{
+			Name:    "finally",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{
+				{Name: "api-token", Value: *v1.NewStructuredValues("$(params['token'])")},
+			},
+		}},
+	}
+	pr := &v1.PipelineRun{
+		Spec: v1.PipelineRunSpec{
+			Params: v1.Params{token, {Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")}},
+		},
+	}
+	expected := v1.PipelineSpec{
+		Params: original.Params,
+		Tasks: []v1.PipelineTask{{
+			Name:    "passed-through",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{
+				{Name: "api-token", Value: token.Value, ValueFrom: token.ValueFrom},
+				{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+			},
+		}, {
+			Name:   "embedded",
+			Params: v1.Params{token},
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name:   "call",
+					Image:  "curl",
+					Script: "curl -H \"Authorization: Bearer $(params.token)\" https://tekton.dev",
+				}},
+			}},
+		}},
+		Finally: []v1.PipelineTask{{
+			Name:    "finally",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params: v1.Params{
+				{Name: "api-token", Value: token.Value, ValueFrom: token.ValueFrom},
+			},
+		}},
+	}
+
+	got, err := resources.ApplyParameters(&original, pr)
+	if err != nil {
+		t.Fatalf("ApplyParameters() returned unexpected error: %v", err)
+	}
+	if d := cmp.Diff(&expected, got); d != "" {
+		t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
+	}
+	if original.Tasks[0].Params[0].ValueFrom != nil {
+		t.Error("ApplyParameters() mutated the original PipelineSpec")
+	}
+}
+
+func TestValidateSensitiveParamReferences(t *testing.T) {
+	params := v1.ParamSpecs{
+		{Name: "token", Type: v1.ParamTypeString, Sensitive: true},
+		{Name: "url", Type: v1.ParamTypeString},
+	}
+	for _, tc := range []struct {
+		name    string
+		task    v1.PipelineTask
+		wantErr bool
+	}{{
+		name: "whole value of a param",
+		task: v1.PipelineTask{
+			Name:    "task",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params:  v1.Params{{Name: "api-token", Value: *v1.NewStructuredValues("$(params.token)")}},
+		},
+	}, {
+		name: "embedded Task",
+		task: v1.PipelineTask{
+			Name: "task",
+			TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+				Steps: []v1.Step{{Name: "call", Image: "curl", Script: "curl -H \"Authorization: Bearer $(params.token)\""}},
+			}},
+		},
+	}, {
+		name: "other params",
+		task: v1.PipelineTask{
+			Name:    "task",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params:  v1.Params{{Name: "url", Value: *v1.NewStructuredValues("$(params.url)/api")}},
+			When:    v1.WhenExpressions{{Input: "$(params.url)", Operator: selection.In, Values: []string{"https://tekton.dev"}}},
+		},
+	}, {
+		name: "part of a param",
+		task: v1.PipelineTask{
+			Name:    "task",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params:  v1.Params{{Name: "header", Value: *v1.NewStructuredValues("Bearer $(params.token)")}},
+		},
+		wantErr: true,
+	}, {
+		name: "array param",
+		task: v1.PipelineTask{
+			Name:    "task",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			Params:  v1.Params{{Name: "headers", Value: *v1.NewStructuredValues("$(params.token)", "Accept: */*")}},
+		},
+		wantErr: true,
+	}, {
+		name: "when expression",
+		task: v1.PipelineTask{
+			Name:    "task",
+			TaskRef: &v1.TaskRef{Name: "task"},
+			When:    v1.WhenExpressions{{Input: "$(params.token)", Operator: selection.NotIn, Values: []string{""}}},
+		},
+		wantErr: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, spec := range []*v1.PipelineSpec{
+				{Params: params, Tasks: []v1.PipelineTask{tc.task}},
+				{Params: params, Finally: []v1.PipelineTask{tc.task}},
+			} {
+				err := resources.ValidateSensitiveParamReferences(spec)
+				if (err != nil) != tc.wantErr {
+					t.Errorf("ValidateSensitiveParamReferences() = %v, wantErr %t", err, tc.wantErr)
+				}
+			}
+		})
+	}
+}
+
 func TestApplyParameters_ArrayIndexing(t *testing.T) {
 	for _, tt := range []struct {
 		name     string
@@ -4810,6 +4967,52 @@ func TestApplyTaskResultsToPipelineResults_Error(t *testing.T) {
 	}
 }
 
+func TestValidatePipelineResultsSchemas(t *testing.T) {
+	schemaResults := []v1.PipelineResult{{
+		Name:   "digest",
+		Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+	}, {
+		Name: "url",
+	}}
+	for _, tc := range []struct {
+		description   string
+		runResults    []v1.PipelineRunResult
+		expectedError error
+	}{{
+		description: "results matching their schema",
+		runResults: []v1.PipelineRunResult{{
+			Name:  "digest",
+			Value: *v1.NewStructuredValues("sha256:abc"),
+		}, {
+			Name:  "url",
+			Value: *v1.NewStructuredValues("anything"),
+		}},
+	}, {
+		description: "result not matching its schema",
+		runResults: []v1.PipelineRunResult{{
+			Name:  "digest",
+			Value: *v1.NewStructuredValues("md5:abc"),
+		}},
+		expectedError: errors.New(`invalid pipelineresults values, they don't match their schema: "digest": "md5:abc" does not match the pattern "^sha256:"`),
+	}} {
+		t.Run(tc.description, func(t *testing.T) {
+			err := resources.ValidatePipelineResultsSchemas(schemaResults, tc.runResults)
+			if tc.expectedError == nil {
+				if err != nil {
+					t.Errorf("ValidatePipelineResultsSchemas() = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expect error but got nil")
+			}
+			if d := cmp.Diff(tc.expectedError.Error(), err.Error()); d != "" {
+				t.Errorf("ValidatePipelineResultsSchemas() errors diff %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestApplyTaskRunContext(t *testing.T) {
 	r := map[string]string{
 		"tasks.task1.status": "succeeded",
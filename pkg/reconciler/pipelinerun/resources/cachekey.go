@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// GetCacheKey returns the key a cached PipelineTask is memoized with: a digest of the resolved
+// Task spec, the params of the TaskRun, the overrides of its taskRunSpec that change how its Steps
+// and Sidecars run and the digests of the workspaces declared in the cache.
+// The order of params and workspaces does not matter. The key is truncated to fit in a label value.
+func GetCacheKey(taskSpec *v1.TaskSpec, params v1.Params, taskRunSpec v1.PipelineTaskRunSpec, cache *v1.TaskCache) (string, error) {
+	sortedParams := slices.Clone(params)
+	slices.SortStableFunc(sortedParams, func(a, b v1.Param) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	var workspaces []v1.CacheWorkspace
+	if cache != nil {
+		workspaces = slices.Clone(cache.Workspaces)
+		slices.SortStableFunc(workspaces, func(a, b v1.CacheWorkspace) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+	// The metadata and timeout of the TaskRun don't change its results
+	overrides := v1.PipelineTaskRunSpec{
+		ServiceAccountName: taskRunSpec.ServiceAccountName,
+		PodTemplate:        taskRunSpec.PodTemplate,
+		StepSpecs:          taskRunSpec.StepSpecs,
+		SidecarSpecs:       taskRunSpec.SidecarSpecs,
+		ComputeResources:   taskRunSpec.ComputeResources,
+	}
+	b, err := json.Marshal(struct {
+		TaskSpec    *v1.TaskSpec           `json:"taskSpec"`
+		Params      v1.Params              `json:"params"`
+		TaskRunSpec v1.PipelineTaskRunSpec `json:"taskRunSpec"`
+		Workspaces  []v1.CacheWorkspace    `json:"workspaces"`
+	}{taskSpec, sortedParams, overrides, workspaces})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute cache key: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])[:validation.LabelValueMaxLength], nil
+}
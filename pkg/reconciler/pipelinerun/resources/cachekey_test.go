@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	resources "github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestGetCacheKey(t *testing.T) {
+	taskSpec := &v1.TaskSpec{Steps: []v1.Step{{Name: "build", Image: "golang", Script: "go build ./..."}}}
+	params := v1.Params{
+		{Name: "a", Value: *v1.NewStructuredValues("1")},
+		{Name: "b", Value: *v1.NewStructuredValues("2")},
+	}
+	cache := &v1.TaskCache{Workspaces: []v1.CacheWorkspace{
+		{Name: "source", Digest: "sha256:abc"},
+		{Name: "config", Digest: "sha256:def"},
+	}}
+	taskRunSpec := v1.PipelineTaskRunSpec{PipelineTaskName: "build", ServiceAccountName: "builder"}
+
+	key, err := resources.GetCacheKey(taskSpec, params, taskRunSpec, cache)
+	if err != nil {
+		t.Fatalf("GetCacheKey() returned an unexpected error: %v", err)
+	}
+	if len(key) != validation.LabelValueMaxLength {
+		t.Errorf("expected a key of length %d but got %q", validation.LabelValueMaxLength, key)
+	}
+
+	reordered, err := resources.GetCacheKey(taskSpec, v1.Params{params[1], params[0]}, taskRunSpec, &v1.TaskCache{
+		Workspaces: []v1.CacheWorkspace{cache.Workspaces[1], cache.Workspaces[0]},
+	})
+	if err != nil {
+		t.Fatalf("GetCacheKey() returned an unexpected error: %v", err)
+	}
+	if reordered != key {
+		t.Errorf("expected the key not to depend on the order of params and workspaces: %s != %s", reordered, key)
+	}
+
+	for _, tc := range []struct {
+		name        string
+		taskSpec    *v1.TaskSpec
+		params      v1.Params
+		taskRunSpec v1.PipelineTaskRunSpec
+		cache       *v1.TaskCache
+	}{{
+		name:        "different task spec",
+		taskSpec:    &v1.TaskSpec{Steps: []v1.Step{{Name: "build", Image: "golang", Script: "go test ./..."}}},
+		params:      params,
+		taskRunSpec: taskRunSpec,
+		cache:       cache,
+	}, {
+		name:     "different param value",
+		taskSpec: taskSpec,
+		params: v1.Params{
+			{Name: "a", Value: *v1.NewStructuredValues("1")},
+			{Name: "b", Value: *v1.NewStructuredValues("3")},
+		},
+		taskRunSpec: taskRunSpec,
+		cache:       cache,
+	}, {
+		name:        "different workspace digest",
+		taskSpec:    taskSpec,
+		params:      params,
+		taskRunSpec: taskRunSpec,
+		cache: &v1.TaskCache{Workspaces: []v1.CacheWorkspace{
+			{Name: "source", Digest: "sha256:123"},
+			{Name: "config", Digest: "sha256:def"},
+		}},
+	}, {
+		name:     "different step specs",
+		taskSpec: taskSpec,
+		params:   params,
+		taskRunSpec: v1.PipelineTaskRunSpec{
+			PipelineTaskName:   "build",
+			ServiceAccountName: "builder",
+			StepSpecs: []v1.TaskRunStepSpec{{
+				Name:             "build",
+				ComputeResources: corev1.ResourceRequirements{Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}},
+			}},
+		},
+		cache: cache,
+	}, {
+		name:     "different compute resources",
+		taskSpec: taskSpec,
+		params:   params,
+		taskRunSpec: v1.PipelineTaskRunSpec{
+			PipelineTaskName:   "build",
+			ServiceAccountName: "builder",
+			ComputeResources:   &corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}},
+		},
+		cache: cache,
+	}, {
+		name:     "different pod template",
+		taskSpec: taskSpec,
+		params:   params,
+		taskRunSpec: v1.PipelineTaskRunSpec{
+			PipelineTaskName:   "build",
+			ServiceAccountName: "builder",
+			PodTemplate:        &pod.Template{NodeSelector: map[string]string{"arch": "arm64"}},
+		},
+		cache: cache,
+	}, {
+		name:     "different service account",
+		taskSpec: taskSpec,
+		params:   params,
+		taskRunSpec: v1.PipelineTaskRunSpec{
+			PipelineTaskName:   "build",
+			ServiceAccountName: "deployer",
+		},
+		cache: cache,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resources.GetCacheKey(tc.taskSpec, tc.params, tc.taskRunSpec, tc.cache)
+			if err != nil {
+				t.Fatalf("GetCacheKey() returned an unexpected error: %v", err)
+			}
+			if got == key {
+				t.Errorf("expected a different key than %s", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	pipelineErrors "github.com/tektoncd/pipeline/pkg/apis/pipeline/errors"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	rprp "github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/pipelinespec"
+	"github.com/tektoncd/pipeline/pkg/trustedresources"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var (
+	// pipelineTaskReferencePattern matches the references to a PipelineTask, e.g. $(tasks.build.results.digest)
+	pipelineTaskReferencePattern = regexp.MustCompile(`\$\(tasks\.([-a-z0-9]+)\.`)
+	// pipelineWorkspaceReferencePattern matches the references to a Pipeline workspace, e.g. $(workspaces.source.bound)
+	pipelineWorkspaceReferencePattern = regexp.MustCompile(`\$\(workspaces\.([-_a-zA-Z0-9]+)\.`)
+)
+
+// ExpandPipelineImports returns a copy of the PipelineSpec in which the Tasks and Finally tasks of the
+// imported Pipelines are inlined, using getImportedPipeline to fetch them. The imported PipelineTasks
+// are renamed to "<import>-<task>", their parameters are replaced by the values bound by the import, and
+// their workspaces are mapped to the workspaces of the importing Pipeline.
+func ExpandPipelineImports(ctx context.Context, ps *v1.PipelineSpec, getImportedPipeline func(*v1.PipelineRef) rprp.GetPipeline) (*v1.PipelineSpec, error) {
+	if len(ps.Imports) == 0 {
+		return ps, nil
+	}
+	expanded := ps.DeepCopy()
+	expanded.Imports = nil
+	for _, imp := range ps.Imports {
+		p, _, vr, err := getImportedPipeline(imp.PipelineRef)(ctx, imp.PipelineRef.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the Pipeline imported as %q: %w", imp.Name, err)
+		}
+		if vr != nil && vr.VerificationResultType == trustedresources.VerificationError {
+			return nil, fmt.Errorf("failed to verify the Pipeline imported as %q: %w", imp.Name, vr.Err)
+		}
+		tasks, finally, err := inlinePipelineImport(ctx, imp, &p.Spec, ps.Workspaces)
+		if err != nil {
+			return nil, pipelineErrors.WrapUserError(fmt.Errorf("failed to import Pipeline as %q: %w", imp.Name, err))
+		}
+		expanded.Tasks = append(expanded.Tasks, tasks...)
+		expanded.Finally = append(expanded.Finally, finally...)
+	}
+	return expanded, nil
+}
+
+// inlinePipelineImport returns the Tasks and Finally tasks of the imported PipelineSpec, renamed and
+// bound to the params and workspaces of the import.
+func inlinePipelineImport(ctx context.Context, imp v1.PipelineImport, spec *v1.PipelineSpec, workspaces []v1.PipelineWorkspaceDeclaration) ([]v1.PipelineTask, []v1.PipelineTask, error) {
+	if len(spec.Imports) > 0 {
+		return nil, nil, errors.New("an imported Pipeline cannot import other Pipelines")
+	}
+	spec = spec.DeepCopy()
+	spec.SetDefaults(ctx)
+
+	// Bind the params of the imported Pipeline, falling back to their default values.
+	bound := imp.Params.ExtractNames()
+	for _, p := range spec.Params {
+		if p.Default == nil && !bound.Has(p.Name) {
+			return nil, nil, fmt.Errorf("param %q of the imported Pipeline is not bound", p.Name)
+		}
+	}
+	spec, err := ApplyParameters(spec, &v1.PipelineRun{Spec: v1.PipelineRunSpec{Params: imp.Params}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Map the workspaces of the imported Pipeline to the workspaces of the importing Pipeline.
+	declared := sets.NewString()
+	for _, ws := range workspaces {
+		declared.Insert(ws.Name)
+	}
+	workspaceMapping := map[string]v1.WorkspacePipelineTaskBinding{}
+	unprovided := map[string]string{}
+	for _, ws := range spec.Workspaces {
+		binding := v1.WorkspacePipelineTaskBinding{Name: ws.Name, Workspace: ws.Name}
+		for _, b := range imp.Workspaces {
+			if b.Name == ws.Name {
+				binding = b
+				if binding.Workspace == "" {
+					binding.Workspace = b.Name
+				}
+			}
+		}
+		switch {
+		case declared.Has(binding.Workspace):
+			workspaceMapping[ws.Name] = binding
+		case ws.Optional:
+			unprovided[fmt.Sprintf("workspaces.%s.bound", ws.Name)] = "false"
+		default:
+			return nil, nil, fmt.Errorf("workspace %q of the imported Pipeline is not bound", ws.Name)
+		}
+	}
+	spec = ApplyReplacements(spec, unprovided, map[string][]string{}, map[string]map[string]string{})
+
+	taskNames := v1.PipelineTaskList(spec.Tasks).Names()
+	prefix := func(name string) string {
+		return fmt.Sprintf("%s-%s", imp.Name, name)
+	}
+	tasks, err := renameImportedPipelineTasks(spec.Tasks, taskNames, prefix, workspaceMapping)
+	if err != nil {
+		return nil, nil, err
+	}
+	finally, err := renameImportedPipelineTasks(spec.Finally, taskNames, prefix, workspaceMapping)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, finally, nil
+}
+
+// renameImportedPipelineTasks renames the imported PipelineTasks, updating the references to the
+// PipelineTasks in taskNames and to the workspaces of the imported Pipeline.
+func renameImportedPipelineTasks(pts []v1.PipelineTask, taskNames sets.String, prefix func(string) string, workspaceMapping map[string]v1.WorkspacePipelineTaskBinding) ([]v1.PipelineTask, error) {
+	renamed := make([]v1.PipelineTask, 0, len(pts))
+	for _, pt := range pts {
+		// The embedded specs have their own scope for the references, so they're left untouched.
+		taskSpec, pipelineSpec := pt.TaskSpec, pt.PipelineSpec
+		pt.TaskSpec, pt.PipelineSpec = nil, nil
+		b, err := json.Marshal(pt)
+		if err != nil {
+			return nil, err
+		}
+		s := pipelineTaskReferencePattern.ReplaceAllStringFunc(string(b), func(ref string) string {
+			name := pipelineTaskReferencePattern.FindStringSubmatch(ref)[1]
+			if !taskNames.Has(name) {
+				return ref
+			}
+			return fmt.Sprintf("$(tasks.%s.", prefix(name))
+		})
+		s = pipelineWorkspaceReferencePattern.ReplaceAllStringFunc(s, func(ref string) string {
+			name := pipelineWorkspaceReferencePattern.FindStringSubmatch(ref)[1]
+			binding, ok := workspaceMapping[name]
+			if !ok {
+				return ref
+			}
+			return fmt.Sprintf("$(workspaces.%s.", binding.Workspace)
+		})
+		var out v1.PipelineTask
+		if err := json.Unmarshal([]byte(s), &out); err != nil {
+			return nil, err
+		}
+		out.TaskSpec, out.PipelineSpec = taskSpec, pipelineSpec
+
+		out.Name = prefix(pt.Name)
+		for i, name := range out.RunAfter {
+			out.RunAfter[i] = prefix(name)
+		}
+		for i := range out.RunAfterTriggers {
+			out.RunAfterTriggers[i].Task = prefix(out.RunAfterTriggers[i].Task)
+		}
+		out.Workspaces = nil
+		for _, ws := range pt.Workspaces {
+			workspace := ws.Workspace
+			if workspace == "" {
+				workspace = ws.Name
+			}
+			binding, ok := workspaceMapping[workspace]
+			if !ok {
+				// The workspace is optional and isn't provided by the importing Pipeline.
+				continue
+			}
+			ws.Workspace = binding.Workspace
+			if binding.SubPath != "" {
+				ws.SubPath = filepath.Join(binding.SubPath, ws.SubPath)
+			}
+			out.Workspaces = append(out.Workspaces, ws)
+		}
+		renamed = append(renamed, out)
+	}
+	return renamed, nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	rprp "github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/pipelinespec"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/pkg/remote"
+	"github.com/tektoncd/pipeline/pkg/trustedresources"
+	"github.com/tektoncd/pipeline/test/diff"
+	"github.com/tektoncd/pipeline/test/parse"
+)
+
+func getImportedPipeline(pipelines ...*v1.Pipeline) func(*v1.PipelineRef) rprp.GetPipeline {
+	return func(*v1.PipelineRef) rprp.GetPipeline {
+		return func(_ context.Context, name string) (*v1.Pipeline, *v1.RefSource, *trustedresources.VerificationResult, error) {
+			for _, p := range pipelines {
+				if p.Name == name {
+					return p, nil, nil, nil
+				}
+			}
+			return nil, nil, nil, errors.New("pipeline not found")
+		}
+	}
+}
+
+func TestExpandPipelineImports(t *testing.T) {
+	imported := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: build-and-scan
+spec:
+  params:
+  - name: image
+  - name: tag
+    default: latest
+  workspaces:
+  - name: shared
+  - name: cache
+    optional: true
+  tasks:
+  - name: build
+    taskRef:
+      name: build
+    params:
+    - name: image
+      value: $(params.image):$(params.tag)
+    workspaces:
+    - name: source
+      workspace: shared
+      subPath: src
+    - name: cache
+  - name: scan
+    runAfter: [build]
+    taskRef:
+      name: scan
+    params:
+    - name: digest
+      value: $(tasks.build.results.digest)
+    when:
+    - input: $(workspaces.cache.bound)
+      operator: in
+      values: ["false"]
+  finally:
+  - name: cleanup
+    taskRef:
+      name: cleanup
+    params:
+    - name: status
+      value: $(tasks.scan.status)
+    workspaces:
+    - name: shared
+`)
+	ps := &parse.MustParseV1Pipeline(t, `
+metadata:
+  name: release
+spec:
+  params:
+  - name: registry
+  workspaces:
+  - name: source
+  imports:
+  - name: ci
+    pipelineRef:
+      name: build-and-scan
+    params:
+    - name: image
+      value: $(params.registry)/app
+    workspaces:
+    - name: shared
+      workspace: source
+      subPath: ci
+  tasks:
+  - name: publish
+    runAfter: [ci-scan]
+    taskRef:
+      name: publish
+    params:
+    - name: digest
+      value: $(tasks.ci-build.results.digest)
+`).Spec
+
+	got, err := resources.ExpandPipelineImports(t.Context(), ps, getImportedPipeline(imported))
+	if err != nil {
+		t.Fatalf("ExpandPipelineImports() = %v", err)
+	}
+	want := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: release
+spec:
+  params:
+  - name: registry
+  workspaces:
+  - name: source
+  tasks:
+  - name: publish
+    runAfter: [ci-scan]
+    taskRef:
+      name: publish
+    params:
+    - name: digest
+      value: $(tasks.ci-build.results.digest)
+  - name: ci-build
+    taskRef:
+      name: build
+      kind: Task
+    params:
+    - name: image
+      value: $(params.registry)/app:latest
+    workspaces:
+    - name: source
+      workspace: source
+      subPath: ci/src
+  - name: ci-scan
+    runAfter: [ci-build]
+    taskRef:
+      name: scan
+      kind: Task
+    params:
+    - name: digest
+      value: $(tasks.ci-build.results.digest)
+    when:
+    - input: "false"
+      operator: in
+      values: ["false"]
+  finally:
+  - name: ci-cleanup
+    taskRef:
+      name: cleanup
+      kind: Task
+    params:
+    - name: status
+      value: $(tasks.ci-scan.status)
+    workspaces:
+    - name: shared
+      workspace: source
+      subPath: ci
+`).Spec
+	if d := cmp.Diff(&want, got); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+	if len(ps.Imports) != 1 || len(ps.Tasks) != 1 {
+		t.Errorf("ExpandPipelineImports() modified the original PipelineSpec: %v", ps)
+	}
+}
+
+func TestExpandPipelineImports_Error(t *testing.T) {
+	imported := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: build-and-scan
+spec:
+  params:
+  - name: image
+  workspaces:
+  - name: shared
+  tasks:
+  - name: build
+    taskRef:
+      name: build
+    workspaces:
+    - name: shared
+`)
+	nested := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: nested
+spec:
+  imports:
+  - name: ci
+    pipelineRef:
+      name: build-and-scan
+`)
+	for _, tc := range []struct {
+		name    string
+		imp     string
+		getter  func(*v1.PipelineRef) rprp.GetPipeline
+		wantErr string
+	}{{
+		name: "unbound param",
+		imp: `
+    pipelineRef:
+      name: build-and-scan
+    workspaces:
+    - name: shared
+      workspace: source`,
+		getter:  getImportedPipeline(imported),
+		wantErr: `failed to import Pipeline as "ci": param "image" of the imported Pipeline is not bound`,
+	}, {
+		name: "unbound workspace",
+		imp: `
+    pipelineRef:
+      name: build-and-scan
+    params:
+    - name: image
+      value: app`,
+		getter:  getImportedPipeline(imported),
+		wantErr: `failed to import Pipeline as "ci": workspace "shared" of the imported Pipeline is not bound`,
+	}, {
+		name: "nested imports",
+		imp: `
+    pipelineRef:
+      name: nested`,
+		getter:  getImportedPipeline(nested),
+		wantErr: `failed to import Pipeline as "ci": an imported Pipeline cannot import other Pipelines`,
+	}, {
+		name: "missing pipeline",
+		imp: `
+    pipelineRef:
+      name: missing`,
+		getter:  getImportedPipeline(imported),
+		wantErr: `failed to get the Pipeline imported as "ci": pipeline not found`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			ps := parse.MustParseV1Pipeline(t, `
+metadata:
+  name: release
+spec:
+  workspaces:
+  - name: cache
+  imports:
+  - name: ci`+tc.imp+`
+`).Spec
+			_, err := resources.ExpandPipelineImports(t.Context(), &ps, tc.getter)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestExpandPipelineImports_ResolutionInProgress(t *testing.T) {
+	ps := &v1.PipelineSpec{
+		Imports: []v1.PipelineImport{{
+			Name:        "ci",
+			PipelineRef: &v1.PipelineRef{ResolverRef: v1.ResolverRef{Resolver: "git"}},
+		}},
+	}
+	getter := func(*v1.PipelineRef) rprp.GetPipeline {
+		return func(context.Context, string) (*v1.Pipeline, *v1.RefSource, *trustedresources.VerificationResult, error) {
+			return nil, nil, nil, remote.ErrRequestInProgress
+		}
+	}
+	if _, err := resources.ExpandPipelineImports(t.Context(), ps, getter); !errors.Is(err, remote.ErrRequestInProgress) {
+		t.Errorf("expected %v, got %v", remote.ErrRequestInProgress, err)
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/tektoncd/pipeline/pkg/resolution/resource"
 	"github.com/tektoncd/pipeline/pkg/substitution"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmeta"
 )
@@ -102,6 +103,11 @@ type ResolvedPipelineTask struct {
 	TaskRunNames []string
 	TaskRuns     []*v1.TaskRun
 	ResolvedTask *resources.ResolvedTask
+	// Cached is true when TaskRuns holds a TaskRun of a previous PipelineRun reused from the cache.
+	Cached bool
+	// CarriedOver is true when TaskRuns or CustomRuns hold the runs of the PipelineRun referenced
+	// by rerunOf, which succeeded there and are not run again.
+	CarriedOver bool
 
 	// If the PipelineTask is a Custom Task, CustomRunName and CustomRun will be set.
 	CustomTask     bool
@@ -110,6 +116,9 @@ type ResolvedPipelineTask struct {
 
 	PipelineTask *v1.PipelineTask
 	ResultsCache map[string][]string
+	// MaxConcurrency is the maximum number of TaskRuns, CustomRuns or child PipelineRuns of a
+	// matrixed PipelineTask running at once, 0 if they are not bounded.
+	MaxConcurrency int
 
 	// EvaluatedCEL is used to store the results of evaluated CEL expression
 	EvaluatedCEL map[string]bool
@@ -159,11 +168,14 @@ func (t *ResolvedPipelineTask) EvaluateCEL() error {
 
 // isDone returns true only if the task is skipped, succeeded or failed
 func (t ResolvedPipelineTask) isDone(facts *PipelineRunFacts) bool {
-	return t.Skip(facts).IsSkipped || t.isSuccessful() || t.isFailure() || t.isValidationFailed(facts.ValidationFailedTask)
+	return t.Skip(facts).IsSkipped || t.isSuccessful() || t.isFailure() || t.isValidationFailed(facts.ValidationFailedTask) || t.isAbandoned(facts)
 }
 
 // IsRunning returns true only if the task is neither succeeded, cancelled nor failed
 func (t ResolvedPipelineTask) IsRunning() bool {
+	if t.hasUnscheduledRuns() {
+		return !t.areScheduledRunsDone()
+	}
 	switch {
 	case t.IsCustomTask():
 		if len(t.CustomRuns) == 0 {
@@ -209,6 +221,11 @@ func (t ResolvedPipelineTask) getReason() string {
 		}
 	}
 
+	if t.hasMatrixFailurePolicy() && t.isSuccessful() {
+		// Some Combinations may have failed while enough of them succeeded
+		return v1.TaskRunReasonSuccessful.String()
+	}
+
 	if t.IsCustomTask() {
 		if len(t.CustomRuns) == 0 {
 			return ""
@@ -239,8 +256,16 @@ func (t ResolvedPipelineTask) getReason() string {
 }
 
 // isSuccessful returns true only if the run has completed successfully
-// If the PipelineTask has a Matrix, isSuccessful returns true if all runs have completed successfully
+// If the PipelineTask has a Matrix, isSuccessful returns true if all runs have completed successfully,
+// or if at least failurePolicy.minSuccessful of them have when all runs are done.
 func (t ResolvedPipelineTask) isSuccessful() bool {
+	if t.hasUnscheduledRuns() {
+		return false
+	}
+	if t.hasMatrixFailurePolicy() {
+		counts := t.countMatrixRuns()
+		return counts.created > 0 && counts.done == counts.total && counts.succeeded >= counts.required
+	}
 	if t.IsChildPipeline() {
 		if len(t.ChildPipelineRuns) == 0 {
 			return false
@@ -279,8 +304,17 @@ func (t ResolvedPipelineTask) isSuccessful() bool {
 }
 
 // isFailure returns true only if the run has failed (if it has ConditionSucceeded = False).
-// If the PipelineTask has a Matrix, isFailure returns true if any run has failed and all other runs are done.
+// If the PipelineTask has a Matrix, isFailure returns true if any run has failed and all other runs are done,
+// or if less than failurePolicy.minSuccessful of them have succeeded when all runs are done.
 func (t ResolvedPipelineTask) isFailure() bool {
+	if t.hasUnscheduledRuns() {
+		return false
+	}
+	if t.hasMatrixFailurePolicy() {
+		counts := t.countMatrixRuns()
+		return counts.created > 0 && counts.done == counts.created && counts.succeeded < counts.required &&
+			(counts.created == counts.total || t.isMatrixFailFastTriggered())
+	}
 	var isDone bool
 	if t.IsChildPipeline() {
 		if len(t.ChildPipelineRuns) == 0 {
@@ -314,6 +348,166 @@ func (t ResolvedPipelineTask) isFailure() bool {
 	return t.haveAnyTaskRunsFailed() && isDone
 }
 
+// hasUnscheduledRuns returns true if some TaskRuns, CustomRuns or child PipelineRuns of a matrixed
+// PipelineTask running with a bounded maxConcurrency have not been created yet.
+func (t ResolvedPipelineTask) hasUnscheduledRuns() bool {
+	switch {
+	case t.MaxConcurrency == 0, t.isMatrixFailFastTriggered():
+		return false
+	case t.IsChildPipeline():
+		return len(t.ChildPipelineRuns) < len(t.ChildPipelineRunNames)
+	case t.IsCustomTask():
+		return len(t.CustomRuns) < len(t.CustomRunNames)
+	default:
+		return len(t.TaskRuns) < len(t.TaskRunNames)
+	}
+}
+
+// areScheduledRunsDone returns true if all the TaskRuns, CustomRuns or child PipelineRuns created so far are done.
+func (t ResolvedPipelineTask) areScheduledRunsDone() bool {
+	for _, childPipelineRun := range t.ChildPipelineRuns {
+		if !childPipelineRun.IsDone() {
+			return false
+		}
+	}
+	for _, run := range t.CustomRuns {
+		if !run.IsDone() {
+			return false
+		}
+	}
+	for _, taskRun := range t.TaskRuns {
+		if !taskRun.IsDone() {
+			return false
+		}
+	}
+	return true
+}
+
+// isAbandoned returns true if a matrixed PipelineTask running with a bounded maxConcurrency has some
+// runs left to create, but none running, while the PipelineRun no longer schedules them because it is
+// stopping, cancelled or timed out.
+func (t ResolvedPipelineTask) isAbandoned(facts *PipelineRunFacts) bool {
+	if !t.isScheduled() || !t.hasUnscheduledRuns() || !t.areScheduledRunsDone() {
+		return false
+	}
+	if t.IsFinalTask(facts) {
+		return facts.IsCancelled() || t.skipBecausePipelineRunPipelineTimeoutReached(facts) ||
+			t.skipBecausePipelineRunFinallyTimeoutReached(facts)
+	}
+	return facts.IsCancelled() || facts.IsGracefullyCancelled() || facts.IsGracefullyStopped() ||
+		(facts.IsStopping() && !t.PipelineTask.HandlesFailures()) ||
+		t.skipBecausePipelineRunPipelineTimeoutReached(facts) || t.skipBecausePipelineRunTasksTimeoutReached(facts)
+}
+
+// RunsToSchedule returns the indexes in TaskRunNames, or in CustomRunNames for a Custom Task and in
+// ChildPipelineRunNames for a child Pipeline, of the runs to create next. If the PipelineTask is matrixed with a bounded maxConcurrency, only the runs
+// fitting next to the ones still running are returned.
+func (t ResolvedPipelineTask) RunsToSchedule() []int {
+	if t.isMatrixFailFastTriggered() {
+		return nil
+	}
+	names, created, running := t.TaskRunNames, sets.NewString(), 0
+	for _, taskRun := range t.TaskRuns {
+		created.Insert(taskRun.Name)
+		if !taskRun.IsDone() {
+			running++
+		}
+	}
+	if t.IsCustomTask() {
+		names = t.CustomRunNames
+		for _, run := range t.CustomRuns {
+			created.Insert(run.Name)
+			if !run.IsDone() {
+				running++
+			}
+		}
+	}
+	if t.IsChildPipeline() {
+		names = t.ChildPipelineRunNames
+		for _, childPipelineRun := range t.ChildPipelineRuns {
+			created.Insert(childPipelineRun.Name)
+			if !childPipelineRun.IsDone() {
+				running++
+			}
+		}
+	}
+	var indexes []int
+	for i, name := range names {
+		if t.MaxConcurrency > 0 && running+len(indexes) >= t.MaxConcurrency {
+			break
+		}
+		if !created.Has(name) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// matrixRunCounts holds the counts of the TaskRuns or CustomRuns of a matrixed PipelineTask.
+type matrixRunCounts struct {
+	// total is the number of Combinations, created or not
+	total int
+	// required is the number of runs which must succeed for the PipelineTask to succeed
+	required  int
+	created   int
+	done      int
+	succeeded int
+	// failed counts the failed runs, but not the ones cancelled
+	failed int
+}
+
+// hasMatrixFailurePolicy returns true if the PipelineTask is matrixed with a failurePolicy.
+func (t ResolvedPipelineTask) hasMatrixFailurePolicy() bool {
+	return t.PipelineTask != nil && t.PipelineTask.IsMatrixed() && t.PipelineTask.Matrix.FailurePolicy != nil && !t.IsChildPipeline()
+}
+
+// countMatrixRuns returns the counts of the TaskRuns or CustomRuns of a matrixed PipelineTask.
+func (t ResolvedPipelineTask) countMatrixRuns() matrixRunCounts {
+	var counts matrixRunCounts
+	count := func(condition *apis.Condition, cancelledReason string) {
+		counts.created++
+		switch {
+		case condition.IsTrue():
+			counts.done++
+			counts.succeeded++
+		case condition.IsFalse():
+			counts.done++
+			if condition.Reason != cancelledReason {
+				counts.failed++
+			}
+		}
+	}
+	if t.IsCustomTask() {
+		for _, run := range t.CustomRuns {
+			count(run.Status.GetCondition(apis.ConditionSucceeded), v1beta1.CustomRunReasonCancelled.String())
+		}
+		counts.total = max(counts.created, len(t.CustomRunNames))
+	} else {
+		for _, taskRun := range t.TaskRuns {
+			count(taskRun.Status.GetCondition(apis.ConditionSucceeded), v1.TaskRunReasonCancelled.String())
+		}
+		counts.total = max(counts.created, len(t.TaskRunNames))
+	}
+	counts.required = t.PipelineTask.Matrix.GetMinSuccessful(counts.total)
+	return counts
+}
+
+// isMatrixFailFastTriggered returns true if the PipelineTask is matrixed with failurePolicy.failFast and
+// so many runs have failed that failurePolicy.minSuccessful can no longer be reached.
+func (t ResolvedPipelineTask) isMatrixFailFastTriggered() bool {
+	if !t.hasMatrixFailurePolicy() || !t.PipelineTask.Matrix.IsFailFast() {
+		return false
+	}
+	counts := t.countMatrixRuns()
+	return counts.failed > counts.total-counts.required
+}
+
+// IsMatrixFailFastCancelling returns true if the remaining TaskRuns or CustomRuns of a matrixed PipelineTask
+// with failurePolicy.failFast must be cancelled, since the PipelineTask can no longer succeed.
+func (t ResolvedPipelineTask) IsMatrixFailFastCancelling() bool {
+	return t.isMatrixFailFastTriggered() && !t.areScheduledRunsDone()
+}
+
 // isValidationFailed return true if the task is failed at the validation step
 func (t ResolvedPipelineTask) isValidationFailed(ftasks []*ResolvedPipelineTask) bool {
 	for _, ftask := range ftasks {
@@ -361,7 +555,11 @@ func (t ResolvedPipelineTask) isCancelledForTimeOut() bool {
 
 // isCancelled returns true only if the run is cancelled
 // If the PipelineTask has a Matrix, isCancelled returns true if any run is cancelled and all other runs are done.
+// Runs cancelled by failurePolicy.failFast do not make the PipelineTask cancelled.
 func (t ResolvedPipelineTask) isCancelled() bool {
+	if t.isMatrixFailFastTriggered() {
+		return false
+	}
 	if t.IsCustomTask() {
 		if len(t.CustomRuns) == 0 {
 			return false
@@ -462,7 +660,9 @@ func (t *ResolvedPipelineTask) skip(facts *PipelineRunFacts) TaskSkipStatus {
 	switch {
 	case facts.isFinalTask(t.PipelineTask.Name) || t.isScheduled() || t.isValidationFailed(facts.ValidationFailedTask):
 		skippingReason = v1.None
-	case facts.IsStopping():
+	case facts.CarriedOverSkips.Has(t.PipelineTask.Name):
+		skippingReason = v1.WhenExpressionsSkip
+	case facts.IsStopping() && !t.PipelineTask.HandlesFailures():
 		skippingReason = v1.StoppingSkip
 	case facts.IsGracefullyCancelled():
 		skippingReason = v1.GracefullyCancelledSkip
@@ -472,6 +672,8 @@ func (t *ResolvedPipelineTask) skip(facts *PipelineRunFacts) TaskSkipStatus {
 		skippingReason = v1.WhenExpressionsSkip
 	case t.skipBecauseParentTaskWasSkipped(facts):
 		skippingReason = v1.ParentTasksSkip
+	case t.skipBecauseRunAfterTriggersWereNotMet(facts):
+		skippingReason = v1.RunAfterTriggersSkip
 	case t.skipBecauseResultReferencesAreMissing(facts):
 		skippingReason = v1.MissingResultsSkip
 	case t.skipBecausePipelineRunPipelineTimeoutReached(facts):
@@ -496,6 +698,7 @@ func (t *ResolvedPipelineTask) skip(facts *PipelineRunFacts) TaskSkipStatus {
 // (3) its parent task was skipped
 // (4) Pipeline is in stopping state (one of the PipelineTasks failed)
 // (5) Pipeline is gracefully cancelled or stopped
+// (6) the outcome of a parent task doesn't match its runAfter trigger
 func (t *ResolvedPipelineTask) Skip(facts *PipelineRunFacts) TaskSkipStatus {
 	if facts.SkipCache == nil {
 		facts.SkipCache = make(map[string]TaskSkipStatus)
@@ -529,9 +732,10 @@ func (t *ResolvedPipelineTask) skipBecauseParentTaskWasSkipped(facts *PipelineRu
 	for _, p := range node.Prev {
 		parentTask := stateMap[p.Key]
 		if parentSkipStatus := parentTask.Skip(facts); parentSkipStatus.IsSkipped {
-			// if the parent task was skipped due to its `when` expressions,
-			// then we should ignore that and continue evaluating if we should skip because of other parent tasks
-			if parentSkipStatus.SkippingReason == v1.WhenExpressionsSkip {
+			// if the parent task was skipped due to its `when` expressions, or if the task runs after the
+			// parent task whatever its outcome, then we should ignore that and continue evaluating if we
+			// should skip because of other parent tasks
+			if parentSkipStatus.SkippingReason == v1.WhenExpressionsSkip || t.PipelineTask.RunAfterTriggerFor(p.Key) == v1.RunAfterAlways {
 				continue
 			}
 			return true
@@ -540,6 +744,33 @@ func (t *ResolvedPipelineTask) skipBecauseParentTaskWasSkipped(facts *PipelineRu
 	return false
 }
 
+// skipBecauseRunAfterTriggersWereNotMet returns true once all the parent tasks are done if the outcome
+// of a parent task doesn't match the trigger set for it in runAfterTriggers
+func (t *ResolvedPipelineTask) skipBecauseRunAfterTriggersWereNotMet(facts *PipelineRunFacts) bool {
+	if len(t.PipelineTask.RunAfterTriggers) == 0 || !t.checkParentsDone(facts) {
+		return false
+	}
+	stateMap := facts.State.ToMap()
+	for _, trigger := range t.PipelineTask.RunAfterTriggers {
+		parentTask, ok := stateMap[trigger.Task]
+		if !ok {
+			continue
+		}
+		failed := parentTask.isFailure() || parentTask.isValidationFailed(facts.ValidationFailedTask)
+		switch trigger.Condition {
+		case v1.RunAfterOnSuccess:
+			if failed {
+				return true
+			}
+		case v1.RunAfterOnFailure:
+			if !failed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // skipBecauseResultReferencesAreMissing checks if the task references results that cannot be resolved, which is a
 // reason for skipping the task, and applies result references if found
 func (t *ResolvedPipelineTask) skipBecauseResultReferencesAreMissing(facts *PipelineRunFacts) bool {
@@ -733,6 +964,7 @@ func ResolvePipelineTask(
 
 	switch {
 	case rpt.IsChildPipeline():
+		rpt.MaxConcurrency = getMaxConcurrency(ctx, rpt.PipelineTask)
 		rpt.ChildPipelineRunNames = GetNamesOfChildPipelineRuns(
 			pipelineRun.Status.ChildReferences,
 			pipelineTask.Name,
@@ -747,7 +979,9 @@ func ResolvePipelineTask(
 		}
 
 	case rpt.IsCustomTask():
+		rpt.MaxConcurrency = getMaxConcurrency(ctx, rpt.PipelineTask)
 		rpt.CustomRunNames = getNamesOfCustomRuns(pipelineRun.Status.ChildReferences, pipelineTask.Name, pipelineRun.Name, numCombinations)
+		rpt.CarriedOver = isCarriedOverChild(pipelineRun.Status.ChildReferences, pipelineTask.Name)
 		for _, runName := range rpt.CustomRunNames {
 			run, err := getRun(runName)
 			if err != nil && !kerrors.IsNotFound(err) {
@@ -757,14 +991,28 @@ func ResolvePipelineTask(
 				rpt.CustomRuns = append(rpt.CustomRuns, run)
 			}
 		}
+		if rpt.CarriedOver && len(rpt.CustomRuns) == 0 {
+			rpt.CarriedOver = false
+			rpt.CustomRunNames = getNewRunNames(pipelineTask.Name, pipelineRun.Name, numCombinations)
+		}
 
 	default:
+		rpt.MaxConcurrency = getMaxConcurrency(ctx, rpt.PipelineTask)
 		rpt.TaskRunNames = GetNamesOfTaskRuns(pipelineRun.Status.ChildReferences, pipelineTask.Name, pipelineRun.Name, numCombinations)
+		rpt.Cached = isCachedChild(pipelineRun.Status.ChildReferences, pipelineTask.Name)
+		rpt.CarriedOver = isCarriedOverChild(pipelineRun.Status.ChildReferences, pipelineTask.Name)
 		for _, taskRunName := range rpt.TaskRunNames {
 			if err := rpt.setTaskRunsAndResolvedTask(ctx, taskRunName, getTask, getTaskRun, pipelineTask); err != nil {
 				return nil, err
 			}
 		}
+		// The reused TaskRun belongs to another PipelineRun and may have been deleted since,
+		// in which case the PipelineTask is scheduled again under a name of its own.
+		if (rpt.Cached || rpt.CarriedOver) && len(rpt.TaskRuns) == 0 {
+			rpt.Cached = false
+			rpt.CarriedOver = false
+			rpt.TaskRunNames = getNewRunNames(pipelineTask.Name, pipelineRun.Name, numCombinations)
+		}
 	}
 
 	return &rpt, nil
@@ -896,6 +1144,15 @@ func resolveTask(
 	return rt, nil
 }
 
+// getMaxConcurrency returns the maximum number of runs of the PipelineTask running at once, 0 if it is
+// not matrixed or its runs are not bounded.
+func getMaxConcurrency(ctx context.Context, pipelineTask *v1.PipelineTask) int {
+	if !pipelineTask.IsMatrixed() {
+		return 0
+	}
+	return pipelineTask.Matrix.GetMaxConcurrency(ctx)
+}
+
 // GetTaskRunName should return a unique name for a `TaskRun` if one has not already been defined, and the existing one otherwise.
 func GetTaskRunName(childRefs []v1.ChildStatusReference, ptName, prName string) string {
 	for _, cr := range childRefs {
@@ -908,7 +1165,8 @@ func GetTaskRunName(childRefs []v1.ChildStatusReference, ptName, prName string)
 
 // GetNamesOfTaskRuns should return unique names for `TaskRuns` if one has not already been defined, and the existing one otherwise.
 func GetNamesOfTaskRuns(childRefs []v1.ChildStatusReference, ptName, prName string, numberOfTaskRuns int) []string {
-	if taskRunNames := getTaskRunNamesFromChildRefs(childRefs, ptName); taskRunNames != nil {
+	// A matrixed PipelineTask running with a bounded maxConcurrency has only some of its TaskRuns created
+	if taskRunNames := getTaskRunNamesFromChildRefs(childRefs, ptName); len(taskRunNames) >= numberOfTaskRuns {
 		return taskRunNames
 	}
 	return getNewRunNames(ptName, prName, numberOfTaskRuns)
@@ -917,7 +1175,8 @@ func GetNamesOfTaskRuns(childRefs []v1.ChildStatusReference, ptName, prName stri
 // GetNamesOfChildPipelineRuns should return unique names for child PipelineRuns if one has not already been
 // defined, and the existing one otherwise.
 func GetNamesOfChildPipelineRuns(childRefs []v1.ChildStatusReference, ptName, prName string, numberOfPipelineRuns int) []string {
-	if pipelineRunNames := getChildPipelineRunNamesFromChildRefs(childRefs, ptName); pipelineRunNames != nil {
+	// A matrixed PipelineTask running with a bounded maxConcurrency has only some of its child PipelineRuns created
+	if pipelineRunNames := getChildPipelineRunNamesFromChildRefs(childRefs, ptName); len(pipelineRunNames) >= numberOfPipelineRuns {
 		return pipelineRunNames
 	}
 	return getNewRunNames(ptName, prName, numberOfPipelineRuns)
@@ -934,6 +1193,27 @@ func getTaskRunNamesFromChildRefs(childRefs []v1.ChildStatusReference, ptName st
 	return taskRunNames
 }
 
+// isCachedChild returns true if the TaskRun of the named Pipeline Task was reused from the cache.
+func isCachedChild(childRefs []v1.ChildStatusReference, ptName string) bool {
+	for _, cr := range childRefs {
+		if cr.Kind == pipeline.TaskRunControllerName && cr.PipelineTaskName == ptName && cr.Cached {
+			return true
+		}
+	}
+	return false
+}
+
+// isCarriedOverChild returns true if the runs of the named Pipeline Task were carried over
+// from the PipelineRun referenced by rerunOf.
+func isCarriedOverChild(childRefs []v1.ChildStatusReference, ptName string) bool {
+	for _, cr := range childRefs {
+		if cr.PipelineTaskName == ptName && cr.CarriedOver {
+			return true
+		}
+	}
+	return false
+}
+
 func getNewRunNames(ptName, prName string, numberOfRuns int) []string {
 	var runNames []string
 	// If it is a singular PipelineRun/TaskRun/CustomRun, we only append the ptName
@@ -977,7 +1257,8 @@ func getCustomRunName(childRefs []v1.ChildStatusReference, ptName, prName string
 // getNamesOfCustomRuns should return a unique names for `CustomRuns` if they have not already been defined,
 // and the existing ones otherwise.
 func getNamesOfCustomRuns(childRefs []v1.ChildStatusReference, ptName, prName string, numberOfRuns int) []string {
-	if customRunNames := getRunNamesFromChildRefs(childRefs, ptName); customRunNames != nil {
+	// A matrixed PipelineTask running with a bounded maxConcurrency has only some of its CustomRuns created
+	if customRunNames := getRunNamesFromChildRefs(childRefs, ptName); len(customRunNames) >= numberOfRuns {
 		return customRunNames
 	}
 	return getNewRunNames(ptName, prName, numberOfRuns)
@@ -1083,16 +1364,37 @@ func CheckMissingResultReferences(pipelineRunState PipelineRunState, target *Res
 }
 
 // createResultsCacheMatrixedTaskRuns creates a cache of results that have been fanned out from a
-// referenced matrixed PipelineTask so that you can easily access these results in subsequent Pipeline Tasks
+// referenced matrixed PipelineTask so that you can easily access these results in subsequent Pipeline Tasks.
+// The results of a matrixed PipelineTask referencing a child Pipeline are read from its child PipelineRuns.
 func createResultsCacheMatrixedTaskRuns(rpt *ResolvedPipelineTask) (resultsCache map[string][]string) {
 	if len(rpt.ResultsCache) == 0 {
 		resultsCache = make(map[string][]string)
 	}
+	if rpt.IsChildPipeline() {
+		// Sort the child PipelineRuns by name to ensure the order is deterministic
+		sort.Slice(rpt.ChildPipelineRuns, func(i, j int) bool {
+			return rpt.ChildPipelineRuns[i].Name < rpt.ChildPipelineRuns[j].Name
+		})
+		for _, childPipelineRun := range rpt.ChildPipelineRuns {
+			// The results of the failed Combinations are ignored
+			if childPipelineRun.IsFailure() {
+				continue
+			}
+			for _, result := range childPipelineRun.Status.Results {
+				resultsCache[result.Name] = append(resultsCache[result.Name], result.Value.StringVal)
+			}
+		}
+		return resultsCache
+	}
 	// Sort the taskRuns by name to ensure the order is deterministic
 	sort.Slice(rpt.TaskRuns, func(i, j int) bool {
 		return rpt.TaskRuns[i].Name < rpt.TaskRuns[j].Name
 	})
 	for _, taskRun := range rpt.TaskRuns {
+		// The results of the failed Combinations are ignored
+		if taskRun.IsFailure() {
+			continue
+		}
 		results := taskRun.Status.Results
 		for _, result := range results {
 			resultsCache[result.Name] = append(resultsCache[result.Name], result.Value.StringVal)
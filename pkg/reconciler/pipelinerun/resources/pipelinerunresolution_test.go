@@ -43,6 +43,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	logtesting "knative.dev/pkg/logging/testing"
@@ -2567,6 +2568,101 @@ func TestSkipBecauseParentTaskWasSkipped(t *testing.T) {
 	}
 }
 
+func TestSkipWithRunAfterTriggers(t *testing.T) {
+	deploy := v1.PipelineTask{Name: "deploy", TaskRef: &v1.TaskRef{Name: "task"}}
+	verify := v1.PipelineTask{Name: "verify", TaskRef: &v1.TaskRef{Name: "task"}, RunAfter: []string{"deploy"}}
+	rollback := v1.PipelineTask{
+		Name:             "rollback",
+		TaskRef:          &v1.TaskRef{Name: "task"},
+		RunAfter:         []string{"deploy"},
+		RunAfterTriggers: []v1.RunAfterTrigger{{Task: "deploy", Condition: v1.RunAfterOnFailure}},
+	}
+	promote := v1.PipelineTask{
+		Name:             "promote",
+		TaskRef:          &v1.TaskRef{Name: "task"},
+		RunAfter:         []string{"deploy"},
+		RunAfterTriggers: []v1.RunAfterTrigger{{Task: "deploy", Condition: v1.RunAfterOnSuccess}},
+	}
+	report := v1.PipelineTask{
+		Name:             "report",
+		TaskRef:          &v1.TaskRef{Name: "task"},
+		RunAfter:         []string{"rollback"},
+		RunAfterTriggers: []v1.RunAfterTrigger{{Task: "rollback", Condition: v1.RunAfterAlways}},
+	}
+	newState := func(deployRun *v1.TaskRun) PipelineRunState {
+		state := PipelineRunState{{
+			PipelineTask: &deploy,
+			TaskRunNames: []string{"pipelinerun-deploy"},
+			TaskRuns:     []*v1.TaskRun{deployRun},
+		}}
+		for _, pt := range []v1.PipelineTask{verify, rollback, promote, report} {
+			state = append(state, &ResolvedPipelineTask{
+				PipelineTask: &pt,
+				TaskRunNames: []string{"pipelinerun-" + pt.Name},
+			})
+		}
+		return state
+	}
+	for _, tc := range []struct {
+		name      string
+		state     PipelineRunState
+		expected  map[string]v1.SkippingReason
+		wantQueue []string
+	}{{
+		name:  "deploy succeeded",
+		state: newState(makeSucceeded(trs[0])),
+		expected: map[string]v1.SkippingReason{
+			"verify":   v1.None,
+			"rollback": v1.RunAfterTriggersSkip,
+			"promote":  v1.None,
+			"report":   v1.None,
+		},
+		wantQueue: []string{"verify", "promote", "report"},
+	}, {
+		name:  "deploy failed",
+		state: newState(makeFailed(trs[0])),
+		expected: map[string]v1.SkippingReason{
+			"verify":   v1.StoppingSkip,
+			"rollback": v1.None,
+			"promote":  v1.StoppingSkip,
+			"report":   v1.None,
+		},
+		wantQueue: []string{"rollback"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := dagFromState(tc.state)
+			if err != nil {
+				t.Fatalf("Could not get a dag from the TC state %#v: %v", tc.state, err)
+			}
+			stateMap := tc.state.ToMap()
+			facts := PipelineRunFacts{
+				State:           tc.state,
+				TasksGraph:      d,
+				FinalTasksGraph: &dag.Graph{},
+				TimeoutsState: PipelineRunTimeoutsState{
+					Clock: testClock,
+				},
+			}
+			for taskName, reason := range tc.expected {
+				if d := cmp.Diff(reason, stateMap[taskName].Skip(&facts).SkippingReason); d != "" {
+					t.Errorf("Didn't get expected skipping reason for task %s: %s", taskName, diff.PrintWantGot(d))
+				}
+			}
+			queue, err := facts.DAGExecutionQueue()
+			if err != nil {
+				t.Fatalf("unexpected error getting DAG execution queue: %s", err)
+			}
+			var gotQueue []string
+			for _, rpt := range queue {
+				gotQueue = append(gotQueue, rpt.PipelineTask.Name)
+			}
+			if d := cmp.Diff(tc.wantQueue, gotQueue); d != "" {
+				t.Errorf("Didn't get expected execution queue: %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func getExpectedMessage(runName string, specStatus v1.PipelineRunSpecStatus, status corev1.ConditionStatus,
 	successful, incomplete, skipped, failed, cancelled int,
 ) string {
@@ -5608,6 +5704,51 @@ func TestCreateResultsCacheMatrixedTaskRuns(t *testing.T) {
 		want: map[string][]string{
 			"": {""},
 		},
+	}, {
+		name: "failed matrixed taskrun results are ignored",
+		rpt: &ResolvedPipelineTask{
+			PipelineTask: matrixedPipelineTask,
+			TaskRuns: []*v1.TaskRun{{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "namespace",
+					Name:      "matrix-task-failed",
+				},
+				Status: v1.TaskRunStatus{
+					Status: duckv1.Status{Conditions: []apis.Condition{{
+						Type:   apis.ConditionSucceeded,
+						Status: corev1.ConditionFalse,
+					}}},
+					TaskRunStatusFields: v1.TaskRunStatusFields{
+						Results: []v1.TaskRunResult{{
+							Name:  "platform",
+							Type:  "string",
+							Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "mac"},
+						}},
+					},
+				},
+			}, {
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "namespace",
+					Name:      "matrix-task-succeeded",
+				},
+				Status: v1.TaskRunStatus{
+					Status: duckv1.Status{Conditions: []apis.Condition{{
+						Type:   apis.ConditionSucceeded,
+						Status: corev1.ConditionTrue,
+					}}},
+					TaskRunStatusFields: v1.TaskRunStatusFields{
+						Results: []v1.TaskRunResult{{
+							Name:  "platform",
+							Type:  "string",
+							Value: v1.ParamValue{Type: v1.ParamTypeString, StringVal: "linux"},
+						}},
+					},
+				},
+			}},
+		},
+		want: map[string][]string{
+			"platform": {"linux"},
+		},
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			got := createResultsCacheMatrixedTaskRuns(tc.rpt)
@@ -6326,3 +6467,236 @@ func TestSetChildPipelineRunsAndResolvedPipeline(t *testing.T) {
 		})
 	}
 }
+
+func TestRunsToSchedule(t *testing.T) {
+	taskRunNames := []string{trs[0].Name, trs[1].Name, trs[2].Name}
+	customRunNames := []string{customRuns[0].Name, customRuns[1].Name}
+	for _, tc := range []struct {
+		name string
+		rpt  ResolvedPipelineTask
+		want []int
+	}{{
+		name: "unbounded taskruns",
+		rpt: ResolvedPipelineTask{
+			PipelineTask: &v1.PipelineTask{Name: "task"},
+			TaskRunNames: taskRunNames,
+		},
+		want: []int{0, 1, 2},
+	}, {
+		name: "first window of taskruns",
+		rpt: ResolvedPipelineTask{
+			PipelineTask:   &v1.PipelineTask{Name: "task"},
+			TaskRunNames:   taskRunNames,
+			MaxConcurrency: 2,
+		},
+		want: []int{0, 1},
+	}, {
+		name: "window of taskruns full",
+		rpt: ResolvedPipelineTask{
+			PipelineTask:   &v1.PipelineTask{Name: "task"},
+			TaskRunNames:   taskRunNames,
+			TaskRuns:       []*v1.TaskRun{makeStarted(trs[0]), makeStarted(trs[1])},
+			MaxConcurrency: 2,
+		},
+	}, {
+		name: "taskrun of the window done",
+		rpt: ResolvedPipelineTask{
+			PipelineTask:   &v1.PipelineTask{Name: "task"},
+			TaskRunNames:   taskRunNames,
+			TaskRuns:       []*v1.TaskRun{makeFailed(trs[0]), makeStarted(trs[1])},
+			MaxConcurrency: 2,
+		},
+		want: []int{2},
+	}, {
+		name: "window of customruns",
+		rpt: ResolvedPipelineTask{
+			PipelineTask:   &v1.PipelineTask{Name: "task"},
+			CustomTask:     true,
+			CustomRunNames: customRunNames,
+			CustomRuns:     []*v1beta1.CustomRun{makeCustomRunSucceeded(customRuns[0])},
+			MaxConcurrency: 1,
+		},
+		want: []int{1},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := cmp.Diff(tc.want, tc.rpt.RunsToSchedule()); d != "" {
+				t.Errorf("RunsToSchedule() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestMatrixMaxConcurrencyStatus(t *testing.T) {
+	taskRunNames := []string{trs[0].Name, trs[1].Name, trs[2].Name}
+	for _, tc := range []struct {
+		name          string
+		taskRuns      []*v1.TaskRun
+		specStatus    v1.PipelineRunSpecStatus
+		wantRunning   bool
+		wantSucceeded bool
+		wantFailed    bool
+		wantAbandoned bool
+	}{{
+		name:        "window running",
+		taskRuns:    []*v1.TaskRun{makeSucceeded(trs[0]), makeStarted(trs[1])},
+		wantRunning: true,
+	}, {
+		name:     "failed taskrun does not fail the remaining combinations",
+		taskRuns: []*v1.TaskRun{makeFailed(trs[0]), makeSucceeded(trs[1])},
+	}, {
+		name:          "all combinations succeeded",
+		taskRuns:      []*v1.TaskRun{makeSucceeded(trs[0]), makeSucceeded(trs[1]), makeSucceeded(trs[2])},
+		wantSucceeded: true,
+	}, {
+		name:       "all combinations done with a failure",
+		taskRuns:   []*v1.TaskRun{makeFailed(trs[0]), makeSucceeded(trs[1]), makeSucceeded(trs[2])},
+		wantFailed: true,
+	}, {
+		name:          "remaining combinations abandoned when gracefully stopped",
+		taskRuns:      []*v1.TaskRun{makeSucceeded(trs[0]), makeSucceeded(trs[1])},
+		specStatus:    v1.PipelineRunSpecStatusStoppedRunFinally,
+		wantAbandoned: true,
+	}, {
+		name:        "running window not abandoned when gracefully stopped",
+		taskRuns:    []*v1.TaskRun{makeSucceeded(trs[0]), makeStarted(trs[1])},
+		specStatus:  v1.PipelineRunSpecStatusStoppedRunFinally,
+		wantRunning: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			rpt := &ResolvedPipelineTask{
+				PipelineTask:   &v1.PipelineTask{Name: "task"},
+				TaskRunNames:   taskRunNames,
+				TaskRuns:       tc.taskRuns,
+				MaxConcurrency: 2,
+			}
+			state := PipelineRunState{rpt}
+			d, err := dagFromState(state)
+			if err != nil {
+				t.Fatalf("Could not get a dag from the state %#v: %v", state, err)
+			}
+			facts := &PipelineRunFacts{
+				State:           state,
+				SpecStatus:      tc.specStatus,
+				TasksGraph:      d,
+				FinalTasksGraph: &dag.Graph{},
+				TimeoutsState: PipelineRunTimeoutsState{
+					Clock: testClock,
+				},
+			}
+			if got := rpt.IsRunning(); got != tc.wantRunning {
+				t.Errorf("expected IsRunning() to be %t but got %t", tc.wantRunning, got)
+			}
+			if got := rpt.isSuccessful(); got != tc.wantSucceeded {
+				t.Errorf("expected isSuccessful() to be %t but got %t", tc.wantSucceeded, got)
+			}
+			if got := rpt.isFailure(); got != tc.wantFailed {
+				t.Errorf("expected isFailure() to be %t but got %t", tc.wantFailed, got)
+			}
+			if got := rpt.isAbandoned(facts); got != tc.wantAbandoned {
+				t.Errorf("expected isAbandoned() to be %t but got %t", tc.wantAbandoned, got)
+			}
+			wantDone := tc.wantSucceeded || tc.wantFailed || tc.wantAbandoned
+			if got := rpt.isDone(facts); got != wantDone {
+				t.Errorf("expected isDone() to be %t but got %t", wantDone, got)
+			}
+		})
+	}
+}
+
+func TestMatrixFailurePolicyStatus(t *testing.T) {
+	taskRunNames := []string{trs[0].Name, trs[1].Name, trs[2].Name}
+	matrixedTask := func(failurePolicy *v1.MatrixFailurePolicy) *v1.PipelineTask {
+		return &v1.PipelineTask{Name: "task", Matrix: &v1.Matrix{
+			Params: v1.Params{{
+				Name: "platform", Value: v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: []string{"linux", "mac", "windows"}},
+			}},
+			FailurePolicy: failurePolicy,
+		}}
+	}
+	minSuccessful := intstr.FromInt32(2)
+	for _, tc := range []struct {
+		name           string
+		failurePolicy  *v1.MatrixFailurePolicy
+		taskRuns       []*v1.TaskRun
+		maxConcurrency int
+		wantSucceeded  bool
+		wantFailed     bool
+		wantCancelled  bool
+		wantCancelling bool
+		wantReason     string
+	}{{
+		name:          "minSuccessful reached",
+		failurePolicy: &v1.MatrixFailurePolicy{MinSuccessful: &minSuccessful},
+		taskRuns:      []*v1.TaskRun{makeFailed(trs[0]), makeSucceeded(trs[1]), makeSucceeded(trs[2])},
+		wantSucceeded: true,
+		wantReason:    v1.TaskRunReasonSuccessful.String(),
+	}, {
+		name:          "minSuccessful reached waits for all combinations",
+		failurePolicy: &v1.MatrixFailurePolicy{MinSuccessful: &minSuccessful},
+		taskRuns:      []*v1.TaskRun{makeSucceeded(trs[0]), makeSucceeded(trs[1]), makeStarted(trs[2])},
+	}, {
+		name:          "minSuccessful not reached",
+		failurePolicy: &v1.MatrixFailurePolicy{MinSuccessful: &minSuccessful},
+		taskRuns:      []*v1.TaskRun{makeFailed(trs[0]), makeFailed(trs[1]), makeSucceeded(trs[2])},
+		wantFailed:    true,
+		wantReason:    "Failed",
+	}, {
+		name:           "failFast cancels the remaining combinations",
+		failurePolicy:  &v1.MatrixFailurePolicy{FailFast: true},
+		taskRuns:       []*v1.TaskRun{makeFailed(trs[0]), makeStarted(trs[1]), makeStarted(trs[2])},
+		wantCancelling: true,
+	}, {
+		name:          "failFast fails once the remaining combinations are cancelled",
+		failurePolicy: &v1.MatrixFailurePolicy{FailFast: true},
+		taskRuns:      []*v1.TaskRun{makeFailed(trs[0]), withCancelled(makeFailed(trs[1])), makeSucceeded(trs[2])},
+		wantFailed:    true,
+		wantReason:    "Failed",
+	}, {
+		name:          "failFast tolerates the failures allowed by minSuccessful",
+		failurePolicy: &v1.MatrixFailurePolicy{FailFast: true, MinSuccessful: &minSuccessful},
+		taskRuns:      []*v1.TaskRun{makeFailed(trs[0]), makeStarted(trs[1]), makeStarted(trs[2])},
+	}, {
+		name:           "failFast does not create the unscheduled combinations",
+		failurePolicy:  &v1.MatrixFailurePolicy{FailFast: true},
+		taskRuns:       []*v1.TaskRun{makeFailed(trs[0])},
+		maxConcurrency: 1,
+		wantFailed:     true,
+		wantReason:     "Failed",
+	}, {
+		name:          "combinations cancelled without failFast",
+		failurePolicy: &v1.MatrixFailurePolicy{MinSuccessful: &minSuccessful},
+		taskRuns:      []*v1.TaskRun{withCancelled(makeFailed(trs[0])), withCancelled(makeFailed(trs[1])), makeSucceeded(trs[2])},
+		wantFailed:    true,
+		wantCancelled: true,
+		wantReason:    v1.TaskRunReasonCancelled.String(),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			rpt := &ResolvedPipelineTask{
+				PipelineTask:   matrixedTask(tc.failurePolicy),
+				TaskRunNames:   taskRunNames,
+				TaskRuns:       tc.taskRuns,
+				MaxConcurrency: tc.maxConcurrency,
+			}
+			if got := rpt.isSuccessful(); got != tc.wantSucceeded {
+				t.Errorf("expected isSuccessful() to be %t but got %t", tc.wantSucceeded, got)
+			}
+			if got := rpt.isFailure(); got != tc.wantFailed {
+				t.Errorf("expected isFailure() to be %t but got %t", tc.wantFailed, got)
+			}
+			if got := rpt.isCancelled(); got != tc.wantCancelled {
+				t.Errorf("expected isCancelled() to be %t but got %t", tc.wantCancelled, got)
+			}
+			if got := rpt.IsMatrixFailFastCancelling(); got != tc.wantCancelling {
+				t.Errorf("expected IsMatrixFailFastCancelling() to be %t but got %t", tc.wantCancelling, got)
+			}
+			if len(rpt.RunsToSchedule()) != 0 {
+				t.Errorf("expected no combination to schedule but got %v", rpt.RunsToSchedule())
+			}
+			if tc.wantReason != "" {
+				if got := rpt.getReason(); got != tc.wantReason {
+					t.Errorf("expected getReason() to be %s but got %s", tc.wantReason, got)
+				}
+			}
+		})
+	}
+}
@@ -83,6 +83,10 @@ type PipelineRunFacts struct {
 	// condition to help users understand why specific tasks were not executed
 	// (e.g. missing result references).
 	ValidationFailedErrors map[string]string
+
+	// CarriedOverSkips are the names of the PipelineTasks skipped because of their when
+	// expressions in the PipelineRun referenced by rerunOf, which are skipped again.
+	CarriedOverSkips sets.Set[string]
 }
 
 // PipelineRunTimeoutsState records information about start times and timeouts for the PipelineRun, so that the PipelineRunFacts
@@ -151,6 +155,10 @@ func (state PipelineRunState) IsBeforeFirstTaskRun() bool {
 func (state PipelineRunState) AdjustStartTime(unadjustedStartTime *metav1.Time) *metav1.Time {
 	adjustedStartTime := unadjustedStartTime
 	for _, rpt := range state {
+		// Reused runs were created for another PipelineRun
+		if rpt.Cached || rpt.CarriedOver {
+			continue
+		}
 		for _, childPipelineRun := range rpt.ChildPipelineRuns {
 			if childPipelineRun.CreationTimestamp.Time.Before(adjustedStartTime.Time) {
 				adjustedStartTime = &childPipelineRun.CreationTimestamp
@@ -174,6 +182,33 @@ func (state PipelineRunState) AdjustStartTime(unadjustedStartTime *metav1.Time)
 	return adjustedStartTime.DeepCopy()
 }
 
+// ExecutionStartTime returns the earliest time the first Step of a TaskRun of the PipelineRun started, or a
+// CustomRun or child PipelineRun started executing, or nil if none has yet.
+func (state PipelineRunState) ExecutionStartTime() *metav1.Time {
+	var executionStartTime *metav1.Time
+	earliest := func(t *metav1.Time) {
+		if !t.IsZero() && (executionStartTime == nil || t.Before(executionStartTime)) {
+			executionStartTime = t
+		}
+	}
+	for _, rpt := range state {
+		// Reused runs were executed for another PipelineRun
+		if rpt.Cached || rpt.CarriedOver {
+			continue
+		}
+		for _, childPipelineRun := range rpt.ChildPipelineRuns {
+			earliest(childPipelineRun.Status.ExecutionStartTime)
+		}
+		for _, customRun := range rpt.CustomRuns {
+			earliest(customRun.Status.StartTime)
+		}
+		for _, taskRun := range rpt.TaskRuns {
+			earliest(taskRun.Status.ExecutionStartTime)
+		}
+	}
+	return executionStartTime.DeepCopy()
+}
+
 // GetTaskRunsResults returns a map of all completed TaskRuns in the state, with the pipeline task name as
 // the key and the results from the corresponding TaskRun as the value. It includes tasks which have completed
 // successfully or with failure. Note: isFailure() returns true for ALL non-successful completed states including
@@ -182,15 +217,18 @@ func (state PipelineRunState) AdjustStartTime(unadjustedStartTime *metav1.Time)
 func (state PipelineRunState) GetTaskRunsResults() map[string][]v1.TaskRunResult {
 	results := make(map[string][]v1.TaskRunResult)
 	for _, rpt := range state {
-		if rpt.IsChildPipeline() {
-			continue
-		}
 		if rpt.IsCustomTask() {
 			continue
 		}
 		if !rpt.isSuccessful() && !rpt.isFailure() {
 			continue
 		}
+		if rpt.IsChildPipeline() {
+			if childPipelineRunResults := getChildPipelineRunsResults(rpt); len(childPipelineRunResults) > 0 {
+				results[rpt.PipelineTask.Name] = childPipelineRunResults
+			}
+			continue
+		}
 		if rpt.PipelineTask.IsMatrixed() {
 			taskRunResults := ConvertResultsMapToTaskRunResults(rpt.ResultsCache)
 			if len(taskRunResults) > 0 {
@@ -203,6 +241,26 @@ func (state PipelineRunState) GetTaskRunsResults() map[string][]v1.TaskRunResult
 	return results
 }
 
+// getChildPipelineRunsResults returns the results of the child PipelineRuns of a PipelineTask referencing a
+// child Pipeline, aggregated into arrays like the results of matrixed TaskRuns if the PipelineTask is matrixed.
+func getChildPipelineRunsResults(rpt *ResolvedPipelineTask) []v1.TaskRunResult {
+	if rpt.PipelineTask.IsMatrixed() {
+		if len(rpt.ResultsCache) == 0 {
+			rpt.ResultsCache = createResultsCacheMatrixedTaskRuns(rpt)
+		}
+		return ConvertResultsMapToTaskRunResults(rpt.ResultsCache)
+	}
+	var taskRunResults []v1.TaskRunResult
+	for _, result := range rpt.ChildPipelineRuns[0].Status.Results {
+		taskRunResults = append(taskRunResults, v1.TaskRunResult{
+			Name:  result.Name,
+			Type:  v1.ResultsType(result.Value.Type),
+			Value: result.Value,
+		})
+	}
+	return taskRunResults
+}
+
 // GetTaskRunsArtifacts returns a map of all completed TaskRuns in the state, with the pipeline task name as
 // the key and the artifacts from the corresponding TaskRun as the value. It includes tasks which have completed
 // successfully or with failure (including cancelled and timed-out, see GetTaskRunsResults comment).
@@ -382,6 +440,7 @@ func (t *ResolvedPipelineTask) getChildRefForRun(customRun *v1beta1.CustomRun) v
 		},
 		Name:             customRun.GetObjectMeta().GetName(),
 		PipelineTaskName: t.PipelineTask.Name,
+		CarriedOver:      t.CarriedOver,
 		WhenExpressions:  t.PipelineTask.When,
 	}
 	return t.getDisplayName(nil, customRun, nil, c)
@@ -395,20 +454,25 @@ func (t *ResolvedPipelineTask) getChildRefForTaskRun(taskRun *v1.TaskRun) v1.Chi
 		},
 		Name:             taskRun.Name,
 		PipelineTaskName: t.PipelineTask.Name,
+		Cached:           t.Cached,
+		CarriedOver:      t.CarriedOver,
 		WhenExpressions:  t.PipelineTask.When,
 	}
 	return t.getDisplayName(nil, nil, taskRun, c)
 }
 
 // getNextTasks returns a list of pipeline tasks which should be executed next i.e.
-// a list of tasks from candidateTasks which aren't yet indicated in state to be running and
-// a list of cancelled/failed tasks from candidateTasks which haven't exhausted their retries
+// a list of tasks from candidateTasks which aren't yet indicated in state to be running,
+// a list of cancelled/failed tasks from candidateTasks which haven't exhausted their retries and
+// a list of matrixed tasks from candidateTasks with runs left to create within their maxConcurrency
 func (state PipelineRunState) getNextTasks(candidateTasks sets.String) []*ResolvedPipelineTask {
 	tasks := []*ResolvedPipelineTask{}
 	for _, t := range state {
 		if _, ok := candidateTasks[t.PipelineTask.Name]; ok {
 			if len(t.TaskRuns) == 0 && len(t.CustomRuns) == 0 && len(t.ChildPipelineRuns) == 0 {
 				tasks = append(tasks, t)
+			} else if t.hasUnscheduledRuns() && len(t.RunsToSchedule()) > 0 {
+				tasks = append(tasks, t)
 			}
 		}
 	}
@@ -455,6 +519,11 @@ func (facts *PipelineRunFacts) IsGracefullyStopped() bool {
 	return facts.SpecStatus == v1.PipelineRunSpecStatusStoppedRunFinally
 }
 
+// IsPaused returns true if the PipelineRun was paused
+func (facts *PipelineRunFacts) IsPaused() bool {
+	return facts.SpecStatus == v1.PipelineRunSpecStatusPaused
+}
+
 // DAGExecutionQueue returns a list of DAG tasks which needs to be scheduled next
 func (facts *PipelineRunFacts) DAGExecutionQueue() (PipelineRunState, error) {
 	var tasks PipelineRunState
@@ -469,7 +538,18 @@ func (facts *PipelineRunFacts) DAGExecutionQueue() (PipelineRunState, error) {
 	if err != nil {
 		return tasks, err
 	}
-	if !facts.IsStopping() && !facts.IsGracefullyStopped() {
+	switch {
+	case facts.IsGracefullyStopped():
+	case facts.IsPaused():
+		// when pipelinerun is paused, hold the candidate tasks until it is resumed
+	case facts.IsStopping():
+		// when pipelinerun is stopping, only schedule the tasks handling the failures of their parent tasks
+		for _, t := range facts.State.getNextTasks(candidateTasks) {
+			if t.PipelineTask.HandlesFailures() {
+				tasks = append(tasks, t)
+			}
+		}
+	default:
 		tasks = facts.State.getNextTasks(candidateTasks)
 	}
 	return tasks, nil
@@ -542,6 +622,15 @@ func (facts *PipelineRunFacts) GetPipelineConditionStatus(ctx context.Context, p
 	// 2. All tasks are done and at least one has failed or has been cancelled -> Failed
 	// 3. All tasks are done or are skipped (i.e. condition check failed).-> Success
 	// 4. A Task or Condition is running right now or there are things left to run -> Running
+	if pr.HasQueueTimedOut(c) {
+		return &apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1.PipelineRunReasonQueueTimedOut.String(),
+			Message: fmt.Sprintf("PipelineRun %q did not start executing within the queue timeout %q", pr.Name, pr.Spec.Timeouts.Queue.Duration.String()),
+		}
+	}
+
 	if pr.HasTimedOut(ctx, c) {
 		return &apis.Condition{
 			Type:    apis.ConditionSucceeded,
@@ -653,6 +742,9 @@ func (facts *PipelineRunFacts) GetPipelineConditionStatus(ctx context.Context, p
 	case pr.IsGracefullyStopped():
 		// Transition pipeline into running finally state, when graceful stop is in progress
 		reason = v1.PipelineRunReasonStoppedRunningFinally.String()
+	case pr.IsPaused():
+		// No new Tasks are scheduled until the pipeline is resumed
+		reason = v1.PipelineRunReasonPaused.String()
 	case s.Cancelled > 0 || (s.Failed > 0 && facts.checkFinalTasksDone()):
 		// Transition pipeline into stopping state when one of the tasks(dag/final) cancelled or one of the dag tasks failed
 		// for a pipeline with final tasks, single dag task failure does not transition to interim stopping state
@@ -698,6 +790,43 @@ func (facts *PipelineRunFacts) GetSkippedTasks() []v1.SkippedTask {
 	return skipped
 }
 
+// GetCachedTasks constructs a list of the PipelineTasks whose TaskRun was reused from the cache
+func (facts *PipelineRunFacts) GetCachedTasks() []v1.CachedTask {
+	var cached []v1.CachedTask
+	for _, rpt := range facts.State {
+		if !rpt.Cached {
+			continue
+		}
+		for _, tr := range rpt.TaskRuns {
+			cached = append(cached, v1.CachedTask{
+				Name:        rpt.PipelineTask.Name,
+				TaskRunName: tr.Name,
+				CacheKey:    tr.Labels[pipeline.CacheKeyLabelKey],
+			})
+		}
+	}
+	return cached
+}
+
+// GetCarriedOverTasks constructs a list of the PipelineTasks carried over from the PipelineRun
+// referenced by rerunOf, whether their runs are reused or they are skipped again
+func (facts *PipelineRunFacts) GetCarriedOverTasks(rerunOf *v1.PipelineRunRef) []v1.CarriedOverTask {
+	if rerunOf == nil {
+		return nil
+	}
+	var carriedOver []v1.CarriedOverTask
+	for _, rpt := range facts.State {
+		if rpt.CarriedOver || facts.CarriedOverSkips.Has(rpt.PipelineTask.Name) {
+			carriedOver = append(carriedOver, v1.CarriedOverTask{
+				Name:            rpt.PipelineTask.Name,
+				PipelineRunName: rerunOf.Name,
+				Skipped:         !rpt.CarriedOver,
+			})
+		}
+	}
+	return carriedOver
+}
+
 // GetPipelineTaskStatus returns the status of a PipelineTask depending on its child
 // PipelineRun/TaskRun/CustomRun. The checks are implemented such that the finally tasks
 // are requesting status of the dag tasks.
@@ -730,7 +859,8 @@ func (facts *PipelineRunFacts) GetPipelineTaskStatus() map[string]string {
 		// will reset it to failed/skipped if needed
 		aggregateStatus = v1.PipelineRunReasonSuccessful.String()
 		for _, t := range facts.State {
-			if facts.isDAGTask(t.PipelineTask.Name) {
+			// a matrixed task with a failurePolicy may succeed while some of its runs failed
+			if facts.isDAGTask(t.PipelineTask.Name) && !t.isSuccessful() {
 				// if any of the dag pipeline tasks failed, change the aggregate status to failed and return
 				if t.IsChildPipeline() && t.haveAnyChildPipelineRunsFailed() {
 					aggregateStatus = v1.PipelineRunReasonFailed.String()
@@ -851,12 +981,15 @@ func (facts *PipelineRunFacts) getPipelineTasksCount() pipelineRunStatusCount {
 		case t.isCancelled():
 			s.Cancelled++
 		// increment failure counter based on Task OnError type since the task has failed
-		case t.isFailure():
+		case t.isFailure(), t.isAbandoned(facts) && t.haveAnyRunsFailed():
 			if t.PipelineTask.OnError == v1.PipelineTaskContinue {
 				s.IgnoredFailed++
 			} else {
 				s.Failed++
 			}
+		// increment cancelled counter since the remaining runs of the matrixed task will not be created
+		case t.isAbandoned(facts):
+			s.Cancelled++
 		case t.isValidationFailed(facts.ValidationFailedTask):
 			s.ValidationFailed++
 		// increment skipped and skipped due to timeout counters since the task was skipped due to the pipeline, tasks, or finally timeout being reached before the task was launched
@@ -1060,6 +1060,14 @@ func TestDAGExecutionQueue(t *testing.T) {
 			&runningTask, &runningRun, &runningChildPipeline,
 			&successfulTask, &successfulRun, &successfulChildPipeline,
 		},
+	}, {
+		name:       "paused",
+		specStatus: v1.PipelineRunSpecStatusPaused,
+		state: PipelineRunState{
+			&createdTask, &createdRun, &createdChildPipeline,
+			&runningTask, &runningRun, &runningChildPipeline,
+			&successfulTask, &successfulRun, &successfulChildPipeline,
+		},
 	}, {
 		name: "running",
 		state: PipelineRunState{
@@ -2789,6 +2797,72 @@ func TestAdjustStartTime(t *testing.T) {
 	}
 }
 
+func TestExecutionStartTime(t *testing.T) {
+	first := &metav1.Time{Time: now.Add(-2 * time.Second)}
+	second := &metav1.Time{Time: now.Add(-1 * time.Second)}
+
+	tests := []struct {
+		name string
+		prs  PipelineRunState
+		want *metav1.Time
+	}{{
+		name: "no run started executing",
+		prs: PipelineRunState{{
+			TaskRuns: []*v1.TaskRun{{
+				ObjectMeta: metav1.ObjectMeta{Name: "blah"},
+				Status: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime: first,
+				}},
+			}},
+		}},
+	}, {
+		name: "earliest taskrun and customrun",
+		prs: PipelineRunState{{
+			TaskRuns: []*v1.TaskRun{{
+				ObjectMeta: metav1.ObjectMeta{Name: "blah1"},
+				Status: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+					ExecutionStartTime: second,
+				}},
+			}},
+		}, {
+			CustomRuns: []*v1beta1.CustomRun{{
+				ObjectMeta: metav1.ObjectMeta{Name: "blah2"},
+				Status: v1beta1.CustomRunStatus{CustomRunStatusFields: v1beta1.CustomRunStatusFields{
+					StartTime: first,
+				}},
+			}},
+		}},
+		want: first,
+	}, {
+		name: "cached taskrun is ignored",
+		prs: PipelineRunState{{
+			Cached: true,
+			TaskRuns: []*v1.TaskRun{{
+				ObjectMeta: metav1.ObjectMeta{Name: "blah1"},
+				Status: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+					ExecutionStartTime: first,
+				}},
+			}},
+		}, {
+			TaskRuns: []*v1.TaskRun{{
+				ObjectMeta: metav1.ObjectMeta{Name: "blah2"},
+				Status: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+					ExecutionStartTime: second,
+				}},
+			}},
+		}},
+		want: second,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if d := cmp.Diff(test.want, test.prs.ExecutionStartTime()); d != "" {
+				t.Errorf("ExecutionStartTime() %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
 func TestPipelineRunFacts_GetPipelineTaskStatus(t *testing.T) {
 	tcs := []struct {
 		name           string
@@ -42,6 +42,7 @@ type ResolvedResultRef struct {
 	ResultReference v1.ResultRef
 	FromTaskRun     string
 	FromRun         string
+	FromPipelineRun string
 }
 
 // ResolveResultRef resolves any ResultReference that are found in the target ResolvedPipelineTask
@@ -132,6 +133,27 @@ func convertToResultRefs(pipelineRunState PipelineRunState, target *ResolvedPipe
 				return nil, resultRef.PipelineTask, err
 			}
 			resolvedResultRefs = append(resolvedResultRefs, resolved)
+		case referencedPipelineTask.IsChildPipeline():
+			// Matrixed referenced Pipeline Task referencing a child Pipeline
+			if referencedPipelineTask.PipelineTask.IsMatrixed() {
+				arrayValues, err := findResultValuesForMatrix(referencedPipelineTask, resultRef)
+				if err != nil {
+					return nil, resultRef.PipelineTask, err
+				}
+				for _, childPipelineRun := range referencedPipelineTask.ChildPipelineRuns {
+					resolvedResultRefs = append(resolvedResultRefs, &ResolvedResultRef{
+						Value:           arrayValues,
+						FromPipelineRun: childPipelineRun.Name,
+						ResultReference: *resultRef,
+					})
+				}
+			} else {
+				resolved, err := resolveChildPipelineResultRef(referencedPipelineTask.ChildPipelineRuns, resultRef)
+				if err != nil {
+					return nil, resultRef.PipelineTask, err
+				}
+				resolvedResultRefs = append(resolvedResultRefs, resolved)
+			}
 		default:
 			// Matrixed referenced Pipeline Task
 			if referencedPipelineTask.PipelineTask.IsMatrixed() {
@@ -201,6 +223,20 @@ func resolveResultRef(taskRuns []*v1.TaskRun, resultRef *v1.ResultRef) (*Resolve
 	}, nil
 }
 
+func resolveChildPipelineResultRef(childPipelineRuns []*v1.PipelineRun, resultRef *v1.ResultRef) (*ResolvedResultRef, error) {
+	childPipelineRun := childPipelineRuns[0]
+	for _, result := range childPipelineRun.Status.Results {
+		if result.Name == resultRef.Result {
+			return &ResolvedResultRef{
+				Value:           result.Value,
+				FromPipelineRun: childPipelineRun.Name,
+				ResultReference: *resultRef,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: Could not find result with name %s for pipeline task %s", ErrInvalidTaskResultReference, resultRef.Result, resultRef.PipelineTask)
+}
+
 func findRunResultForParam(customRun *v1beta1.CustomRun, reference *v1.ResultRef) (string, error) {
 	for _, result := range customRun.Status.Results {
 		if result.Name == reference.Result {
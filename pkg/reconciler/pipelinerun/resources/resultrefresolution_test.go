@@ -401,6 +401,71 @@ var pipelineRunState = PipelineRunState{{
 	},
 }}
 
+var childPipelineRunState = PipelineRunState{{
+	PipelineTask: &v1.PipelineTask{
+		Name:        "deploy",
+		PipelineRef: &v1.PipelineRef{Name: "deploy"},
+	},
+	ChildPipelineRunNames: []string{"deploy-pr"},
+	ChildPipelineRuns: []*v1.PipelineRun{
+		childPipelineRunWithResult("deploy-pr", "https://us-east.example.com"),
+	},
+}, {
+	PipelineTask: &v1.PipelineTask{
+		Name:        "deploy-matrixed",
+		PipelineRef: &v1.PipelineRef{Name: "deploy"},
+		Matrix: &v1.Matrix{Params: v1.Params{{
+			Name: "region", Value: *v1.NewStructuredValues("us-east", "eu-west"),
+		}}},
+	},
+	ChildPipelineRunNames: []string{"deploy-matrixed-pr-0", "deploy-matrixed-pr-1"},
+	ChildPipelineRuns: []*v1.PipelineRun{
+		childPipelineRunWithResult("deploy-matrixed-pr-1", "https://eu-west.example.com"),
+		childPipelineRunWithResult("deploy-matrixed-pr-0", "https://us-east.example.com"),
+	},
+}, {
+	PipelineTask: &v1.PipelineTask{
+		Name:    "notify",
+		TaskRef: &v1.TaskRef{Name: "notify"},
+		Params: v1.Params{{
+			Name: "url", Value: *v1.NewStructuredValues("$(tasks.deploy.results.url)"),
+		}},
+	},
+}, {
+	PipelineTask: &v1.PipelineTask{
+		Name:    "report",
+		TaskRef: &v1.TaskRef{Name: "report"},
+		Params: v1.Params{{
+			Name: "urls", Value: *v1.NewStructuredValues("$(tasks.deploy-matrixed.results.url[*])"),
+		}},
+	},
+}, {
+	PipelineTask: &v1.PipelineTask{
+		Name:    "audit",
+		TaskRef: &v1.TaskRef{Name: "audit"},
+		Params: v1.Params{{
+			Name: "digest", Value: *v1.NewStructuredValues("$(tasks.deploy.results.digest)"),
+		}},
+	},
+}}
+
+func childPipelineRunWithResult(name, url string) *v1.PipelineRun {
+	return &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.PipelineRunStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{successCondition},
+			},
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				Results: []v1.PipelineRunResult{{
+					Name:  "url",
+					Value: *v1.NewStructuredValues(url),
+				}},
+			},
+		},
+	}
+}
+
 func TestResolveResultRefs(t *testing.T) {
 	for _, tt := range []struct {
 		name             string
@@ -604,6 +669,43 @@ func TestResolveResultRefs(t *testing.T) {
 			},
 			FromTaskRun: "nTaskRun",
 		}},
+	}, {
+		name:             "Test successful child PipelineRun result references resolution",
+		pipelineRunState: childPipelineRunState,
+		targets: PipelineRunState{
+			childPipelineRunState[2],
+		},
+		want: ResolvedResultRefs{{
+			Value: *v1.NewStructuredValues("https://us-east.example.com"),
+			ResultReference: v1.ResultRef{
+				PipelineTask: "deploy",
+				Result:       "url",
+			},
+			FromPipelineRun: "deploy-pr",
+		}},
+	}, {
+		name:             "Test successful matrixed child PipelineRuns result references resolution",
+		pipelineRunState: childPipelineRunState,
+		targets: PipelineRunState{
+			childPipelineRunState[3],
+		},
+		want: ResolvedResultRefs{{
+			Value: *v1.NewStructuredValues("https://us-east.example.com", "https://eu-west.example.com"),
+			ResultReference: v1.ResultRef{
+				PipelineTask: "deploy-matrixed",
+				Result:       "url",
+			},
+			FromPipelineRun: "deploy-matrixed-pr-1",
+		}},
+	}, {
+		name:             "Test unsuccessful child PipelineRun result references resolution - missing result",
+		pipelineRunState: childPipelineRunState,
+		targets: PipelineRunState{
+			childPipelineRunState[4],
+		},
+		want:    nil,
+		wantErr: true,
+		wantPt:  "deploy",
 	}} {
 		t.Run(tt.name, func(t *testing.T) {
 			got, pt, err := ResolveResultRefs(tt.pipelineRunState, tt.targets)
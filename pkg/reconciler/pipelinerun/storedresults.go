@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+)
+
+// fetchStoredResults replaces the TaskRuns of the state whose results are stored in object storage
+// with copies holding the values of their results, so that they can be resolved like any other result.
+// The TaskRuns in the informer cache are left untouched.
+func (c *Reconciler) fetchStoredResults(ctx context.Context, pr *v1.PipelineRun, state resources.PipelineRunState) error {
+	var bucket *objectstorageresults.Bucket
+	for _, rpt := range state {
+		for i, tr := range rpt.TaskRuns {
+			if tr == nil || !objectstorageresults.HasStoredResults(tr.Status.Results) {
+				continue
+			}
+			if bucket == nil {
+				var err error
+				bucket, err = objectstorageresults.NewBucketFromConfig(ctx, c.secretLister, pr.Namespace)
+				if err != nil {
+					return err
+				}
+			}
+			results, err := objectstorageresults.FetchResults(ctx, c.resultsCache, bucket, tr.Status.Results)
+			if err != nil {
+				return fmt.Errorf("failed to fetch the results of TaskRun %s: %w", tr.Name, err)
+			}
+			tr = tr.DeepCopy()
+			tr.Status.Results = results
+			rpt.TaskRuns[i] = tr
+		}
+	}
+	return nil
+}
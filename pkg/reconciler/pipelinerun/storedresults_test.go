@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults/objectstoragetest"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFetchStoredResults(t *testing.T) {
+	value := `["foo","bar"]`
+	sum := sha256.Sum256([]byte(value))
+	key := "sha256/" + hex.EncodeToString(sum[:])
+	server := objectstoragetest.NewServer(t)
+	server.SetObject(key, []byte(value))
+
+	ctx := config.ToContext(t.Context(), &config.Config{
+		Defaults: &config.Defaults{
+			DefaultResultsObjectStorageEndpoint: server.URL,
+			DefaultResultsObjectStorageBucket:   objectstoragetest.BucketName,
+			DefaultResultsObjectStorageSecret:   "results-storage",
+		},
+	})
+	storedTaskRun := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "stored", Namespace: "foo"},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				Results: []v1.TaskRunResult{{
+					Name:  "array",
+					Type:  v1.ResultsTypeArray,
+					Value: v1.ResultValue{Type: v1.ParamTypeArray, ArrayVal: []string{}},
+					URI:   "s3://results/" + key,
+				}, {
+					Name:  "inline",
+					Type:  v1.ResultsTypeString,
+					Value: *v1.NewStructuredValues("hello"),
+				}},
+			},
+		},
+	}
+	inlineTaskRun := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "inline", Namespace: "foo"},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				Results: []v1.TaskRunResult{{
+					Name:  "inline",
+					Type:  v1.ResultsTypeString,
+					Value: *v1.NewStructuredValues("hello"),
+				}},
+			},
+		},
+	}
+	state := resources.PipelineRunState{
+		{TaskRuns: []*v1.TaskRun{storedTaskRun}},
+		{TaskRuns: []*v1.TaskRun{inlineTaskRun}},
+	}
+	cache, err := objectstorageresults.NewCache(10)
+	if err != nil {
+		t.Fatalf("NewCache: %v", err)
+	}
+	c := &Reconciler{secretLister: objectstoragetest.SecretLister(objectstoragetest.CredentialsSecret("foo", "results-storage")), resultsCache: cache}
+	pr := &v1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"}}
+	if err := c.fetchStoredResults(ctx, pr, state); err != nil {
+		t.Fatalf("fetchStoredResults: %v", err)
+	}
+
+	want := []v1.TaskRunResult{{
+		Name:  "array",
+		Type:  v1.ResultsTypeArray,
+		Value: *v1.NewStructuredValues("foo", "bar"),
+	}, {
+		Name:  "inline",
+		Type:  v1.ResultsTypeString,
+		Value: *v1.NewStructuredValues("hello"),
+	}}
+	if d := cmp.Diff(want, state[0].TaskRuns[0].Status.Results); d != "" {
+		t.Errorf("fetched results %s", diff.PrintWantGot(d))
+	}
+	if state[1].TaskRuns[0] != inlineTaskRun {
+		t.Error("expected the TaskRun without stored results to be left as is")
+	}
+	if storedTaskRun.Status.Results[0].URI == "" {
+		t.Error("expected the original TaskRun to be left untouched")
+	}
+}
+
+func TestFetchStoredResults_NotConfigured(t *testing.T) {
+	state := resources.PipelineRunState{{
+		TaskRuns: []*v1.TaskRun{{
+			ObjectMeta: metav1.ObjectMeta{Name: "stored", Namespace: "foo"},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{{
+						Name:  "result",
+						Type:  v1.ResultsTypeString,
+						Value: *v1.NewStructuredValues(""),
+						URI:   "s3://results/sha256/abc",
+					}},
+				},
+			},
+		}},
+	}}
+	c := &Reconciler{secretLister: objectstoragetest.SecretLister()}
+	pr := &v1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"}}
+	if err := c.fetchStoredResults(t.Context(), pr, state); err == nil {
+		t.Error("expected an error when the results bucket is not configured")
+	}
+}
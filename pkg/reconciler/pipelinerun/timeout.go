@@ -133,11 +133,11 @@ func timeoutPipelineTasks(ctx context.Context, logger *zap.SugaredLogger, pr *v1
 	return timeoutPipelineTasksForTaskNames(ctx, logger, pr, clientSet, sets.NewString())
 }
 
-// timeoutPipelineTasksForTaskNames patches `TaskRun`s and `Run`s for the given task names, or all if no task names are given, with canceled status and appropriate message
+// timeoutPipelineTasksForTaskNames patches `TaskRun`s, `Run`s and child `PipelineRun`s for the given task names, or all if no task names are given, with canceled status and appropriate message
 func timeoutPipelineTasksForTaskNames(ctx context.Context, logger *zap.SugaredLogger, pr *v1.PipelineRun, clientSet clientset.Interface, taskNames sets.String) []string {
 	errs := []string{}
 
-	trNames, customRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
+	trNames, customRunNames, childPipelineRunNames, err := getChildObjectsFromPRStatusForTaskNames(ctx, pr.Status, taskNames)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
@@ -163,5 +163,15 @@ func timeoutPipelineTasksForTaskNames(ctx context.Context, logger *zap.SugaredLo
 			continue
 		}
 	}
+
+	// A child PipelineRun has no status message to record the timeout of its parent, it is cancelled
+	for _, childPipelineRunName := range childPipelineRunNames {
+		logger.Infof("cancelling child PipelineRun %s for timeout", childPipelineRunName)
+
+		if err := requestPipelineRunCancellation(ctx, childPipelineRunName, pr.Namespace, clientSet); err != nil {
+			errs = append(errs, fmt.Errorf("failed to patch child PipelineRun `%s` with timeout: %w", childPipelineRunName, err).Error())
+			continue
+		}
+	}
 	return errs
 }
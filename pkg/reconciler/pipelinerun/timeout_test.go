@@ -39,6 +39,7 @@ func TestTimeoutPipelineRun(t *testing.T) {
 		pipelineRun           *v1.PipelineRun
 		taskRuns              []*v1.TaskRun
 		customRuns            []*v1beta1.CustomRun
+		childPipelineRuns     []*v1.PipelineRun
 		wantErr               bool
 	}{{
 		name: "no-resolved-taskrun",
@@ -232,6 +233,27 @@ func TestTimeoutPipelineRun(t *testing.T) {
 			{ObjectMeta: metav1.ObjectMeta{Name: "r1"}},
 			{ObjectMeta: metav1.ObjectMeta{Name: "r2"}},
 		},
+	}, {
+		name: "child-pipelineruns",
+		pipelineRun: &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline-run-timedout"},
+			Spec:       v1.PipelineRunSpec{},
+			Status: v1.PipelineRunStatus{PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				ChildReferences: []v1.ChildStatusReference{{
+					TypeMeta:         runtime.TypeMeta{Kind: pipelineRun},
+					Name:             "test-pipeline-run-timedout-child-0",
+					PipelineTaskName: "child",
+				}, {
+					TypeMeta:         runtime.TypeMeta{Kind: pipelineRun},
+					Name:             "test-pipeline-run-timedout-child-1",
+					PipelineTaskName: "child",
+				}},
+			}},
+		},
+		childPipelineRuns: []*v1.PipelineRun{
+			{ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline-run-timedout-child-0"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "test-pipeline-run-timedout-child-1"}},
+		},
 	}, {
 		name: "unknown-kind-on-child-references",
 		pipelineRun: &v1.PipelineRun{
@@ -250,7 +272,7 @@ func TestTimeoutPipelineRun(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			d := test.Data{
-				PipelineRuns: []*v1.PipelineRun{tc.pipelineRun},
+				PipelineRuns: append([]*v1.PipelineRun{tc.pipelineRun}, tc.childPipelineRuns...),
 				TaskRuns:     tc.taskRuns,
 				CustomRuns:   tc.customRuns,
 			}
@@ -301,6 +323,15 @@ func TestTimeoutPipelineRun(t *testing.T) {
 						}
 					}
 				}
+				for _, expectedChild := range tc.childPipelineRuns {
+					child, err := c.Pipeline.TektonV1().PipelineRuns("").Get(ctx, expectedChild.Name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatalf("couldn't get expected child PipelineRun %s, got error %s", expectedChild.Name, err)
+					}
+					if child.Spec.Status != v1.PipelineRunSpecStatusCancelled {
+						t.Errorf("expected child PipelineRun %q to be cancelled for timeout, was %q", child.Name, child.Spec.Status)
+					}
+				}
 			}
 		})
 	}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"knative.dev/pkg/controller"
+)
+
+// setWorkspaceCaches rewrites the hashFiles of the cache workspaces of a TaskRun, which are in
+// pipeline workspaces, to the workspaces of the task they are bound to.
+func setWorkspaceCaches(rpt *resources.ResolvedPipelineTask, workspaces []v1.WorkspaceBinding) error {
+	for i := range workspaces {
+		if workspaces[i].Cache == nil || len(workspaces[i].Cache.HashFiles) == 0 {
+			continue
+		}
+		cache := *workspaces[i].Cache
+		cache.HashFiles = slices.Clone(cache.HashFiles)
+		for j, f := range cache.HashFiles {
+			pipelineWorkspace, pattern, _ := strings.Cut(f, "/")
+			idx := slices.IndexFunc(rpt.PipelineTask.Workspaces, func(ws v1.WorkspacePipelineTaskBinding) bool {
+				return pipelineWorkspaceName(ws) == pipelineWorkspace
+			})
+			if idx < 0 {
+				// This error cannot be recovered without changing the Pipeline
+				return controller.NewPermanentError(fmt.Errorf("hashFiles %q of the cache of workspace %q is in workspace %q which is not bound to pipeline task %q",
+					f, workspaces[i].Name, pipelineWorkspace, rpt.PipelineTask.Name))
+			}
+			cache.HashFiles[j] = rpt.PipelineTask.Workspaces[idx].Name + "/" + pattern
+		}
+		workspaces[i].Cache = &cache
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+	"knative.dev/pkg/controller"
+)
+
+func TestSetWorkspaceCaches(t *testing.T) {
+	rpt := &resources.ResolvedPipelineTask{PipelineTask: &v1.PipelineTask{
+		Name: "build",
+		Workspaces: []v1.WorkspacePipelineTaskBinding{
+			{Name: "deps", Workspace: "go-cache"},
+			{Name: "src", Workspace: "source"},
+		},
+	}}
+	cache := &v1.WorkspaceCache{Key: "go-$(hash)", HashFiles: []string{"source/go.sum", "source/tools/*.mod"}}
+	workspaces := []v1.WorkspaceBinding{{Name: "deps", Cache: cache}, {Name: "src"}}
+	if err := setWorkspaceCaches(rpt, workspaces); err != nil {
+		t.Fatalf("setWorkspaceCaches: %v", err)
+	}
+	want := []v1.WorkspaceBinding{{
+		Name:  "deps",
+		Cache: &v1.WorkspaceCache{Key: "go-$(hash)", HashFiles: []string{"src/go.sum", "src/tools/*.mod"}},
+	}, {Name: "src"}}
+	if d := cmp.Diff(want, workspaces); d != "" {
+		t.Errorf("workspaces %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff([]string{"source/go.sum", "source/tools/*.mod"}, cache.HashFiles); d != "" {
+		t.Errorf("the cache of the PipelineRun should not be modified %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestSetWorkspaceCaches_WorkspaceNotBound(t *testing.T) {
+	rpt := &resources.ResolvedPipelineTask{PipelineTask: &v1.PipelineTask{
+		Name:       "build",
+		Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "deps", Workspace: "go-cache"}},
+	}}
+	workspaces := []v1.WorkspaceBinding{{Name: "deps", Cache: &v1.WorkspaceCache{Key: "go-$(hash)", HashFiles: []string{"source/go.sum"}}}}
+	err := setWorkspaceCaches(rpt, workspaces)
+	if !controller.IsPermanentError(err) {
+		t.Fatalf("setWorkspaceCaches() error = %v, want a permanent error", err)
+	}
+}
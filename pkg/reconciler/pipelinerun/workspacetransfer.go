@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"context"
+
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/controller"
+)
+
+// setWorkspaceTransfers fills in the snapshots the workspaces of a TaskRun bound with transfer are
+// restored from and snapshotted to. A workspace is restored from the snapshots of the same pipeline
+// workspace taken by the closest pipeline tasks the task runs after, and snapshotted under a key
+// unique to the TaskRun.
+func setWorkspaceTransfers(ctx context.Context, pr *v1.PipelineRun, rpt *resources.ResolvedPipelineTask, facts *resources.PipelineRunFacts, taskRunName string, workspaces []v1.WorkspaceBinding) error {
+	for i := range workspaces {
+		if workspaces[i].Transfer == nil {
+			continue
+		}
+		bucket := config.FromContextOrDefaults(ctx).Defaults.DefaultResultsObjectStorageBucket
+		if bucket == "" {
+			// This error cannot be recovered without configuring the results bucket
+			return controller.NewPermanentError(workspacetransfer.ErrNotConfigured)
+		}
+		var pipelineWorkspace string
+		for _, ws := range rpt.PipelineTask.Workspaces {
+			if ws.Name == workspaces[i].Name {
+				pipelineWorkspace = pipelineWorkspaceName(ws)
+			}
+		}
+		workspaces[i].Transfer = &v1.TransferWorkspace{
+			From: transferSources(rpt, facts, pipelineWorkspace),
+			To:   workspacetransfer.SnapshotURI(bucket, pr.Namespace, taskRunName, workspaces[i].Name),
+		}
+	}
+	return nil
+}
+
+// transferSources returns the snapshots of pipelineWorkspace a pipeline task restores, in the
+// order the pipeline tasks taking them are declared. They are taken by the parents of the task
+// binding pipelineWorkspace whose TaskRuns succeeded; the parents which did not take a snapshot
+// are looked through. Finally tasks run after the leaves of the tasks graph.
+func transferSources(rpt *resources.ResolvedPipelineTask, facts *resources.PipelineRunFacts, pipelineWorkspace string) []string {
+	var parents []*dag.Node
+	if facts.FinalTasksGraph != nil && rpt.IsFinalTask(facts) {
+		for _, node := range facts.TasksGraph.Nodes {
+			if len(node.Next) == 0 {
+				parents = append(parents, node)
+			}
+		}
+	} else if node, ok := facts.TasksGraph.Nodes[rpt.PipelineTask.Name]; ok {
+		parents = node.Prev
+	}
+
+	tasks := facts.State.ToMap()
+	snapshots := map[string][]string{}
+	visited := sets.New[string]()
+	for len(parents) > 0 {
+		node := parents[0]
+		parents = parents[1:]
+		if visited.Has(node.Key) {
+			continue
+		}
+		visited.Insert(node.Key)
+		if uris := taskSnapshots(tasks[node.Key], pipelineWorkspace); len(uris) > 0 {
+			snapshots[node.Key] = uris
+			continue
+		}
+		parents = append(parents, node.Prev...)
+	}
+
+	var from []string
+	for _, t := range facts.State {
+		from = append(from, snapshots[t.PipelineTask.Name]...)
+	}
+	return from
+}
+
+// taskSnapshots returns the snapshots of pipelineWorkspace taken by the successful TaskRuns of a pipeline task.
+func taskSnapshots(rpt *resources.ResolvedPipelineTask, pipelineWorkspace string) []string {
+	if rpt == nil {
+		return nil
+	}
+	var taskWorkspace string
+	for _, ws := range rpt.PipelineTask.Workspaces {
+		if pipelineWorkspaceName(ws) == pipelineWorkspace {
+			taskWorkspace = ws.Name
+			break
+		}
+	}
+	if taskWorkspace == "" {
+		return nil
+	}
+	var uris []string
+	for _, tr := range rpt.TaskRuns {
+		if tr == nil || !tr.IsSuccessful() {
+			continue
+		}
+		for _, wb := range tr.Spec.Workspaces {
+			if wb.Name == taskWorkspace && wb.Transfer != nil && wb.Transfer.To != "" {
+				uris = append(uris, wb.Transfer.To)
+			}
+		}
+	}
+	return uris
+}
+
+// pipelineWorkspaceName returns the name of the pipeline workspace bound to a workspace of a pipeline task.
+func pipelineWorkspaceName(ws v1.WorkspacePipelineTaskBinding) string {
+	if ws.Workspace != "" {
+		return ws.Workspace
+	}
+	return ws.Name
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pipelinerun
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/pipeline/internal/workspacetransfer"
+	"github.com/tektoncd/pipeline/pkg/apis/config"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipeline/dag"
+	"github.com/tektoncd/pipeline/pkg/reconciler/pipelinerun/resources"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func transferTaskRun(name string, status corev1.ConditionStatus, workspace string) *v1.TaskRun {
+	return &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "foo"},
+		Spec: v1.TaskRunSpec{
+			Workspaces: []v1.WorkspaceBinding{{
+				Name:     workspace,
+				Transfer: &v1.TransferWorkspace{To: workspacetransfer.SnapshotURI("results", "foo", name, workspace)},
+			}},
+		},
+		Status: v1.TaskRunStatus{Status: duckv1.Status{
+			Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: status}},
+		}},
+	}
+}
+
+func TestSetWorkspaceTransfers(t *testing.T) {
+	source := []v1.WorkspacePipelineTaskBinding{{Name: "source"}}
+	tasks := []v1.PipelineTask{
+		{Name: "fetch", Workspaces: source},
+		{Name: "lint", RunAfter: []string{"fetch"}, Workspaces: source},
+		{Name: "scan", RunAfter: []string{"fetch"}, Workspaces: source},
+		{Name: "build", RunAfter: []string{"fetch"}},
+		{Name: "test", RunAfter: []string{"lint", "scan", "build"}, Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "src", Workspace: "source"}}},
+	}
+	finallyTasks := []v1.PipelineTask{{Name: "report", Workspaces: source}}
+	tasksGraph, err := dag.Build(v1.PipelineTaskList(tasks), v1.PipelineTaskList(tasks).Deps())
+	if err != nil {
+		t.Fatal(err)
+	}
+	finalTasksGraph, err := dag.Build(v1.PipelineTaskList(finallyTasks), map[string][]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	facts := &resources.PipelineRunFacts{
+		State: resources.PipelineRunState{
+			{PipelineTask: &tasks[0], TaskRuns: []*v1.TaskRun{transferTaskRun("pr-fetch", corev1.ConditionTrue, "source")}},
+			{PipelineTask: &tasks[1], TaskRuns: []*v1.TaskRun{transferTaskRun("pr-lint", corev1.ConditionTrue, "source")}},
+			{PipelineTask: &tasks[2], TaskRuns: []*v1.TaskRun{transferTaskRun("pr-scan", corev1.ConditionFalse, "source")}},
+			{PipelineTask: &tasks[3]},
+			{PipelineTask: &tasks[4]},
+			{PipelineTask: &finallyTasks[0]},
+		},
+		TasksGraph:      tasksGraph,
+		FinalTasksGraph: finalTasksGraph,
+	}
+	ctx := config.ToContext(t.Context(), &config.Config{Defaults: &config.Defaults{DefaultResultsObjectStorageBucket: "results"}})
+	pr := &v1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"}}
+
+	for _, tc := range []struct {
+		name        string
+		rpt         *resources.ResolvedPipelineTask
+		taskRunName string
+		workspace   string
+		want        *v1.TransferWorkspace
+	}{{
+		name:        "first task",
+		rpt:         facts.State[0],
+		taskRunName: "pr-fetch",
+		workspace:   "source",
+		want:        &v1.TransferWorkspace{To: "s3://results/workspaces/foo/pr-fetch/source.tar.gz"},
+	}, {
+		name:        "task after successful, failed and not bound tasks",
+		rpt:         facts.State[4],
+		taskRunName: "pr-test",
+		workspace:   "src",
+		want: &v1.TransferWorkspace{
+			From: []string{"s3://results/workspaces/foo/pr-fetch/source.tar.gz", "s3://results/workspaces/foo/pr-lint/source.tar.gz"},
+			To:   "s3://results/workspaces/foo/pr-test/src.tar.gz",
+		},
+	}, {
+		name:        "finally task",
+		rpt:         facts.State[5],
+		taskRunName: "pr-report",
+		workspace:   "source",
+		want: &v1.TransferWorkspace{
+			From: []string{"s3://results/workspaces/foo/pr-fetch/source.tar.gz", "s3://results/workspaces/foo/pr-lint/source.tar.gz"},
+			To:   "s3://results/workspaces/foo/pr-report/source.tar.gz",
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			workspaces := []v1.WorkspaceBinding{{Name: tc.workspace, Transfer: &v1.TransferWorkspace{}}}
+			if err := setWorkspaceTransfers(ctx, pr, tc.rpt, facts, tc.taskRunName, workspaces); err != nil {
+				t.Fatalf("setWorkspaceTransfers() = %v", err)
+			}
+			if d := cmp.Diff(tc.want, workspaces[0].Transfer); d != "" {
+				t.Errorf("Transfer %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
+
+func TestSetWorkspaceTransfers_NotConfigured(t *testing.T) {
+	pt := &v1.PipelineTask{Name: "fetch", Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "source"}}}
+	tasksGraph, err := dag.Build(v1.PipelineTaskList{*pt}, map[string][]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rpt := &resources.ResolvedPipelineTask{PipelineTask: pt}
+	facts := &resources.PipelineRunFacts{State: resources.PipelineRunState{rpt}, TasksGraph: tasksGraph}
+	pr := &v1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: "pr", Namespace: "foo"}}
+	workspaces := []v1.WorkspaceBinding{{Name: "source", Transfer: &v1.TransferWorkspace{}}}
+	if err := setWorkspaceTransfers(t.Context(), pr, rpt, facts, "pr-fetch", workspaces); !errors.Is(err, workspacetransfer.ErrNotConfigured) {
+		t.Errorf("setWorkspaceTransfers() = %v, want %v", err, workspacetransfer.ErrNotConfigured)
+	}
+}
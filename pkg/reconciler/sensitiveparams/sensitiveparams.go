@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sensitiveparams moves the values of sensitive params out of the
+// TaskRuns and PipelineRuns specs into Secrets owned by the runs.
+package sensitiveparams
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/kmeta"
+)
+
+// SecretName returns the name of the Secret holding the values of the sensitive params of a run.
+func SecretName(runName string) string {
+	return kmeta.ChildName(runName, "-sensitive-params")
+}
+
+// MoveToSecret stores the values of the params declared sensitive in paramSpecs which are still set
+// inline in a Secret owned by the run, and returns a copy of the params in which they are replaced
+// by a reference to that Secret. The params are returned as-is if none of them needs to be moved.
+func MoveToSecret(ctx context.Context, kubeclient clientset.Interface, params v1.Params, paramSpecs v1.ParamSpecs, owner metav1.OwnerReference, namespace string, labels map[string]string) (v1.Params, error) {
+	sensitive := map[string]bool{}
+	for _, ps := range paramSpecs {
+		if ps.Sensitive {
+			sensitive[ps.Name] = true
+		}
+	}
+
+	data := map[string][]byte{}
+	for _, p := range params {
+		if sensitive[p.Name] && p.ValueFrom == nil {
+			data[p.Name] = []byte(p.Value.StringVal)
+		}
+	}
+	if len(data) == 0 {
+		return params, nil
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            SecretName(owner.Name),
+			Namespace:       namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+	if err := createSecret(ctx, kubeclient, secret); err != nil {
+		return nil, err
+	}
+
+	moved := params.DeepCopy()
+	for i := range moved {
+		if _, ok := data[moved[i].Name]; !ok {
+			continue
+		}
+		moved[i].Value = *v1.NewStructuredValues(v1.SensitiveParamRedactedValue)
+		moved[i].ValueFrom = &v1.ParamValueSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+				Key:                  moved[i].Name,
+			},
+		}
+	}
+	return moved, nil
+}
+
+// createSecret creates the Secret. The Secret already exists when the params of a previous reconciliation
+// could not be persisted: it is only reused if it is owned by the same run and holds the same values, so
+// that the controller never writes to a Secret it did not create.
+func createSecret(ctx context.Context, kubeclient clientset.Interface, secret *corev1.Secret) error {
+	_, err := kubeclient.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+		return nil
+	case !apierrors.IsAlreadyExists(err):
+		return fmt.Errorf("failed to create Secret %s for sensitive params: %w", secret.Name, err)
+	}
+
+	existing, err := kubeclient.CoreV1().Secrets(secret.Namespace).Get(ctx, secret.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get Secret %s for sensitive params: %w", secret.Name, err)
+	}
+	owner := secret.OwnerReferences[0]
+	if !slices.ContainsFunc(existing.OwnerReferences, func(ref metav1.OwnerReference) bool {
+		return ref.UID == owner.UID && ref.Kind == owner.Kind && ref.Name == owner.Name
+	}) {
+		return fmt.Errorf("the Secret %s for sensitive params already exists and is not owned by %s %s", secret.Name, owner.Kind, owner.Name)
+	}
+	for k, v := range secret.Data {
+		if !bytes.Equal(existing.Data[k], v) {
+			return fmt.Errorf("the Secret %s for sensitive params already exists with a different value for %q", secret.Name, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitiveparams_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/sensitiveparams"
+	"github.com/tektoncd/pipeline/test/diff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+)
+
+var owner = metav1.OwnerReference{
+	APIVersion: "tekton.dev/v1",
+	Kind:       "TaskRun",
+	Name:       "run",
+}
+
+var paramSpecs = v1.ParamSpecs{
+	{Name: "token", Type: v1.ParamTypeString, Sensitive: true},
+	{Name: "url", Type: v1.ParamTypeString},
+}
+
+func TestMoveToSecret(t *testing.T) {
+	kubeclient := fakek8s.NewSimpleClientset()
+	params := v1.Params{
+		{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")},
+		{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")},
+	}
+
+	got, err := sensitiveparams.MoveToSecret(t.Context(), kubeclient, params, paramSpecs, owner, "ns", map[string]string{"tekton.dev/taskRun": "run"})
+	if err != nil {
+		t.Fatalf("MoveToSecret() = %v", err)
+	}
+
+	want := v1.Params{{
+		Name:  "token",
+		Value: *v1.NewStructuredValues(v1.SensitiveParamRedactedValue),
+		ValueFrom: &v1.ParamValueSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "run-sensitive-params"},
+			Key:                  "token",
+		}},
+	}, {
+		Name:  "url",
+		Value: *v1.NewStructuredValues("https://tekton.dev"),
+	}}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("MoveToSecret() params %s", diff.PrintWantGot(d))
+	}
+	if params[0].ValueFrom != nil {
+		t.Errorf("MoveToSecret() modified the params: %v", params)
+	}
+
+	secret, err := kubeclient.CoreV1().Secrets("ns").Get(t.Context(), "run-sensitive-params", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the Secret: %v", err)
+	}
+	if d := cmp.Diff(map[string][]byte{"token": []byte("s3cr3t")}, secret.Data); d != "" {
+		t.Errorf("Secret data %s", diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff([]metav1.OwnerReference{owner}, secret.OwnerReferences); d != "" {
+		t.Errorf("Secret owner references %s", diff.PrintWantGot(d))
+	}
+
+	// the params already moved are left unchanged
+	again, err := sensitiveparams.MoveToSecret(t.Context(), kubeclient, got, paramSpecs, owner, "ns", nil)
+	if err != nil {
+		t.Fatalf("MoveToSecret() = %v", err)
+	}
+	if d := cmp.Diff(got, again); d != "" {
+		t.Errorf("MoveToSecret() params %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestMoveToSecret_ExistingSecret(t *testing.T) {
+	params := v1.Params{{Name: "token", Value: *v1.NewStructuredValues("s3cr3t")}}
+	for _, tc := range []struct {
+		name    string
+		secret  *corev1.Secret
+		wantErr bool
+	}{{
+		name: "created by a previous reconciliation",
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "run-sensitive-params", Namespace: "ns", OwnerReferences: []metav1.OwnerReference{owner}},
+			Data:       map[string][]byte{"token": []byte("s3cr3t")},
+		},
+	}, {
+		name: "not owned by the run",
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "run-sensitive-params", Namespace: "ns"},
+			Data:       map[string][]byte{"token": []byte("s3cr3t")},
+		},
+		wantErr: true,
+	}, {
+		name: "different value",
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "run-sensitive-params", Namespace: "ns", OwnerReferences: []metav1.OwnerReference{owner}},
+			Data:       map[string][]byte{"token": []byte("other")},
+		},
+		wantErr: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			kubeclient := fakek8s.NewSimpleClientset(tc.secret)
+			_, err := sensitiveparams.MoveToSecret(t.Context(), kubeclient, params, paramSpecs, owner, "ns", nil)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("MoveToSecret() = %v, wantErr %t", err, tc.wantErr)
+			}
+			for _, action := range kubeclient.Actions() {
+				if action.GetVerb() == "update" {
+					t.Errorf("MoveToSecret() updated the existing Secret: %v", action)
+				}
+			}
+		})
+	}
+}
+
+func TestMoveToSecret_NoSensitiveParams(t *testing.T) {
+	kubeclient := fakek8s.NewSimpleClientset()
+	params := v1.Params{{Name: "url", Value: *v1.NewStructuredValues("https://tekton.dev")}}
+
+	got, err := sensitiveparams.MoveToSecret(t.Context(), kubeclient, params, paramSpecs, owner, "ns", nil)
+	if err != nil {
+		t.Fatalf("MoveToSecret() = %v", err)
+	}
+	if d := cmp.Diff(params, got); d != "" {
+		t.Errorf("MoveToSecret() params %s", diff.PrintWantGot(d))
+	}
+	if len(kubeclient.Actions()) != 0 {
+		t.Errorf("MoveToSecret() called the API server: %v", kubeclient.Actions())
+	}
+}
@@ -19,6 +19,7 @@ package taskrun
 import (
 	"context"
 
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
 	"github.com/tektoncd/pipeline/internal/reconciler/cachetransform"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
@@ -110,10 +111,16 @@ func NewController(opts *pipeline.Options, clock clock.PassiveClock) func(contex
 			metrics:                  taskrunmetricsRecorder,
 			entrypointCache:          entrypointCache,
 			podLister:                podInformer.Lister(),
+			secretLister:             secretinformer.Lister(),
 			pvcHandler:               volumeclaim.NewPVCHandler(kubeclientset, logger),
 			resolutionRequester:      resolution.NewCRDRequester(resolutionclient.Get(ctx), resolutionInformer.Lister()),
 			tracerProvider:           tracerProvider,
+			resultsCache:             objectstorageresults.SharedCache(),
 		}
+		// The retained PVCs of both TaskRuns and PipelineRuns are collected here,
+		// since the TaskRun controller runs in every controller deployment.
+		go volumeclaim.CollectExpiredRetainedPVCs(ctx, c.pvcHandler, clock, logger)
+
 		impl := taskrunreconciler.NewImpl(ctx, c, func(impl *controller.Impl) controller.Options {
 			return controller.Options{
 				AgentName:         pipeline.TaskRunControllerName,
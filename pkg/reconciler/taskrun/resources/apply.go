@@ -23,6 +23,7 @@ import (
 	"maps"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -44,6 +45,8 @@ import (
 const (
 	// objectIndividualVariablePattern is the reference pattern for object individual keys params.<object_param_name>.<key_name>
 	objectIndividualVariablePattern = "params.%s.%s"
+	// sensitiveParamEnvPrefix is the prefix of the environment variables holding the values of sensitive params
+	sensitiveParamEnvPrefix = "TEKTON_PARAM_"
 )
 
 var (
@@ -63,6 +66,8 @@ var (
 		`^inputs\.params\.(\w+)$`,
 	}
 
+	invalidEnvNameCharacters = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
 	paramIndexRegexPatterns = []string{
 		`\$\(params.%s\[([0-9]*)*\*?\]\)`,
 		`\$\(params\[%q\]\[([0-9]*)*\*?\]\)`,
@@ -394,7 +399,7 @@ func getTaskParameters(spec *v1.TaskSpec, tr *v1.TaskRun, defaults ...v1.ParamSp
 // ApplyParameters applies the params from a TaskRun.Parameters to a TaskSpec
 func ApplyParameters(spec *v1.TaskSpec, tr *v1.TaskRun, defaults ...v1.ParamSpec) *v1.TaskSpec {
 	stringReplacements, arrayReplacements, objectReplacements := getTaskParameters(spec, tr, defaults...)
-	return ApplyReplacements(spec, stringReplacements, arrayReplacements, objectReplacements)
+	return applySensitiveParameters(ApplyReplacements(spec, stringReplacements, arrayReplacements, objectReplacements), tr.Spec.Params)
 }
 
 func replacementsFromDefaultParams(defaults v1.ParamSpecs) (map[string]string, map[string][]string, map[string]map[string]string) {
@@ -491,8 +496,13 @@ func replacementsFromParams(params v1.Params) (map[string]string, map[string][]s
 		case v1.ParamTypeString:
 			fallthrough
 		default:
+			value := p.Value.StringVal
+			if p.ValueFrom != nil {
+				// the value of a sensitive param is only known by the container, through its environment
+				value = fmt.Sprintf("$(%s)", sensitiveParamEnvName(p.Name))
+			}
 			for _, pattern := range paramPatterns {
-				stringReplacements[fmt.Sprintf(pattern, p.Name)] = p.Value.StringVal
+				stringReplacements[fmt.Sprintf(pattern, p.Name)] = value
 			}
 		}
 	}
@@ -500,6 +510,62 @@ func replacementsFromParams(params v1.Params) (map[string]string, map[string][]s
 	return stringReplacements, arrayReplacements, objectReplacements
 }
 
+// sensitiveParamEnvName returns the name of the environment variable holding the value of a sensitive param
+func sensitiveParamEnvName(paramName string) string {
+	return sensitiveParamEnvPrefix + strings.ToUpper(invalidEnvNameCharacters.ReplaceAllString(paramName, "_"))
+}
+
+// ValidateSensitiveParamReferences returns an error if a script references a param whose value is read from
+// a Secret. Its value is only injected through the environment, and no substitution in a script can expand it
+// safely whatever the quoting, so scripts must read the environment variable themselves.
+func ValidateSensitiveParamReferences(spec *v1.TaskSpec, params v1.Params) error {
+	for _, p := range params {
+		if p.ValueFrom == nil {
+			continue
+		}
+		references := func(script string) bool {
+			return slices.ContainsFunc(paramPatterns, func(pattern string) bool {
+				return strings.Contains(script, "$("+fmt.Sprintf(pattern, p.Name)+")")
+			})
+		}
+		for _, s := range spec.Steps {
+			if references(s.Script) {
+				return fmt.Errorf("sensitive param %q can't be referenced in the script of Step %q, which must read $%s instead", p.Name, s.Name, sensitiveParamEnvName(p.Name))
+			}
+		}
+		for _, s := range spec.Sidecars {
+			if references(s.Script) {
+				return fmt.Errorf("sensitive param %q can't be referenced in the script of Sidecar %q, which must read $%s instead", p.Name, s.Name, sensitiveParamEnvName(p.Name))
+			}
+		}
+	}
+	return nil
+}
+
+// applySensitiveParameters injects the values of the params referencing a Secret in the Steps and Sidecars
+// through environment variables. Their references were replaced by $(<env name>), which Kubernetes expands in
+// the command, args and env of the containers, while scripts read the variables themselves.
+// The variables are prepended so that the env of the containers can reference them.
+func applySensitiveParameters(spec *v1.TaskSpec, params v1.Params) *v1.TaskSpec {
+	for _, p := range params {
+		if p.ValueFrom == nil {
+			continue
+		}
+		name := sensitiveParamEnvName(p.Name)
+		env := corev1.EnvVar{
+			Name:      name,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: p.ValueFrom.SecretKeyRef.DeepCopy()},
+		}
+		for i := range spec.Steps {
+			spec.Steps[i].Env = append([]corev1.EnvVar{env}, spec.Steps[i].Env...)
+		}
+		for i := range spec.Sidecars {
+			spec.Sidecars[i].Env = append([]corev1.EnvVar{env}, spec.Sidecars[i].Env...)
+		}
+	}
+	return spec
+}
+
 func getContextReplacements(taskName string, tr *v1.TaskRun) map[string]string {
 	return map[string]string{
 		"context.taskRun.name":      tr.Name,
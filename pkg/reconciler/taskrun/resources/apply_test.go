@@ -1363,6 +1363,118 @@ func TestApplyParameters(t *testing.T) {
 	}
 }
 
+func TestApplyParameters_SensitiveParams(t *testing.T) {
+	secretKeyRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "tr-sensitive-params"},
+		Key:                  "api.token",
+	}
+	tr := &v1.TaskRun{
+		Spec: v1.TaskRunSpec{
+			Params: v1.Params{{
+				Name:      "api.token",
+				Value:     *v1.NewStructuredValues(v1.SensitiveParamRedactedValue),
+				ValueFrom: &v1.ParamValueSource{SecretKeyRef: secretKeyRef},
+			}, {
+				Name:  "url",
+				Value: *v1.NewStructuredValues("https://tekton.dev"),
+			}},
+		},
+	}
+	spec := &v1.TaskSpec{
+		Params: v1.ParamSpecs{{
+			Name:      "api.token",
+			Type:      v1.ParamTypeString,
+			Sensitive: true,
+		}, {
+			Name: "url",
+			Type: v1.ParamTypeString,
+		}},
+		Steps: []v1.Step{{
+			Name:  "call",
+			Image: "curl",
+			Args:  []string{"-H", "Authorization: Bearer $(params['api.token'])", "$(params.url)"},
+			Env:   []corev1.EnvVar{{Name: "TOKEN", Value: "$(params['api.token'])"}},
+		}, {
+			Name:   "script",
+			Image:  "bash",
+			Script: "curl -H \"Authorization: Bearer ${TEKTON_PARAM_API_TOKEN}\" $(params.url)",
+		}},
+	}
+	env := corev1.EnvVar{
+		Name:      "TEKTON_PARAM_API_TOKEN",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretKeyRef},
+	}
+	want := applyMutation(spec, func(spec *v1.TaskSpec) {
+		spec.Steps[0].Args = []string{"-H", "Authorization: Bearer $(TEKTON_PARAM_API_TOKEN)", "https://tekton.dev"}
+		spec.Steps[0].Env = []corev1.EnvVar{env, {Name: "TOKEN", Value: "$(TEKTON_PARAM_API_TOKEN)"}}
+		spec.Steps[1].Script = "curl -H \"Authorization: Bearer ${TEKTON_PARAM_API_TOKEN}\" https://tekton.dev"
+		spec.Steps[1].Env = []corev1.EnvVar{env}
+	})
+
+	got := resources.ApplyParameters(spec, tr, spec.Params...)
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("ApplyParameters() got diff %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestValidateSensitiveParamReferences(t *testing.T) {
+	params := v1.Params{{
+		Name:  "token",
+		Value: *v1.NewStructuredValues(v1.SensitiveParamRedactedValue),
+		ValueFrom: &v1.ParamValueSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "tr-sensitive-params"},
+			Key:                  "token",
+		}},
+	}, {
+		Name:  "url",
+		Value: *v1.NewStructuredValues("https://tekton.dev"),
+	}}
+	for _, tc := range []struct {
+		name    string
+		spec    v1.TaskSpec
+		wantErr bool
+	}{{
+		name: "args of a container",
+		spec: v1.TaskSpec{Steps: []v1.Step{{Name: "call", Image: "python", Command: []string{"python"}, Args: []string{"call.py", "$(params.token)"}}}},
+	}, {
+		name: "env of a container",
+		spec: v1.TaskSpec{Steps: []v1.Step{{Name: "call", Env: []corev1.EnvVar{{Name: "TOKEN", Value: "$(params.token)"}}}}},
+	}, {
+		name: "script reading the environment variable",
+		spec: v1.TaskSpec{Steps: []v1.Step{{Name: "call", Script: "curl -H \"Authorization: Bearer ${TEKTON_PARAM_TOKEN}\" $(params.url)"}}},
+	}, {
+		name: "python script referencing other params",
+		spec: v1.TaskSpec{Steps: []v1.Step{{Name: "call", Script: "#!/usr/bin/env python3\nprint(\"$(params.url)\")"}}},
+	}, {
+		name:    "script without shebang",
+		spec:    v1.TaskSpec{Steps: []v1.Step{{Name: "call", Script: "curl -H \"Authorization: Bearer $(params.token)\""}}},
+		wantErr: true,
+	}, {
+		name:    "single quoted reference",
+		spec:    v1.TaskSpec{Steps: []v1.Step{{Name: "call", Script: "#!/usr/bin/env bash\ncurl -H 'Authorization: Bearer $(params.token)'"}}},
+		wantErr: true,
+	}, {
+		name:    "reference in a quoted heredoc",
+		spec:    v1.TaskSpec{Steps: []v1.Step{{Name: "call", Script: "cat > token <<'EOF'\n$(params.token)\nEOF"}}},
+		wantErr: true,
+	}, {
+		name:    "python script",
+		spec:    v1.TaskSpec{Steps: []v1.Step{{Name: "call", Script: "#!/usr/bin/env python3\nprint(\"$(params.token)\")"}}},
+		wantErr: true,
+	}, {
+		name:    "node script in a sidecar",
+		spec:    v1.TaskSpec{Sidecars: []v1.Sidecar{{Name: "server", Script: "#!/usr/local/bin/node\nconsole.log(\"$(params['token'])\")"}}},
+		wantErr: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := resources.ValidateSensitiveParamReferences(&tc.spec, params)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateSensitiveParamReferences() = %v, wantErr %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestApplyParameters_ArrayIndexing(t *testing.T) {
 	tr := &v1.TaskRun{
 		Spec: v1.TaskRunSpec{
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/trustedresources"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ExtendTaskSpec returns the TaskSpec merged into the base Task it extends, using getBaseTask to
+// fetch the base Task, along with the source the base Task came from. The TaskSpec is returned
+// as is if it doesn't extend a base Task.
+//
+// The steps, results and sidecars of the TaskSpec replace the ones of the base Task with the same
+// name, and the other ones are appended, unless the steps are inserted next to a step of the base
+// Task. The params of the TaskSpec override the fields they set of the params of the base Task
+// with the same name, e.g. their default value. The stepTemplate of the TaskSpec replaces the
+// one of the base Task.
+func ExtendTaskSpec(ctx context.Context, taskSpec *v1.TaskSpec, getBaseTask GetTask) (*v1.TaskSpec, *v1.RefSource, error) {
+	if taskSpec.Extends == nil {
+		return taskSpec, nil, nil
+	}
+	base, source, vr, err := getBaseTask(ctx, taskSpec.Extends.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the base Task: %w", err)
+	}
+	if vr != nil && vr.VerificationResultType == trustedresources.VerificationError {
+		return nil, nil, fmt.Errorf("failed to verify the base Task: %w", vr.Err)
+	}
+	if base.Spec.Extends != nil {
+		return nil, nil, errors.New("the base Task cannot extend another Task")
+	}
+	extended := base.Spec.DeepCopy()
+
+	steps, err := extendSteps(extended.Steps, taskSpec.Steps, taskSpec.Extends.InsertSteps)
+	if err != nil {
+		return nil, nil, err
+	}
+	extended.Steps = steps
+	extended.Params = extendParams(extended.Params, taskSpec.Params)
+	extended.Results = extendByName(extended.Results, taskSpec.Results, func(r v1.TaskResult) string { return r.Name })
+	extended.Sidecars = extendByName(extended.Sidecars, taskSpec.Sidecars, func(s v1.Sidecar) string { return s.Name })
+	extended.Workspaces = extendByName(extended.Workspaces, taskSpec.Workspaces, func(w v1.WorkspaceDeclaration) string { return w.Name })
+	extended.Volumes = extendByName(extended.Volumes, taskSpec.Volumes, func(v corev1.Volume) string { return v.Name })
+	if taskSpec.StepTemplate != nil {
+		extended.StepTemplate = taskSpec.StepTemplate.DeepCopy()
+	}
+	if taskSpec.DisplayName != "" {
+		extended.DisplayName = taskSpec.DisplayName
+	}
+	if taskSpec.Description != "" {
+		extended.Description = taskSpec.Description
+	}
+	extended.SetDefaults(ctx)
+	return extended, source, nil
+}
+
+// extendSteps replaces the base steps by the steps with the same name, inserts the steps of
+// insertions next to their anchor and appends the other steps.
+func extendSteps(base, steps []v1.Step, insertions []v1.StepInsertion) ([]v1.Step, error) {
+	extended := append([]v1.Step{}, base...)
+	inserted := map[string]v1.StepInsertion{}
+	for _, insertion := range insertions {
+		inserted[insertion.Step] = insertion
+	}
+	for _, step := range steps {
+		insertion, ok := inserted[step.Name]
+		if !ok {
+			extended = extendByName(extended, []v1.Step{step}, func(s v1.Step) string { return s.Name })
+			continue
+		}
+		if stepIndex(extended, step.Name) >= 0 {
+			return nil, fmt.Errorf("step %q replaces a step of the base Task and cannot be inserted", step.Name)
+		}
+		anchor, i := insertion.Before, stepIndex(extended, insertion.Before)
+		if insertion.After != "" {
+			anchor, i = insertion.After, stepIndex(extended, insertion.After)+1
+		}
+		if stepIndex(extended, anchor) < 0 {
+			return nil, fmt.Errorf("step %q cannot be inserted next to step %q which doesn't exist", step.Name, anchor)
+		}
+		extended = append(extended[:i], append([]v1.Step{step}, extended[i:]...)...)
+	}
+	return extended, nil
+}
+
+func stepIndex(steps []v1.Step, name string) int {
+	for i, step := range steps {
+		if name != "" && step.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// extendParams overrides the fields of the base params set by the params with the same name,
+// and appends the other params.
+func extendParams(base, params v1.ParamSpecs) v1.ParamSpecs {
+	if len(params) == 0 {
+		return base
+	}
+	extended := append(v1.ParamSpecs{}, base...)
+	for _, p := range params {
+		i := 0
+		for i < len(extended) && extended[i].Name != p.Name {
+			i++
+		}
+		if i == len(extended) {
+			extended = append(extended, p)
+			continue
+		}
+		if p.Type != "" {
+			extended[i].Type = p.Type
+		}
+		if p.Description != "" {
+			extended[i].Description = p.Description
+		}
+		if p.Properties != nil {
+			extended[i].Properties = p.Properties
+		}
+		if p.Default != nil {
+			extended[i].Default = p.Default
+		}
+		if p.Enum != nil {
+			extended[i].Enum = p.Enum
+		}
+	}
+	return extended
+}
+
+// extendByName replaces the base items by the items with the same name, and appends the other items.
+func extendByName[T any](base, items []T, name func(T) string) []T {
+	if len(items) == 0 {
+		return base
+	}
+	extended := append([]T{}, base...)
+	for _, item := range items {
+		replaced := false
+		for i := range extended {
+			if name(item) != "" && name(extended[i]) == name(item) {
+				extended[i] = item
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			extended = append(extended, item)
+		}
+	}
+	return extended
+}
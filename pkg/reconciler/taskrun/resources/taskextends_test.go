@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/trustedresources"
+	"github.com/tektoncd/pipeline/test/diff"
+	"github.com/tektoncd/pipeline/test/parse"
+)
+
+func getBaseTask(base *v1.Task) GetTask {
+	return func(_ context.Context, name string) (*v1.Task, *v1.RefSource, *trustedresources.VerificationResult, error) {
+		if base == nil || base.Name != name {
+			return nil, nil, nil, errors.New("task not found")
+		}
+		return base, refSourceSample, nil, nil
+	}
+}
+
+func TestExtendTaskSpec(t *testing.T) {
+	base := parse.MustParseV1Task(t, `
+metadata:
+  name: go-build
+spec:
+  description: Builds a Go module
+  params:
+  - name: go-version
+    type: string
+    description: The version of Go
+    default: "1.23"
+  - name: packages
+    type: array
+    default: ["./..."]
+  results:
+  - name: binary
+    type: string
+  stepTemplate:
+    image: golang:$(params.go-version)
+  sidecars:
+  - name: cache
+    image: redis
+  steps:
+  - name: fetch
+    script: go mod download
+  - name: compile
+    script: go build $(params.packages[*])
+  - name: test
+    script: go test $(params.packages[*])
+`)
+	ts := &parse.MustParseV1Task(t, `
+metadata:
+  name: go-build-lint
+spec:
+  extends:
+    name: go-build
+    insertSteps:
+    - step: lint
+      before: compile
+    - step: vet
+      after: lint
+  params:
+  - name: go-version
+    default: "1.24"
+  - name: lint-config
+    type: string
+    default: .golangci.yml
+  results:
+  - name: binary
+    type: string
+    description: The path of the binary
+  sidecars:
+  - name: cache
+    image: valkey
+  steps:
+  - name: compile
+    script: CGO_ENABLED=0 go build $(params.packages[*])
+  - name: lint
+    image: golangci/golangci-lint
+    script: golangci-lint run -c $(params.lint-config)
+  - name: vet
+    script: go vet $(params.packages[*])
+  - name: publish
+    script: ko publish .
+`).Spec
+
+	got, source, err := ExtendTaskSpec(t.Context(), ts, getBaseTask(base))
+	if err != nil {
+		t.Fatalf("ExtendTaskSpec() = %v", err)
+	}
+	want := parse.MustParseV1Task(t, `
+metadata:
+  name: go-build-lint
+spec:
+  description: Builds a Go module
+  params:
+  - name: go-version
+    type: string
+    description: The version of Go
+    default: "1.24"
+  - name: packages
+    type: array
+    default: ["./..."]
+  - name: lint-config
+    type: string
+    default: .golangci.yml
+  results:
+  - name: binary
+    type: string
+    description: The path of the binary
+  stepTemplate:
+    image: golang:$(params.go-version)
+  sidecars:
+  - name: cache
+    image: valkey
+  steps:
+  - name: fetch
+    script: go mod download
+  - name: lint
+    image: golangci/golangci-lint
+    script: golangci-lint run -c $(params.lint-config)
+  - name: vet
+    script: go vet $(params.packages[*])
+  - name: compile
+    script: CGO_ENABLED=0 go build $(params.packages[*])
+  - name: test
+    script: go test $(params.packages[*])
+  - name: publish
+    script: ko publish .
+`).Spec
+	if d := cmp.Diff(&want, got); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+	if d := cmp.Diff(refSourceSample, source); d != "" {
+		t.Error(diff.PrintWantGot(d))
+	}
+}
+
+func TestExtendTaskSpec_NoExtends(t *testing.T) {
+	ts := &v1.TaskSpec{Steps: []v1.Step{{Name: "build", Image: "golang"}}}
+	got, source, err := ExtendTaskSpec(t.Context(), ts, getBaseTask(nil))
+	if err != nil {
+		t.Fatalf("ExtendTaskSpec() = %v", err)
+	}
+	if got != ts || source != nil {
+		t.Errorf("expected the TaskSpec to be returned as is, got %v and %v", got, source)
+	}
+}
+
+func TestExtendTaskSpec_Error(t *testing.T) {
+	base := parse.MustParseV1Task(t, `
+metadata:
+  name: go-build
+spec:
+  steps:
+  - name: compile
+    image: golang
+    script: go build ./...
+`)
+	nested := parse.MustParseV1Task(t, `
+metadata:
+  name: go-build
+spec:
+  extends:
+    name: other
+`)
+	for _, tc := range []struct {
+		name    string
+		ts      *v1.TaskSpec
+		base    *v1.Task
+		wantErr string
+	}{{
+		name:    "missing base task",
+		ts:      &v1.TaskSpec{Extends: &v1.TaskExtends{TaskRef: v1.TaskRef{Name: "missing"}}},
+		base:    base,
+		wantErr: "failed to get the base Task: task not found",
+	}, {
+		name:    "nested extends",
+		ts:      &v1.TaskSpec{Extends: &v1.TaskExtends{TaskRef: v1.TaskRef{Name: "go-build"}}},
+		base:    nested,
+		wantErr: "the base Task cannot extend another Task",
+	}, {
+		name: "inserted step replacing a step of the base task",
+		ts: &v1.TaskSpec{
+			Extends: &v1.TaskExtends{
+				TaskRef:     v1.TaskRef{Name: "go-build"},
+				InsertSteps: []v1.StepInsertion{{Step: "compile", After: "compile"}},
+			},
+			Steps: []v1.Step{{Name: "compile", Image: "golang"}},
+		},
+		base:    base,
+		wantErr: `step "compile" replaces a step of the base Task and cannot be inserted`,
+	}, {
+		name: "inserted step next to a missing step",
+		ts: &v1.TaskSpec{
+			Extends: &v1.TaskExtends{
+				TaskRef:     v1.TaskRef{Name: "go-build"},
+				InsertSteps: []v1.StepInsertion{{Step: "lint", Before: "fetch"}},
+			},
+			Steps: []v1.Step{{Name: "lint", Image: "golangci/golangci-lint"}},
+		},
+		base:    base,
+		wantErr: `step "lint" cannot be inserted next to step "fetch" which doesn't exist`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := ExtendTaskSpec(t.Context(), tc.ts, getBaseTask(tc.base))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if d := cmp.Diff(tc.wantErr, err.Error()); d != "" {
+				t.Error(diff.PrintWantGot(d))
+			}
+		})
+	}
+}
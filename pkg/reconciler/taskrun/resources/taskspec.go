@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
@@ -30,7 +32,9 @@ import (
 	remoteresource "github.com/tektoncd/pipeline/pkg/remoteresolution/resource"
 	"github.com/tektoncd/pipeline/pkg/trustedresources"
 	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -104,9 +108,12 @@ func GetTaskData(ctx context.Context, taskRun *v1.TaskRun, getTask GetTask) (*re
 }
 
 // stepRefResolution holds the outcome of resolving a step referencing a StepAction.
+// A composite StepAction resolves to several steps, along with the sidecars and volumes they need.
 type stepRefResolution struct {
-	resolvedStep *v1.Step
-	source       *v1.RefSource
+	resolvedSteps []v1.Step
+	sidecars      []v1.Sidecar
+	volumes       []corev1.Volume
+	source        *v1.RefSource
 }
 
 // hasStepRefs provides a fast check to see if any steps in a TaskSpec contain a reference to a StepAction.
@@ -120,13 +127,13 @@ func hasStepRefs(taskSpec *v1.TaskSpec) bool {
 }
 
 // resolveStepRef resolves a step referecing a StepAction by fetching the remote StepAction, merging it with the Step's specification, and returning the resolved step.
-func resolveStepRef(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.TaskRun, tekton clientset.Interface, k8s kubernetes.Interface, requester remoteresource.Requester, step *v1.Step) (*v1.Step, *v1.RefSource, error) {
+func resolveStepRef(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.TaskRun, tekton clientset.Interface, k8s kubernetes.Interface, requester remoteresource.Requester, step *v1.Step, stepIndex int) (*stepRefResolution, error) {
 	resolvedStep := step.DeepCopy()
 
 	getStepAction := GetStepActionFunc(tekton, k8s, requester, taskRun, taskSpec, resolvedStep)
 	stepAction, source, err := getStepAction(ctx, resolvedStep.Ref.Name)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	stepActionSpec := stepAction.StepActionSpec()
@@ -134,12 +141,25 @@ func resolveStepRef(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.TaskR
 
 	stepFromStepAction := stepActionSpec.ToStep()
 	if err := validateStepHasStepActionParameters(resolvedStep.Params, stepActionSpec.Params); err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	if stepActionSpec.IsComposite() {
+		steps, err := expandCompositeStepAction(&taskSpec, taskRun, resolvedStep, stepIndex, stepActionSpec)
+		if err != nil {
+			return nil, err
+		}
+		return &stepRefResolution{
+			resolvedSteps: steps,
+			sidecars:      stepActionSpec.Sidecars,
+			volumes:       stepActionSpec.Volumes,
+			source:        source,
+		}, nil
 	}
 
 	stepFromStepAction, err = applyStepActionParameters(stepFromStepAction, &taskSpec, taskRun, resolvedStep.Params, stepActionSpec.Params)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// Merge fields from the resolved StepAction into the step
@@ -169,7 +189,77 @@ func resolveStepRef(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.TaskR
 	resolvedStep.Ref = nil
 	resolvedStep.Params = nil
 
-	return resolvedStep, source, nil
+	return &stepRefResolution{resolvedSteps: []v1.Step{*resolvedStep}, source: source}, nil
+}
+
+// expandCompositeStepAction expands a step referencing a composite StepAction into the steps of the StepAction.
+// The steps are prefixed with the name of the referencing step, except for the last one which takes its name:
+// the results of the StepAction are written by the last step, so they are referenced as the results of the
+// referencing step. The when expressions and onError of the referencing step apply to every expanded step.
+func expandCompositeStepAction(taskSpec *v1.TaskSpec, taskRun *v1.TaskRun, step *v1.Step, stepIndex int, stepActionSpec v1beta1.StepActionSpec) ([]v1.Step, error) {
+	prefix := pod.TrimStepPrefix(pod.StepName(step.Name, stepIndex))
+	last := len(stepActionSpec.Steps) - 1
+
+	// the steps of the StepAction may reference the results of the steps before them
+	var renames []string
+	for _, s := range stepActionSpec.Steps[:last] {
+		renames = append(renames, fmt.Sprintf("$(steps.%s.", s.Name), fmt.Sprintf("$(steps.%s-%s.", prefix, s.Name))
+	}
+	renamer := strings.NewReplacer(renames...)
+
+	steps := make([]v1.Step, 0, len(stepActionSpec.Steps))
+	for i, s := range stepActionSpec.Steps {
+		expanded := s.DeepCopy()
+		if i == last {
+			expanded.Name = step.Name
+			expanded.Results = mergeStepResults(expanded.Results, stepActionSpec.Results)
+		} else {
+			expanded.Name = fmt.Sprintf("%s-%s", prefix, s.Name)
+		}
+		renameStepReferences(expanded, renamer)
+
+		expanded, err := applyStepActionParameters(expanded, taskSpec, taskRun, step.Params, stepActionSpec.Params)
+		if err != nil {
+			return nil, err
+		}
+		expanded.When = append(step.When.DeepCopy(), expanded.When...)
+		if expanded.OnError == "" {
+			expanded.OnError = step.OnError
+		}
+		steps = append(steps, *expanded)
+	}
+	return steps, nil
+}
+
+// renameStepReferences rewrites the references to other steps in the fields of a step that may hold them.
+func renameStepReferences(step *v1.Step, renamer *strings.Replacer) {
+	step.Script = renamer.Replace(step.Script)
+	for i := range step.Command {
+		step.Command[i] = renamer.Replace(step.Command[i])
+	}
+	for i := range step.Args {
+		step.Args[i] = renamer.Replace(step.Args[i])
+	}
+	for i := range step.Env {
+		step.Env[i].Value = renamer.Replace(step.Env[i].Value)
+	}
+	for i := range step.When {
+		step.When[i].Input = renamer.Replace(step.When[i].Input)
+		step.When[i].CEL = renamer.Replace(step.When[i].CEL)
+		for j := range step.When[i].Values {
+			step.When[i].Values[j] = renamer.Replace(step.When[i].Values[j])
+		}
+	}
+}
+
+// mergeStepResults adds the results that are not declared yet to the given results.
+func mergeStepResults(results []v1.StepResult, toAdd []v1.StepResult) []v1.StepResult {
+	for _, r := range toAdd {
+		if !slices.ContainsFunc(results, func(declared v1.StepResult) bool { return declared.Name == r.Name }) {
+			results = append(results, r)
+		}
+	}
+	return results
 }
 
 // updateTaskRunProvenance update the TaskRun's status with source provenance information for a given step
@@ -196,8 +286,10 @@ func updateTaskRunProvenance(taskRun *v1.TaskRun, stepName string, stepIndex int
 }
 
 // GetStepActionsData extracts the StepActions and merges them with the inlined Step specification.
-func GetStepActionsData(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.TaskRun, tekton clientset.Interface, k8s kubernetes.Interface, requester remoteresource.Requester) ([]v1.Step, error) {
-	steps := make([]v1.Step, len(taskSpec.Steps))
+// It returns a copy of the TaskSpec with the resolved Steps, where the Steps referencing a composite
+// StepAction are expanded and the Sidecars and Volumes of the StepAction are added.
+func GetStepActionsData(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.TaskRun, tekton clientset.Interface, k8s kubernetes.Interface, requester remoteresource.Requester) (*v1.TaskSpec, error) {
+	steps := make([]v1.Step, 0, len(taskSpec.Steps))
 
 	// Init step states and known step states indexes lookup map
 	if taskRun.Status.Steps == nil {
@@ -211,10 +303,11 @@ func GetStepActionsData(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.T
 	// If there are no step-ref to resolve, return immediately with nil provenance
 	if !hasStepRefs(&taskSpec) {
 		for i, step := range taskSpec.Steps {
-			steps[i] = step
+			steps = append(steps, step)
 			updateTaskRunProvenance(taskRun, step.Name, i, nil, stepStatusIndex) // create StepState with nil provenance
 		}
-		return steps, nil
+		taskSpec.Steps = steps
+		return &taskSpec, nil
 	}
 
 	// Phase 1: Concurrently resolve all StepActions
@@ -230,11 +323,11 @@ func GetStepActionsData(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.T
 		}
 
 		g.Go(func() error {
-			resolvedStep, source, err := resolveStepRef(ctx, taskSpec, taskRun, tekton, k8s, requester, &step)
+			stepRefResolution, err := resolveStepRef(ctx, taskSpec, taskRun, tekton, k8s, requester, &step, i)
 			if err != nil {
 				return fmt.Errorf("failed to resolve step ref for step %q (index %d): %w", step.Name, i, err)
 			}
-			stepRefResolutions[i] = &stepRefResolution{resolvedStep: resolvedStep, source: source}
+			stepRefResolutions[i] = stepRefResolution
 			return nil
 		})
 	}
@@ -244,17 +337,44 @@ func GetStepActionsData(ctx context.Context, taskSpec v1.TaskSpec, taskRun *v1.T
 	}
 
 	// Phase 2: Sequentially merge results into the final step list and update status
+	sidecars := slices.Clone(taskSpec.Sidecars)
+	volumes := slices.Clone(taskSpec.Volumes)
+	stepNames := sets.New[string]()
 	for i, step := range taskSpec.Steps {
 		if step.Ref == nil {
-			steps[i] = step
-			updateTaskRunProvenance(taskRun, step.Name, i, nil, stepStatusIndex) // create StepState for inline step with nil provenance
+			if step.Name != "" && stepNames.Has(step.Name) {
+				return nil, fmt.Errorf("step %q (index %d) has the name of a step expanded from a StepAction", step.Name, i)
+			}
+			updateTaskRunProvenance(taskRun, step.Name, len(steps), nil, stepStatusIndex) // create StepState for inline step with nil provenance
+			steps = append(steps, step)
+			stepNames.Insert(step.Name)
 			continue
 		}
 
 		stepRefResolution := stepRefResolutions[i]
-		steps[i] = *stepRefResolution.resolvedStep
-		updateTaskRunProvenance(taskRun, stepRefResolution.resolvedStep.Name, i, stepRefResolution.source, stepStatusIndex)
+		for _, resolvedStep := range stepRefResolution.resolvedSteps {
+			if resolvedStep.Name != "" && stepNames.Has(resolvedStep.Name) {
+				return nil, fmt.Errorf("step %q expanded from the StepAction referenced by step %q (index %d) has the name of another step", resolvedStep.Name, step.Name, i)
+			}
+			updateTaskRunProvenance(taskRun, resolvedStep.Name, len(steps), stepRefResolution.source, stepStatusIndex)
+			steps = append(steps, resolvedStep)
+			stepNames.Insert(resolvedStep.Name)
+		}
+		// the sidecars and volumes of a composite StepAction are added once, even if it is referenced several times
+		for _, sc := range stepRefResolution.sidecars {
+			if !slices.ContainsFunc(sidecars, func(declared v1.Sidecar) bool { return declared.Name == sc.Name }) {
+				sidecars = append(sidecars, sc)
+			}
+		}
+		for _, v := range stepRefResolution.volumes {
+			if !slices.ContainsFunc(volumes, func(declared corev1.Volume) bool { return declared.Name == v.Name }) {
+				volumes = append(volumes, v)
+			}
+		}
 	}
 
-	return steps, nil
+	taskSpec.Steps = steps
+	taskSpec.Sidecars = sidecars
+	taskSpec.Volumes = volumes
+	return &taskSpec, nil
 }
@@ -2009,13 +2009,151 @@ func TestGetStepActionsData(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Did not expect an error but got : %s", err)
 			}
-			if d := cmp.Diff(tt.want, got); d != "" {
+			if d := cmp.Diff(tt.want, got.Steps); d != "" {
 				t.Errorf("the taskSpec did not match what was expected diff: %s", diff.PrintWantGot(d))
 			}
 		})
 	}
 }
 
+func TestGetStepActionsData_CompositeStepAction(t *testing.T) {
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mytaskrun",
+			Namespace: "default",
+		},
+		Spec: v1.TaskRunSpec{
+			TaskSpec: &v1.TaskSpec{
+				Steps: []v1.Step{{
+					Name: "build",
+					Ref: &v1.Ref{
+						Name: "go-build",
+					},
+					Params: v1.Params{{
+						Name:  "package",
+						Value: *v1.NewStructuredValues("./cmd/..."),
+					}},
+					OnError: v1.Continue,
+				}, {
+					Name:  "test",
+					Image: "golang",
+					Env: []corev1.EnvVar{{
+						Name:  "BINARY",
+						Value: "$(steps.build.results.binary)",
+					}},
+				}},
+			},
+		},
+	}
+	stepAction := &v1beta1.StepAction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "go-build",
+			Namespace: "default",
+		},
+		Spec: v1beta1.StepActionSpec{
+			Params: v1.ParamSpecs{{
+				Name: "package",
+				Type: v1.ParamTypeString,
+			}},
+			Results: []v1.StepResult{{
+				Name: "binary",
+			}},
+			Steps: []v1.Step{{
+				Name:   "setup",
+				Image:  "golang",
+				Script: "go version > $(step.results.version.path)",
+				Results: []v1.StepResult{{
+					Name: "version",
+				}},
+			}, {
+				Name:    "build",
+				Image:   "golang",
+				Command: []string{"go", "build", "$(params.package)"},
+				Env: []corev1.EnvVar{{
+					Name:  "GOVERSION",
+					Value: "$(steps.setup.results.version)",
+				}},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "gocache",
+					MountPath: "/root/.cache/go-build",
+				}},
+			}},
+			Sidecars: []v1.Sidecar{{
+				Name:  "registry",
+				Image: "registry",
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "gocache",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			}},
+		},
+	}
+	want := &v1.TaskSpec{
+		Steps: []v1.Step{{
+			Name:   "build-setup",
+			Image:  "golang",
+			Script: "go version > $(step.results.version.path)",
+			Results: []v1.StepResult{{
+				Name: "version",
+				Type: v1.ResultsTypeString,
+			}},
+			OnError: v1.Continue,
+		}, {
+			Name:    "build",
+			Image:   "golang",
+			Command: []string{"go", "build", "./cmd/..."},
+			Env: []corev1.EnvVar{{
+				Name:  "GOVERSION",
+				Value: "$(steps.build-setup.results.version)",
+			}},
+			VolumeMounts: []corev1.VolumeMount{{
+				Name:      "gocache",
+				MountPath: "/root/.cache/go-build",
+			}},
+			Results: []v1.StepResult{{
+				Name: "binary",
+				Type: v1.ResultsTypeString,
+			}},
+			OnError: v1.Continue,
+		}, {
+			Name:  "test",
+			Image: "golang",
+			Env: []corev1.EnvVar{{
+				Name:  "BINARY",
+				Value: "$(steps.build.results.binary)",
+			}},
+		}},
+		Sidecars: []v1.Sidecar{{
+			Name:  "registry",
+			Image: "registry",
+		}},
+		Volumes: []corev1.Volume{{
+			Name: "gocache",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		}},
+	}
+
+	tektonclient := fake.NewSimpleClientset(stepAction)
+	got, err := GetStepActionsData(t.Context(), *tr.Spec.TaskSpec, tr, tektonclient, nil, nil)
+	if err != nil {
+		t.Fatalf("Did not expect an error but got : %s", err)
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("the taskSpec did not match what was expected diff: %s", diff.PrintWantGot(d))
+	}
+	var gotStepNames []string
+	for _, stepState := range tr.Status.Steps {
+		gotStepNames = append(gotStepNames, stepState.Name)
+	}
+	if d := cmp.Diff([]string{"build-setup", "build", "test"}, gotStepNames); d != "" {
+		t.Errorf("the step states did not match what was expected diff: %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestGetStepActionsData_Error(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -2228,6 +2366,43 @@ func TestGetStepActionsData_Error(t *testing.T) {
 			},
 		},
 		expectedError: errors.New(`failed to resolve step ref for step "test" (index 0): invalid parameter substitution: commands. Please check the types of the default value and the passed value`),
+	}, {
+		name: "composite step action expanding into a step of the task",
+		tr: &v1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mytaskrun",
+				Namespace: "default",
+			},
+			Spec: v1.TaskRunSpec{
+				TaskSpec: &v1.TaskSpec{
+					Steps: []v1.Step{{
+						Name: "build",
+						Ref: &v1.Ref{
+							Name: "stepAction",
+						},
+					}, {
+						Name:  "build-setup",
+						Image: "myimage",
+					}},
+				},
+			},
+		},
+		stepAction: &v1beta1.StepAction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "stepAction",
+				Namespace: "default",
+			},
+			Spec: v1beta1.StepActionSpec{
+				Steps: []v1.Step{{
+					Name:  "setup",
+					Image: "myimage",
+				}, {
+					Name:  "build",
+					Image: "myimage",
+				}},
+			},
+		},
+		expectedError: errors.New(`step "build-setup" (index 1) has the name of a step expanded from a StepAction`),
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
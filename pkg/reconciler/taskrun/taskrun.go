@@ -24,14 +24,18 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
 	"github.com/tektoncd/pipeline/internal/sidecarlogresults"
+	"github.com/tektoncd/pipeline/internal/workspacecache"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	pipelineErrors "github.com/tektoncd/pipeline/pkg/apis/pipeline/errors"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/pod"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	clientset "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	taskrunreconciler "github.com/tektoncd/pipeline/pkg/client/injection/reconciler/pipeline/v1/taskrun"
 	listers "github.com/tektoncd/pipeline/pkg/client/listers/pipeline/v1"
@@ -44,7 +48,9 @@ import (
 	podconvert "github.com/tektoncd/pipeline/pkg/pod"
 	tknreconciler "github.com/tektoncd/pipeline/pkg/reconciler"
 	"github.com/tektoncd/pipeline/pkg/reconciler/apiserver"
+	"github.com/tektoncd/pipeline/pkg/reconciler/attestation"
 	"github.com/tektoncd/pipeline/pkg/reconciler/events"
+	"github.com/tektoncd/pipeline/pkg/reconciler/sensitiveparams"
 	"github.com/tektoncd/pipeline/pkg/reconciler/taskrun/resources"
 	"github.com/tektoncd/pipeline/pkg/reconciler/volumeclaim"
 	"github.com/tektoncd/pipeline/pkg/remote"
@@ -88,12 +94,14 @@ type Reconciler struct {
 	taskRunLister            listers.TaskRunLister
 	limitrangeLister         corev1Listers.LimitRangeLister
 	podLister                corev1Listers.PodLister
+	secretLister             corev1Listers.SecretLister
 	verificationPolicyLister alphalisters.VerificationPolicyLister
 	entrypointCache          podconvert.EntrypointCache
 	metrics                  *taskrunmetrics.Recorder
 	pvcHandler               volumeclaim.PvcHandler
 	resolutionRequester      resolution.Requester
 	tracerProvider           trace.TracerProvider
+	resultsCache             *objectstorageresults.Cache
 
 	// Native-sidecar detection (ServerVersion + IsNativeSidecarSupport) when EnableKubernetesSidecar
 	// is set is memoized via sync.OnceValues after lazy init guarded by nativeSidecarOnce (#9755).
@@ -101,6 +109,13 @@ type Reconciler struct {
 	// are not listed there but buildSidecarStopPatch stops them using the live Pod.
 	nativeSidecarOnce        sync.Once
 	nativeSidecarFromCluster func() (useTektonNop bool, err error)
+
+	// extractImageVolumes is set once a Pod was rejected for lack of the ImageVolume feature of the cluster.
+	extractImageVolumes atomic.Bool
+	// podLevelResourcesChecked is set once a Pod with pod-level resources was created in dry-run, and
+	// dividePodLevelResources if the cluster dropped them for lack of the PodLevelResources feature.
+	podLevelResourcesChecked atomic.Bool
+	dividePodLevelResources  atomic.Bool
 }
 
 const (
@@ -174,9 +189,13 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, tr *v1.TaskRun) (reconci
 	// Record the duration and count after the reconcile cycle.
 	defer c.durationAndCountMetrics(ctx, tr, before)
 
+	// A TaskRun waiting out the backoff of its RetryPolicy before the next
+	// retry must not be started yet.
+	retryBackoff := retryBackoffRemaining(tr, c.Clock)
+
 	// If the TaskRun is just starting, this will also set the starttime,
 	// from which the timeout will immediately begin counting down.
-	if !tr.HasStarted() && !tr.IsPending() {
+	if !tr.HasStarted() && !tr.IsPending() && retryBackoff == 0 {
 		tr.Status.InitializeConditions()
 		// In case node time was not synchronized, when controller has been scheduled to other nodes.
 		if tr.Status.StartTime.Sub(tr.CreationTimestamp.Time) < 0 {
@@ -210,6 +229,16 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, tr *v1.TaskRun) (reconci
 			}
 		}
 
+		if err := c.attestProvenance(ctx, tr); err != nil {
+			logger.Errorf("Failed to attest the provenance of TaskRun %s: %v", tr.Name, err)
+			return c.emitReconcileEvents(ctx, tr, before, err)
+		}
+
+		if err := c.releaseVolumeClaims(ctx, tr); err != nil {
+			logger.Errorf("Failed to delete or retain the PVCs of TaskRun %s: %v", tr.Name, err)
+			return c.emitReconcileEvents(ctx, tr, before, err)
+		}
+
 		return c.emitReconcileEvents(ctx, tr, before, nil)
 	}
 
@@ -227,6 +256,27 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, tr *v1.TaskRun) (reconci
 		return c.emitReconcileEvents(ctx, tr, before, nil)
 	}
 
+	// When the TaskRun is waiting out its retry backoff, do not create a Pod yet.
+	if retryBackoff > 0 {
+		logger.Infof("TaskRun %s will be retried in %s", tr.Name, retryBackoff)
+		return controller.NewRequeueAfter(retryBackoff)
+	}
+
+	// Check if the TaskRun waited for its first Step to start longer than its queue timeout;
+	// if it did, this will set its status accordingly.
+	if tr.HasQueueTimedOut(c.Clock) {
+		// The first Step may have started since the step statuses were last populated from the pod
+		if err := c.updateStepStatusesFromPod(ctx, tr); err != nil {
+			logger.Warnf("Failed to update step statuses from pod before queue timeout: %v", err)
+		}
+		if tr.HasQueueTimedOut(c.Clock) {
+			message := fmt.Sprintf("TaskRun %q did not start executing within the queue timeout %q", tr.Name, tr.Spec.Timeouts.Queue.Duration)
+			message = appendPreviousConditionContext(before, message)
+			err := c.failTaskRun(ctx, tr, v1.TaskRunReasonQueueTimedOut, message)
+			return c.emitReconcileEvents(ctx, tr, before, err)
+		}
+	}
+
 	// Check if the TaskRun has timed out; if it is, this will set its status
 	// accordingly.
 	if tr.HasTimedOut(ctx, c.Clock) {
@@ -276,14 +326,28 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, tr *v1.TaskRun) (reconci
 		}
 	}
 
+	// The TaskRun saved its cache workspaces when it completed.
+	if tr.IsDone() && (before == nil || before.IsUnknown()) {
+		c.evictWorkspaceCaches(ctx, tr)
+	}
+
 	// Emit events (only when ConditionSucceeded was changed)
 	if err = c.emitReconcileEvents(ctx, tr, before, err); err != nil {
 		return err
 	}
 
-	if tr.Status.StartTime != nil {
+	// Snooze a queued TaskRun until its queue timeout has elapsed. Its execution timeout only starts
+	// counting down once its first Step starts, which triggers another reconcile.
+	if tr.IsQueued() {
+		if tr.Spec.Timeouts.Queue.Duration == config.NoTimeoutDuration {
+			return nil
+		}
+		return controller.NewRequeueAfter(tr.Spec.Timeouts.Queue.Duration - c.Clock.Since(tr.Status.StartTime.Time))
+	}
+
+	if startTime := tr.TimeoutStartTime(); startTime != nil {
 		// Compute the time since the task started.
-		elapsed := c.Clock.Since(tr.Status.StartTime.Time)
+		elapsed := c.Clock.Since(startTime.Time)
 		// Snooze this resource until the timeout has elapsed.
 		timeout := tr.GetTimeout(ctx)
 		// If timeout is NoTimeoutDuration (0), it means no timeout is configured.
@@ -301,6 +365,79 @@ func (c *Reconciler) ReconcileKind(ctx context.Context, tr *v1.TaskRun) (reconci
 	return nil
 }
 
+// attestProvenance stores the signed provenance attestation of the completed TaskRun in a ConfigMap
+// referenced by its status. TaskRuns which ran with enable-provenance-attestations set are attested once.
+// A TaskRun that cannot be signed for lack of a usable signing key is not attested again: the failure is
+// recorded in its status and reported in a warning event.
+func (c *Reconciler) attestProvenance(ctx context.Context, tr *v1.TaskRun) error {
+	if !attestation.ShouldAttest(ctx, tr.Status.Provenance) {
+		return nil
+	}
+	signer, err := attestation.LoadSigner(ctx, c.secretLister)
+	if err != nil {
+		tr.Status.Provenance.AttestationError = err.Error()
+		controller.GetEventRecorder(ctx).Event(tr, corev1.EventTypeWarning, attestation.ReasonFailed, err.Error())
+		return nil
+	}
+	name, err := attestation.Attest(ctx, c.KubeClientSet, signer, attestation.GenerateTaskRun(tr),
+		*kmeta.NewControllerRef(tr), tr.Namespace, map[string]string{pipeline.TaskRunLabelKey: tr.Name})
+	if err != nil {
+		return err
+	}
+	tr.Status.Provenance.Attestation = name
+	return nil
+}
+
+// evictWorkspaceCaches deletes the least recently used cache archives of the namespace of the TaskRun
+// until they fit within default-workspace-cache-size-limit. Failing to evict them does not fail the
+// TaskRun: they are evicted again when the next TaskRun with cache workspaces completes.
+func (c *Reconciler) evictWorkspaceCaches(ctx context.Context, tr *v1.TaskRun) {
+	logger := logging.FromContext(ctx)
+	if len(tr.Status.WorkspaceCaches) == 0 {
+		return
+	}
+	limit := config.FromContextOrDefaults(ctx).Defaults.DefaultWorkspaceCacheSizeLimit
+	if limit <= 0 {
+		return
+	}
+	b, err := objectstorageresults.NewBucketFromConfig(ctx, c.secretLister, tr.Namespace)
+	if err == nil {
+		err = workspacecache.Evict(ctx, b, tr.Namespace, limit)
+	}
+	if err != nil {
+		logger.Errorf("Failed to evict the workspace caches of namespace %s: %v", tr.Namespace, err)
+	}
+}
+
+// releaseVolumeClaims deletes or retains the PVCs created from the volumeClaimTemplates of the workspaces
+// of a completed TaskRun that have a retention policy. The other PVCs are deleted with the TaskRun.
+func (c *Reconciler) releaseVolumeClaims(ctx context.Context, tr *v1.TaskRun) error {
+	succeeded := tr.Status.GetCondition(apis.ConditionSucceeded).IsTrue()
+	var completionTime time.Time
+	if tr.Status.CompletionTime != nil {
+		completionTime = tr.Status.CompletionTime.Time
+	} else {
+		completionTime = c.Clock.Now()
+	}
+	var errs []error
+	for _, ws := range tr.Spec.Workspaces {
+		if ws.VolumeClaimTemplate == nil || ws.RetentionPolicy == nil {
+			continue
+		}
+		pvcName := volumeclaim.GeneratePVCNameFromWorkspaceBinding(ws.VolumeClaimTemplate.Name, ws, *kmeta.NewControllerRef(tr))
+		if !volumeclaim.IsRetained(ws.RetentionPolicy, succeeded) {
+			errs = append(errs, c.pvcHandler.PurgeFinalizerAndDeletePVCForWorkspace(ctx, pvcName, tr.Namespace))
+			continue
+		}
+		labels := map[string]string{
+			pipeline.TaskRunLabelKey:              tr.Name,
+			volumeclaim.RetainedWorkspaceLabelKey: ws.Name,
+		}
+		errs = append(errs, c.pvcHandler.RetainPVCForWorkspace(ctx, pvcName, tr.Namespace, labels, volumeclaim.RetainedUntil(ws.RetentionPolicy, completionTime)))
+	}
+	return errors.Join(errs...)
+}
+
 func (c *Reconciler) checkPodFailed(ctx context.Context, tr *v1.TaskRun) (bool, v1.TaskRunReason, string) {
 	for _, step := range tr.Status.Steps {
 		if step.Waiting == nil {
@@ -474,7 +611,7 @@ func (c *Reconciler) stopSidecars(ctx context.Context, tr *v1.TaskRun) error {
 	condition := tr.Status.GetCondition(apis.ConditionSucceeded)
 	if condition != nil {
 		reason := v1.TaskRunReason(condition.Reason)
-		if reason == v1.TaskRunReasonCancelled || reason == v1.TaskRunReasonTimedOut {
+		if reason == v1.TaskRunReasonCancelled || reason == v1.TaskRunReasonTimedOut || reason == v1.TaskRunReasonQueueTimedOut {
 			return nil
 		}
 	}
@@ -521,6 +658,21 @@ func (c *Reconciler) emitReconcileEvents(ctx context.Context, tr *v1.TaskRun, be
 	return previousError
 }
 
+// extendTaskSpec merges the TaskSpec into the base Task it extends, if any, and returns the
+// source the base Task came from.
+func (c *Reconciler) extendTaskSpec(ctx context.Context, tr *v1.TaskRun, taskSpec *v1.TaskSpec, vp []*v1alpha1.VerificationPolicy) (*v1.TaskSpec, *v1.RefSource, error) {
+	if taskSpec.Extends == nil {
+		return taskSpec, nil, nil
+	}
+	// An embedded TaskSpec isn't read from the status, unlike a referenced Task.
+	if tr.Status.TaskSpec != nil && tr.Status.TaskSpec.Extends == nil {
+		return tr.Status.TaskSpec.DeepCopy(), nil, nil
+	}
+	baseRef := taskSpec.Extends.TaskRef.DeepCopy()
+	getBaseTask := resources.GetTaskFunc(ctx, c.KubeClientSet, c.PipelineClientSet, c.resolutionRequester, tr, baseRef, tr.Name, tr.Namespace, tr.Spec.ServiceAccountName, vp)
+	return resources.ExtendTaskSpec(ctx, taskSpec, getBaseTask)
+}
+
 // `prepare` fetches resources the taskrun depends on, runs validation and conversion
 // It may report errors back to Reconcile, it updates the taskrun status in case of
 // error but it does not sync updates back to etcd. It does not emit events.
@@ -559,14 +711,29 @@ func (c *Reconciler) prepare(ctx context.Context, tr *v1.TaskRun) (*v1.TaskSpec,
 		}
 		tr.Status.MarkResourceFailed(v1.TaskRunReasonFailedResolution, err)
 		return nil, nil, controller.NewPermanentError(err)
-	default:
-		// Store the fetched TaskSpec on the TaskRun for auditing
-		if err := storeTaskSpecAndMergeMeta(ctx, tr, taskSpec, taskMeta); err != nil {
-			logger.Errorf("Failed to store TaskSpec on TaskRun.Status for taskrun %s: %v", tr.Name, err)
+	}
+
+	taskSpec, taskMeta.BaseRefSource, err = c.extendTaskSpec(ctx, tr, taskSpec, vp)
+	switch {
+	case errors.Is(err, remote.ErrRequestInProgress):
+		message := fmt.Sprintf("TaskRun %s/%s awaiting remote base Task", tr.Namespace, tr.Name)
+		tr.Status.MarkResourceOngoing(v1.TaskRunReasonResolvingTaskRef, message)
+		return nil, nil, controller.NewRequeueAfter(remoteResolutionRequeueAfter)
+	case err != nil:
+		logger.Errorf("Failed to extend the Task spec to use for taskrun %s: %v", tr.Name, err)
+		if resolutioncommon.IsErrTransient(err) {
+			return nil, nil, err
 		}
+		tr.Status.MarkResourceFailed(v1.TaskRunReasonFailedResolution, err)
+		return nil, nil, controller.NewPermanentError(err)
+	}
+
+	// Store the fetched TaskSpec on the TaskRun for auditing
+	if err := storeTaskSpecAndMergeMeta(ctx, tr, taskSpec, taskMeta); err != nil {
+		logger.Errorf("Failed to store TaskSpec on TaskRun.Status for taskrun %s: %v", tr.Name, err)
 	}
 
-	steps, err := resources.GetStepActionsData(ctx, *taskSpec, tr, c.PipelineClientSet, c.KubeClientSet, c.resolutionRequester)
+	resolvedTaskSpec, err := resources.GetStepActionsData(ctx, *taskSpec, tr, c.PipelineClientSet, c.KubeClientSet, c.resolutionRequester)
 	switch {
 	case errors.Is(err, remote.ErrRequestInProgress):
 		message := fmt.Sprintf("TaskRun %s/%s awaiting remote StepAction", tr.Namespace, tr.Name)
@@ -586,7 +753,7 @@ func (c *Reconciler) prepare(ctx context.Context, tr *v1.TaskRun) (*v1.TaskSpec,
 		return nil, nil, controller.NewPermanentError(err)
 	default:
 		// Store the fetched StepActions to TaskSpec, and update the stored TaskSpec again
-		taskSpec.Steps = steps
+		*taskSpec = *resolvedTaskSpec
 		if err := storeTaskSpecAndMergeMeta(ctx, tr, taskSpec, taskMeta); err != nil {
 			logger.Errorf("Failed to store TaskSpec on TaskRun.Status for taskrun %s: %v", tr.Name, err)
 		}
@@ -657,6 +824,31 @@ func (c *Reconciler) prepare(ctx context.Context, tr *v1.TaskRun) (*v1.TaskSpec,
 		}
 	}
 
+	if err := func() error {
+		spanCtx, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "ValidateParamSchema")
+		defer span.End()
+		return ValidateParamSchema(spanCtx, tr.Spec.Params, rtr.TaskSpec.Params)
+	}(); err != nil {
+		logger.Errorf("TaskRun %q Param Schema validation failed: %v", tr.Name, err)
+		tr.Status.MarkResourceFailed(v1.TaskRunReasonInvalidParamValue, err)
+		return nil, nil, controller.NewPermanentError(err)
+	}
+
+	// Move the values of the sensitive params to a Secret; the updated params are persisted by syncMetadata
+	params, err := sensitiveparams.MoveToSecret(ctx, c.KubeClientSet, tr.Spec.Params, rtr.TaskSpec.Params,
+		*kmeta.NewControllerRef(tr), tr.Namespace, map[string]string{pipeline.TaskRunLabelKey: tr.Name})
+	if err != nil {
+		logger.Errorf("Failed to move the sensitive params of TaskRun %q to a Secret: %v", tr.Name, err)
+		return nil, nil, err
+	}
+	tr.Spec.Params = params
+
+	if err := resources.ValidateSensitiveParamReferences(rtr.TaskSpec, tr.Spec.Params); err != nil {
+		logger.Errorf("TaskRun %q references sensitive params in a script: %v", tr.Name, err)
+		tr.Status.MarkResourceFailed(v1.TaskRunReasonFailedValidation, pipelineErrors.WrapUserError(err))
+		return nil, nil, controller.NewPermanentError(err)
+	}
+
 	if err := func() error {
 		_, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "ValidateParamArrayIndex")
 		defer span.End()
@@ -827,11 +1019,31 @@ func (c *Reconciler) reconcile(ctx context.Context, tr *v1.TaskRun, rtr *resourc
 		return err
 	}
 
+	// The values of the results stored in object storage are only fetched to validate them once the
+	// TaskRun completed, since its results do not change anymore.
+	var fetchStoredResults storedResultsFetcher
+	if tr.IsDone() {
+		fetchStoredResults = func(results []v1.TaskRunResult) ([]v1.TaskRunResult, error) {
+			b, err := objectstorageresults.NewBucketFromConfig(ctx, c.secretLister, tr.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			return objectstorageresults.FetchResults(ctx, c.resultsCache, b, results)
+		}
+	}
 	if err := func() error {
 		_, span := c.tracerProvider.Tracer(TracerName).Start(ctx, "validateTaskRunResults")
 		defer span.End()
-		return validateTaskRunResults(tr, rtr.TaskSpec)
+		return validateTaskRunResults(tr, rtr.TaskSpec, fetchStoredResults)
 	}(); err != nil {
+		var fetchErr *storedResultsFetchError
+		if errors.As(err, &fetchErr) {
+			// The results could not be fetched, not found invalid: the TaskRun is kept running and
+			// its results are validated again on the next reconcile, until the TaskRun times out.
+			tr.Status.MarkResourceOngoing(v1.TaskRunReasonRunning, err.Error())
+			tr.Status.CompletionTime = nil
+			return err
+		}
 		tr.Status.MarkResourceFailed(v1.TaskRunReasonFailedValidation, err)
 		return err
 	}
@@ -877,7 +1089,8 @@ func (c *Reconciler) updateTaskRunWithDefaultWorkspaces(ctx context.Context, tr
 	return nil
 }
 
-// syncMetadata persists label and annotation changes made during reconciliation.
+// syncMetadata persists label and annotation changes made during reconciliation, along
+// with the sensitive params whose values were moved to a Secret.
 // Knative's generated reconciler only calls UpdateStatus() after ReconcileKind returns,
 // so metadata changes must be persisted separately. This is called via defer in
 // ReconcileKind to ensure it runs on every exit path.
@@ -903,13 +1116,16 @@ func (c *Reconciler) syncMetadata(ctx context.Context, tr *v1.TaskRun) (err erro
 		tr.Annotations,
 	)
 
-	if maps.Equal(mergedLabels, existing.ObjectMeta.Labels) && maps.Equal(mergedAnnotations, existing.ObjectMeta.Annotations) {
+	params, paramsMoved := existing.Spec.Params.ReplaceMovedSensitiveParams(tr.Spec.Params)
+
+	if maps.Equal(mergedLabels, existing.ObjectMeta.Labels) && maps.Equal(mergedAnnotations, existing.ObjectMeta.Annotations) && !paramsMoved {
 		return nil
 	}
 
 	updated := existing.DeepCopy()
 	updated.Labels = mergedLabels
 	updated.Annotations = mergedAnnotations
+	updated.Spec.Params = params
 	_, err = c.PipelineClientSet.TektonV1().TaskRuns(tr.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
 	return err
 }
@@ -922,8 +1138,11 @@ func (c *Reconciler) handlePodCreationError(tr *v1.TaskRun, err error) error {
 		tr.Status.MarkResourceOngoing(podconvert.ReasonPodPending, "tried to create pod, but it failed with ResourceQuotaConflictError")
 		return controller.NewRequeueAfter(time.Second)
 	case isExceededResourceQuotaError(err):
-		// If we are struggling to create the pod, then it hasn't started.
-		tr.Status.StartTime = nil
+		// If we are struggling to create the pod, then it hasn't started, unless the queue timeout
+		// of the TaskRun bounds the time it waits for quota.
+		if !tr.IsQueued() {
+			tr.Status.StartTime = nil
+		}
 		tr.Status.MarkResourceOngoing(podconvert.ReasonExceededResourceQuota, fmt.Sprint("TaskRun Pod exceeded available resources: ", err))
 		return controller.NewRequeueAfter(time.Minute)
 	case isTaskRunValidationFailed(err):
@@ -976,7 +1195,7 @@ func (c *Reconciler) failTaskRun(ctx context.Context, tr *v1.TaskRun, reason v1.
 	terminateStepsInPod(tr, reason)
 
 	var err error
-	if (reason == v1.TaskRunReasonCancelled || reason == v1.TaskRunReasonTimedOut) && (config.FromContextOrDefaults(ctx).FeatureFlags.EnableKeepPodOnCancel) {
+	if (reason == v1.TaskRunReasonCancelled || reason == v1.TaskRunReasonTimedOut || reason == v1.TaskRunReasonQueueTimedOut) && (config.FromContextOrDefaults(ctx).FeatureFlags.EnableKeepPodOnCancel) {
 		logger.Infof("Canceling task run %q by entrypoint, Reason: %s", tr.Name, reason)
 		err = podconvert.CancelPod(ctx, c.KubeClientSet, tr.Namespace, tr.Status.PodName)
 	} else {
@@ -1038,8 +1257,9 @@ func (c *Reconciler) updateStepStatusesFromPod(ctx context.Context, tr *v1.TaskR
 		return err
 	}
 
-	// Only update the Steps field to avoid overwriting other status fields
+	// Only update the Steps and the time the first of them started to avoid overwriting other status fields
 	tr.Status.Steps = status.Steps
+	tr.Status.ExecutionStartTime = status.ExecutionStartTime
 	return nil
 }
 
@@ -1089,6 +1309,7 @@ func (c *Reconciler) createPod(ctx context.Context, ts *v1.TaskSpec, tr *v1.Task
 		}
 	}()
 	logger := logging.FromContext(ctx)
+	originalTs, originalTr := ts, tr
 
 	// We don't want to mutate tr.Status.TaskSpec inside
 	// the createPod function. It's possible that pod will
@@ -1133,9 +1354,11 @@ func (c *Reconciler) createPod(ctx context.Context, ts *v1.TaskSpec, tr *v1.Task
 	}
 
 	podbuilder := podconvert.Builder{
-		Images:          c.Images,
-		KubeClient:      c.KubeClientSet,
-		EntrypointCache: c.entrypointCache,
+		Images:                  c.Images,
+		KubeClient:              c.KubeClientSet,
+		EntrypointCache:         c.entrypointCache,
+		ExtractImageVolumes:     c.extractImageVolumes.Load(),
+		DividePodLevelResources: c.dividePodLevelResources.Load(),
 	}
 	pod, err := podbuilder.Build(ctx, tr, *ts,
 		defaultresourcerequirements.NewTransformer(ctx),
@@ -1146,9 +1369,24 @@ func (c *Reconciler) createPod(ctx context.Context, ts *v1.TaskSpec, tr *v1.Task
 		return nil, fmt.Errorf("translating TaskSpec to Pod: %w", err)
 	}
 
+	// Clusters without the PodLevelResources feature drop the pod-level resources of Pods, so the first
+	// Pod with some is created in dry-run to check they are kept. Otherwise the Pod is built again with
+	// the task-level compute resources divided among its steps, as are the next Pods.
+	if pod.Spec.Resources != nil && !c.podLevelResourcesChecked.Load() {
+		if dryRun, err := c.KubeClientSet.CoreV1().Pods(tr.Namespace).Create(ctx, pod, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}); err == nil {
+			c.podLevelResourcesChecked.Store(true)
+			if dryRun.Spec.Resources == nil {
+				logger.Info("Dividing the task-level compute resources among steps since the cluster does not support pod-level resources")
+				c.dividePodLevelResources.Store(true)
+				return c.createPod(ctx, originalTs, originalTr, rtr, workspaceVolumes)
+			}
+		}
+	}
+
 	// Stash the podname in case there's create conflict so that we can try
 	// to fetch it.
 	podName := pod.Name
+	built := pod
 
 	cfg := config.FromContextOrDefaults(ctx)
 	if !cfg.FeatureFlags.EnableWaitExponentialBackoff {
@@ -1188,6 +1426,13 @@ func (c *Reconciler) createPod(ctx context.Context, ts *v1.TaskSpec, tr *v1.Task
 			return p, nil
 		}
 	}
+	// Clusters without the ImageVolume feature drop the sources of image volumes, so the Pod is
+	// created again with init containers extracting the images, as are the next Pods.
+	if err != nil && !podbuilder.ExtractImageVolumes && podconvert.IsImageVolumeUnsupportedError(err, built) {
+		logger.Infof("Extracting the images workspaces are bound to in init containers since the cluster does not support image volumes: %v", err)
+		c.extractImageVolumes.Store(true)
+		return c.createPod(ctx, originalTs, originalTr, rtr, workspaceVolumes)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1345,6 +1590,9 @@ func storeTaskSpecAndMergeMeta(ctx context.Context, tr *v1.TaskRun, ts *v1.TaskS
 		if meta != nil && meta.RefSource != nil && tr.Status.Provenance.RefSource == nil {
 			tr.Status.Provenance.RefSource = meta.RefSource
 		}
+		if meta != nil && meta.BaseRefSource != nil && tr.Status.Provenance.BaseRefSource == nil {
+			tr.Status.Provenance.BaseRefSource = meta.BaseRefSource
+		}
 	}
 
 	return nil
@@ -1404,9 +1652,36 @@ func retryTaskRun(tr *v1.TaskRun, message string) {
 	newStatus.RetriesStatus = nil
 	tr.Status.RetriesStatus = append(tr.Status.RetriesStatus, *newStatus)
 	tr.Status.StartTime = nil
+	tr.Status.ExecutionStartTime = nil
 	tr.Status.CompletionTime = nil
 	tr.Status.PodName = ""
 	tr.Status.Results = nil
 	taskRunCondSet := apis.NewBatchConditionSet()
 	taskRunCondSet.Manage(&tr.Status).MarkUnknown(apis.ConditionSucceeded, v1.TaskRunReasonToBeRetried.String(), message)
 }
+
+// retryBackoffRemaining returns how long a TaskRun to be retried still has to wait
+// before its next attempt is started, according to the backoff of its RetryPolicy.
+// The delay is counted from the completion time of the last failed attempt.
+func retryBackoffRemaining(tr *v1.TaskRun, c clock.PassiveClock) time.Duration {
+	retry := len(tr.Status.RetriesStatus)
+	if tr.HasStarted() || retry == 0 {
+		return 0
+	}
+	condition := tr.Status.GetCondition(apis.ConditionSucceeded)
+	if condition == nil || condition.Reason != v1.TaskRunReasonToBeRetried.String() {
+		return 0
+	}
+	delay := tr.Spec.RetryPolicy.GetDelay(retry, tr.GetNamespacedName().String())
+	if delay == 0 {
+		return 0
+	}
+	failedAt := condition.LastTransitionTime.Inner.Time
+	if last := tr.Status.RetriesStatus[retry-1]; last.CompletionTime != nil {
+		failedAt = last.CompletionTime.Time
+	}
+	if remaining := delay - c.Since(failedAt); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
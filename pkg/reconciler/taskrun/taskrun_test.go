@@ -19,15 +19,18 @@ package taskrun
 import (
 	"bytes"
 	"context"
+	"crypto/elliptic"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -36,7 +39,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults"
+	"github.com/tektoncd/pipeline/internal/objectstorageresults/objectstoragetest"
 	"github.com/tektoncd/pipeline/pkg/apis/config"
 	cfgtesting "github.com/tektoncd/pipeline/pkg/apis/config/testing"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
@@ -1201,6 +1207,121 @@ spec:
 	}
 }
 
+// TestReconcileWithExtendedTask checks that the TaskSpec of a TaskRun extending a base Task
+// fetched through a Resolver is merged into the base Task, and that the source of the base
+// Task is recorded in the provenance.
+func TestReconcileWithExtendedTask(t *testing.T) {
+	tr := parse.MustParseV1TaskRun(t, `
+metadata:
+  name: tr
+  namespace: default
+spec:
+  taskSpec:
+    extends:
+      resolver: foobar
+    params:
+    - name: greeting
+      default: bonjour
+    steps:
+    - name: goodbye
+      image: docker.io/library/ubuntu
+      script: echo "goodbye"
+`)
+
+	d := test.Data{
+		TaskRuns: []*v1.TaskRun{tr},
+		ConfigMaps: []*corev1.ConfigMap{{
+			ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetFeatureFlagsConfigName()},
+			Data: map[string]string{
+				"enable-api-fields": config.AlphaAPIFields,
+			},
+		}},
+		ServiceAccounts: []*corev1.ServiceAccount{{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: tr.Namespace},
+		}},
+	}
+
+	testAssets, cancel := getTaskRunController(t, d)
+	defer cancel()
+	c := testAssets.Controller
+	clients := testAssets.Clients
+
+	if err := c.Reconciler.Reconcile(testAssets.Ctx, getRunName(tr)); err == nil {
+		t.Error("Wanted a resource request in progress error, but got nil.")
+	} else if controller.IsPermanentError(err) {
+		t.Errorf("expected no error. Got error %v", err)
+	}
+
+	client := testAssets.Clients.ResolutionRequests.ResolutionV1beta1().ResolutionRequests("default")
+	resolutionrequests, err := client.List(testAssets.Ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error listing resource requests: %v", err)
+	}
+	if len(resolutionrequests.Items) != 1 {
+		t.Fatalf("expected exactly 1 resource request but found %d", len(resolutionrequests.Items))
+	}
+
+	// Mock a successful resolution of the base Task
+	resreq := &resolutionrequests.Items[0]
+	taskBytes := []byte(`
+          kind: Task
+          apiVersion: tekton.dev/v1
+          metadata:
+            name: greet
+          spec:
+            params:
+            - name: greeting
+              default: hello
+            steps:
+            - name: greet
+              image: docker.io/library/ubuntu
+              script: echo "$(params.greeting)"
+        `)
+	baseRefSource := &v1.RefSource{URI: "https://github.com/tektoncd/catalog", Digest: map[string]string{"sha1": "abc"}}
+	resreq.Status.ResolutionRequestStatusFields.Data = base64.StdEncoding.Strict().EncodeToString(taskBytes)
+	resreq.Status.ResolutionRequestStatusFields.RefSource = baseRefSource
+	resreq.Status.MarkSucceeded()
+	if _, err := client.UpdateStatus(testAssets.Ctx, resreq, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error updating resource request with resolved task data: %v", err)
+	}
+
+	if err := c.Reconciler.Reconcile(testAssets.Ctx, getRunName(tr)); err != nil {
+		if ok, _ := controller.IsRequeueKey(err); !ok {
+			t.Errorf("expected no error. Got error %v", err)
+		}
+	}
+
+	updatedTR, err := clients.Pipeline.TektonV1().TaskRuns(tr.Namespace).Get(testAssets.Ctx, tr.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("getting updated taskrun: %v", err)
+	}
+	condition := updatedTR.Status.GetCondition(apis.ConditionSucceeded)
+	if condition == nil || condition.Reason != v1.TaskRunReasonRunning.String() {
+		t.Errorf("Expected reason %q but had %v", v1.TaskRunReasonRunning.String(), condition)
+	}
+	wantSpec := &v1.TaskSpec{
+		Params: v1.ParamSpecs{{Name: "greeting", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("bonjour")}},
+		Steps: []v1.Step{{
+			Name:   "greet",
+			Image:  "docker.io/library/ubuntu",
+			Script: `echo "bonjour"`,
+		}, {
+			Name:   "goodbye",
+			Image:  "docker.io/library/ubuntu",
+			Script: `echo "goodbye"`,
+		}},
+	}
+	if d := cmp.Diff(wantSpec, updatedTR.Status.TaskSpec); d != "" {
+		t.Errorf("unexpected TaskSpec in the status %s", diff.PrintWantGot(d))
+	}
+	if updatedTR.Status.Provenance == nil {
+		t.Fatal("expected the provenance to be recorded")
+	}
+	if d := cmp.Diff(baseRefSource, updatedTR.Status.Provenance.BaseRefSource); d != "" {
+		t.Errorf("unexpected base RefSource in the provenance %s", diff.PrintWantGot(d))
+	}
+}
+
 // TestReconcileWithFailingResolver checks that a TaskRun with a failing Resolver
 // field creates a ResolutionRequest object for that Resolver's type, and
 // that when the request fails, the TaskRun fails.
@@ -1912,6 +2033,131 @@ status:
       maxResultSize: 4096
       coschedule: "workspaces"
       disableInlineSpec: ""
+`)
+		toBeTimedOutNotRetriedTaskRun = parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-run-retry-on-mismatch
+  namespace: foo
+spec:
+  retries: 1
+  retryPolicy:
+    retryOn:
+    - reason: PodEvicted
+  timeout: "10s"
+  taskRef:
+    name: test-task
+status:
+  startTime: "2021-12-31T00:00:00Z"
+  conditions:
+  - reason: Running
+    status: Unknown
+    type: Succeeded
+`)
+		timedOutNotRetriedTaskRun = parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-run-retry-on-mismatch
+  namespace: foo
+spec:
+  retries: 1
+  retryPolicy:
+    retryOn:
+    - reason: PodEvicted
+  timeout: "10s"
+  taskRef:
+    name: test-task
+status:
+  conditions:
+  - reason: TaskRunTimeout
+    status: "False"
+    type: Succeeded
+    message: TaskRun "test-taskrun-run-retry-on-mismatch" failed to finish within "10s"
+`)
+		inRetryBackoffTaskRun = parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-in-retry-backoff
+  namespace: foo
+spec:
+  retries: 2
+  retryPolicy:
+    backoff:
+      delay: 1m
+  taskRef:
+    name: test-task
+status:
+  conditions:
+  - reason: ToBeRetried
+    status: Unknown
+    type: Succeeded
+  retriesStatus:
+  - conditions:
+    - reason: Failed
+      status: "False"
+      type: Succeeded
+    startTime: "2021-12-31T23:59:00Z"
+    completionTime: "2021-12-31T23:59:30Z"
+`)
+		afterRetryBackoffTaskRun = parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-after-retry-backoff
+  namespace: foo
+spec:
+  retries: 2
+  retryPolicy:
+    backoff:
+      delay: 1m
+  taskRef:
+    name: test-task
+status:
+  conditions:
+  - reason: ToBeRetried
+    status: Unknown
+    type: Succeeded
+  retriesStatus:
+  - conditions:
+    - reason: Failed
+      status: "False"
+      type: Succeeded
+    startTime: "2021-12-31T23:58:00Z"
+    completionTime: "2021-12-31T23:58:30Z"
+`)
+		retriedAfterRetryBackoffTaskRun = parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-after-retry-backoff
+  namespace: foo
+spec:
+  retries: 2
+  retryPolicy:
+    backoff:
+      delay: 1m
+  taskRef:
+    name: test-task
+status:
+  podName:   "test-taskrun-after-retry-backoff-pod-retry1"
+  conditions:
+  - reason: Running
+    status: Unknown
+    type: Succeeded
+    message: Not all Steps in the Task have finished executing
+  retriesStatus:
+  - conditions:
+    - reason: Failed
+      status: "False"
+      type: Succeeded
+    startTime: "2021-12-31T23:58:00Z"
+    completionTime: "2021-12-31T23:58:30Z"
+  provenance:
+    featureFlags:
+      runningInEnvWithInjectedSidecars: true
+      enableAPIFields: "beta"
+      sendCloudEventsForRuns: true
+      enforceNonfalsifiability: "none"
+      awaitSidecarReadiness: true
+      verificationNoMatchPolicy: "ignore"
+      enableProvenanceInStatus: true
+      resultExtractionMethod: "termination-message"
+      maxResultSize: 4096
+      coschedule: "workspaces"
+      disableInlineSpec: ""
 `)
 		toBeRetriedWithResultsTaskRun = parse.MustParseV1TaskRun(t, `
 metadata:
@@ -2041,6 +2287,34 @@ status:
 		tr:            toBeRetriedWithResultsTaskRun,
 		wantTr:        retriedWithResultsTaskRun,
 		wantStartTime: false,
+	}, {
+		name: "No Retry when the failure does not match retryOn",
+		testData: test.Data{
+			TaskRuns: []*v1.TaskRun{toBeTimedOutNotRetriedTaskRun},
+			Tasks:    []*v1.Task{simpleTask},
+		},
+		tr:                 toBeTimedOutNotRetriedTaskRun,
+		wantTr:             timedOutNotRetriedTaskRun,
+		wantCompletionTime: true,
+		wantStartTime:      true,
+	}, {
+		name: "Wait for the retry backoff of a ToBeRetried TaskRun",
+		testData: test.Data{
+			TaskRuns: []*v1.TaskRun{inRetryBackoffTaskRun},
+			Tasks:    []*v1.Task{simpleTask},
+		},
+		tr:            inRetryBackoffTaskRun,
+		wantTr:        inRetryBackoffTaskRun,
+		wantStartTime: false,
+	}, {
+		name: "Start a ToBeRetried TaskRun after its retry backoff",
+		testData: test.Data{
+			TaskRuns: []*v1.TaskRun{afterRetryBackoffTaskRun},
+			Tasks:    []*v1.Task{simpleTask},
+		},
+		tr:            afterRetryBackoffTaskRun,
+		wantTr:        retriedAfterRetryBackoffTaskRun,
+		wantStartTime: true,
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			testAssets, cancel := getTaskRunController(t, tc.testData)
@@ -2658,6 +2932,242 @@ status:
 	}
 }
 
+func TestReconcileOnCompletedTaskRun_ProvenanceAttestation(t *testing.T) {
+	taskRun := parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-run-success
+  namespace: foo
+  uid: test-taskrun-uid
+spec:
+  taskRef:
+    name: test-task
+status:
+  conditions:
+  - message: Build succeeded
+    reason: Build succeeded
+    status: "True"
+    type: Succeeded
+  startTime: "2021-12-31T23:59:45Z"
+  completionTime: "2022-01-01T00:00:00Z"
+  artifacts:
+    outputs:
+    - name: image
+      buildOutput: true
+      values:
+      - uri: pkg:oci/app?repository_url=gcr.io/org
+        digest:
+          sha256: df85b9e3983fe2ce20ef76ad675ecf435cc99fc9350adc54fa230bae8c32ce48
+  provenance:
+    featureFlags:
+      enableProvenanceAttestations: true
+`)
+	privPEM, _, err := cryptoutils.GeneratePEMEncodedECDSAKeyPair(elliptic.P256(), cryptoutils.SkipPassword)
+	if err != nil {
+		t.Fatalf("GeneratePEMEncodedECDSAKeyPair: %v", err)
+	}
+	signingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: "signing-secrets"},
+		Data:       map[string][]byte{"cosign.key": privPEM},
+	}
+	for _, tc := range []struct {
+		name            string
+		annotations     map[string]string
+		provenance      *v1.Provenance
+		secrets         []*corev1.Secret
+		wantAttestation string
+		wantError       bool
+		wantEvents      []string
+	}{{
+		name:            "completed",
+		secrets:         []*corev1.Secret{signingSecret},
+		wantAttestation: "test-taskrun-run-success-provenance",
+	}, {
+		name:            "attestation annotation preset by the user",
+		annotations:     map[string]string{"attestation.tekton.dev/provenance": "forged"},
+		secrets:         []*corev1.Secret{signingSecret},
+		wantAttestation: "test-taskrun-run-success-provenance",
+	}, {
+		name:       "completed before attestations were enabled",
+		provenance: &v1.Provenance{FeatureFlags: &config.FeatureFlags{}},
+		secrets:    []*corev1.Secret{signingSecret},
+	}, {
+		name: "already attested",
+		provenance: &v1.Provenance{
+			FeatureFlags: &config.FeatureFlags{EnableProvenanceAttestations: true},
+			Attestation:  "existing-provenance",
+		},
+		secrets:         []*corev1.Secret{signingSecret},
+		wantAttestation: "existing-provenance",
+	}, {
+		name:       "signing secret missing",
+		wantError:  true,
+		wantEvents: []string{"Warning ProvenanceAttestationFailed failed to get the provenance signing Secret signing-secrets: secret \"signing-secrets\" not found"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			tr := taskRun.DeepCopy()
+			tr.Annotations = tc.annotations
+			if tc.provenance != nil {
+				tr.Status.Provenance = tc.provenance
+			}
+			d := test.Data{
+				TaskRuns: []*v1.TaskRun{tr},
+				Tasks:    []*v1.Task{simpleTask},
+				ConfigMaps: []*corev1.ConfigMap{{
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetFeatureFlagsConfigName()},
+					Data:       map[string]string{"enable-provenance-attestations": "true"},
+				}, {
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetDefaultsConfigName()},
+					Data:       map[string]string{"default-provenance-signing-secret": "signing-secrets"},
+				}},
+				Secrets: tc.secrets,
+			}
+
+			testAssets, cancel := getTaskRunController(t, d)
+			defer cancel()
+			c := testAssets.Controller
+			clients := testAssets.Clients
+
+			if err := c.Reconciler.Reconcile(testAssets.Ctx, getRunName(taskRun)); err != nil {
+				t.Fatalf("Unexpected error when reconciling completed TaskRun : %v", err)
+			}
+			newTr, err := clients.Pipeline.TektonV1().TaskRuns(taskRun.Namespace).Get(testAssets.Ctx, taskRun.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Expected completed TaskRun %s to exist but instead got error when getting it: %v", taskRun.Name, err)
+			}
+			if newTr.Status.Provenance.Attestation != tc.wantAttestation {
+				t.Errorf("Expected the TaskRun to reference the provenance attestation %q, got %q", tc.wantAttestation, newTr.Status.Provenance.Attestation)
+			}
+			if (newTr.Status.Provenance.AttestationError != "") != tc.wantError {
+				t.Errorf("Expected attestation error %t, got %q", tc.wantError, newTr.Status.Provenance.AttestationError)
+			}
+			if err := k8sevent.CheckEventsOrdered(t, testAssets.Recorder.Events, tc.name, tc.wantEvents); err != nil {
+				t.Error(err)
+			}
+			if tc.wantAttestation != "test-taskrun-run-success-provenance" {
+				if cms, err := clients.Kube.CoreV1().ConfigMaps(taskRun.Namespace).List(testAssets.Ctx, metav1.ListOptions{}); err != nil || len(cms.Items) != 0 {
+					t.Errorf("Expected no provenance attestation to be created, got %v, %v", cms, err)
+				}
+				return
+			}
+			cmName := newTr.Status.Provenance.Attestation
+			cm, err := clients.Kube.CoreV1().ConfigMaps(taskRun.Namespace).Get(testAssets.Ctx, cmName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Expected the ConfigMap %s of the provenance attestation to exist: %v", cmName, err)
+			}
+			if !strings.Contains(cm.Data["attestation.json"], `"payloadType":"application/vnd.in-toto+json"`) {
+				t.Errorf("Expected the ConfigMap to hold an in-toto envelope, got %v", cm.Data)
+			}
+		})
+	}
+}
+
+func TestEvictWorkspaceCaches(t *testing.T) {
+	var deleted []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `<ListBucketResult>`+
+				`<Contents><Key>caches/foo/old.tar.gz</Key><Size>10</Size><LastModified>2026-01-01T00:00:00Z</LastModified></Contents>`+
+				`<Contents><Key>caches/foo/new.tar.gz</Key><Size>10</Size><LastModified>2026-01-02T00:00:00Z</LastModified></Contents>`+
+				`</ListBucketResult>`)
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer s.Close()
+
+	ctx := config.ToContext(t.Context(), &config.Config{
+		Defaults: &config.Defaults{
+			DefaultResultsObjectStorageEndpoint: s.URL,
+			DefaultResultsObjectStorageBucket:   "results",
+			DefaultResultsObjectStorageSecret:   "results-storage",
+			DefaultWorkspaceCacheSizeLimit:      15,
+		},
+	})
+	c := &Reconciler{secretLister: objectstoragetest.SecretLister(objectstoragetest.CredentialsSecret("foo", "results-storage"))}
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "foo"},
+		Status: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{
+			WorkspaceCaches: []v1.WorkspaceCacheStatus{{Name: "deps", Key: "new", Outcome: v1.WorkspaceCacheMiss}},
+		}},
+	}
+	c.evictWorkspaceCaches(ctx, tr)
+	if d := cmp.Diff([]string{"/results/caches/foo/old.tar.gz"}, deleted); d != "" {
+		t.Errorf("deleted objects %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestReleaseVolumeClaims(t *testing.T) {
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: "foo", UID: "build-uid"},
+		Spec: v1.TaskRunSpec{Workspaces: []v1.WorkspaceBinding{{
+			Name:                "retained",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{},
+			RetentionPolicy: &v1.VolumeClaimRetentionPolicy{
+				Policy: v1.VolumeClaimRetentionRetainOnFailure,
+				TTL:    &metav1.Duration{Duration: time.Hour},
+			},
+		}, {
+			Name:                "deleted",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{},
+			RetentionPolicy:     &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionDelete},
+		}, {
+			Name:                "owned",
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaim{},
+		}}},
+		Status: v1.TaskRunStatus{TaskRunStatusFields: v1.TaskRunStatusFields{CompletionTime: &metav1.Time{Time: now}}},
+	}
+	tr.Status.SetCondition(&apis.Condition{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse})
+
+	var pvcs []runtime.Object
+	pvcNames := map[string]string{}
+	for _, ws := range tr.Spec.Workspaces {
+		pvcNames[ws.Name] = volumeclaim.GeneratePVCNameFromWorkspaceBinding("", ws, *kmeta.NewControllerRef(tr))
+		pvcs = append(pvcs, &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{
+			Name:            pvcNames[ws.Name],
+			Namespace:       tr.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(tr)},
+		}})
+	}
+	kubeClientSet := fakekubeclientset.NewSimpleClientset(pvcs...)
+	var deleted []string
+	kubeClientSet.PrependReactor("delete", "persistentvolumeclaims", func(action ktesting.Action) (bool, runtime.Object, error) {
+		deleted = append(deleted, action.(ktesting.DeleteAction).GetName())
+		return true, nil, nil
+	})
+	c := &Reconciler{
+		KubeClientSet: kubeClientSet,
+		Clock:         clock.NewFakePassiveClock(now.Add(20 * time.Minute)),
+		pvcHandler:    volumeclaim.NewPVCHandler(kubeClientSet, logging.FromContext(t.Context())),
+	}
+
+	ctx := t.Context()
+	if err := c.releaseVolumeClaims(ctx, tr); err != nil {
+		t.Fatalf("releaseVolumeClaims: %v", err)
+	}
+	if d := cmp.Diff([]string{pvcNames["deleted"]}, deleted); d != "" {
+		t.Errorf("deleted PVCs %s", diff.PrintWantGot(d))
+	}
+	retained, err := kubeClientSet.CoreV1().PersistentVolumeClaims(tr.Namespace).Get(ctx, pvcNames["retained"], metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error when retrieving the retained PVC: %v", err)
+	}
+	if len(retained.OwnerReferences) > 0 {
+		t.Errorf("expected the owner references of the retained PVC to be removed, got %v", retained.OwnerReferences)
+	}
+	wantLabels := map[string]string{
+		pipeline.TaskRunLabelKey:              "build",
+		volumeclaim.RetainedWorkspaceLabelKey: "retained",
+		volumeclaim.RetainedUntilLabelKey:     strconv.FormatInt(now.Add(time.Hour).Unix(), 10),
+	}
+	if d := cmp.Diff(wantLabels, retained.Labels); d != "" {
+		t.Errorf("unexpected labels of the retained PVC %s", diff.PrintWantGot(d))
+	}
+}
+
 func TestReconcileOnCancelledTaskRun(t *testing.T) {
 	taskRun := parse.MustParseV1TaskRun(t, `
 metadata:
@@ -3605,6 +4115,61 @@ status:
 			wantEvents: []string{
 				"Warning Failed ",
 			},
+		}, {
+			name: "taskrun with queue timeout",
+			taskRun: parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-queue-timeout
+  namespace: foo
+spec:
+  taskRef:
+    name: test-task
+  timeout: 1h
+  timeouts:
+    queue: 10s
+status:
+  conditions:
+  - status: Unknown
+    type: Succeeded
+  startTime: "2021-12-31T23:59:45Z"
+`),
+			expectedStatus: &apis.Condition{
+				Type:    apis.ConditionSucceeded,
+				Status:  corev1.ConditionFalse,
+				Reason:  "TaskRunQueueTimeout",
+				Message: `TaskRun "test-taskrun-queue-timeout" did not start executing within the queue timeout "10s"`,
+			},
+			wantEvents: []string{
+				"Warning Failed ",
+			},
+		}, {
+			name: "taskrun with queue timeout times out from its execution start time",
+			taskRun: parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-execution-timeout
+  namespace: foo
+spec:
+  taskRef:
+    name: test-task
+  timeout: 10s
+  timeouts:
+    queue: 1h
+status:
+  conditions:
+  - status: Unknown
+    type: Succeeded
+  startTime: "2021-12-31T23:00:00Z"
+  executionStartTime: "2021-12-31T23:59:45Z"
+`),
+			expectedStatus: &apis.Condition{
+				Type:    apis.ConditionSucceeded,
+				Status:  corev1.ConditionFalse,
+				Reason:  "TaskRunTimeout",
+				Message: `TaskRun "test-taskrun-execution-timeout" failed to finish within "10s"`,
+			},
+			wantEvents: []string{
+				"Warning Failed ",
+			},
 		},
 	}
 
@@ -4962,6 +5527,176 @@ spec:
 	}
 }
 
+func TestReconcile_ImageVolumesUnsupported(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := test.CreateImage(u.Host+"/toolchain", simpleTask)
+	if err != nil {
+		t.Fatalf("failed to upload the image of the workspace: %v", err)
+	}
+	var taskRuns []*v1.TaskRun
+	for _, name := range []string{"test-taskrun-first", "test-taskrun-second"} {
+		taskRuns = append(taskRuns, parse.MustParseV1TaskRun(t, fmt.Sprintf(`
+metadata:
+  name: %s
+  namespace: foo
+spec:
+  taskSpec:
+    workspaces:
+    - name: toolchain
+    steps:
+    - name: build
+      image: foo
+      command: ["/mycmd"]
+  workspaces:
+  - name: toolchain
+    image:
+      reference: %s
+`, name, ref)))
+	}
+	d := test.Data{
+		TaskRuns: taskRuns,
+		ConfigMaps: []*corev1.ConfigMap{{
+			ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetFeatureFlagsConfigName()},
+			Data:       map[string]string{"enable-api-fields": "alpha"},
+		}},
+	}
+	testAssets, cancel := getTaskRunController(t, d)
+	defer cancel()
+	createServiceAccount(t, testAssets, "default", "foo")
+
+	// The cluster drops the sources of image volumes, so the Pods using them are invalid.
+	rejected := 0
+	testAssets.Clients.Kube.PrependReactor("create", "pods", func(action ktesting.Action) (bool, runtime.Object, error) {
+		pod := action.(ktesting.CreateAction).GetObject().(*corev1.Pod)
+		for i, v := range pod.Spec.Volumes {
+			if v.Image != nil {
+				rejected++
+				return true, nil, apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, pod.Name, field.ErrorList{
+					field.Required(field.NewPath("spec", "volumes").Index(i), "must specify a volume type"),
+				})
+			}
+		}
+		return false, nil, nil
+	})
+
+	for _, tr := range taskRuns {
+		if err := testAssets.Controller.Reconciler.Reconcile(testAssets.Ctx, getRunName(tr)); err != nil {
+			if ok, _ := controller.IsRequeueKey(err); !ok {
+				t.Fatalf("Unexpected error when reconciling TaskRun %s: %v", tr.Name, err)
+			}
+		}
+	}
+	if rejected != 1 {
+		t.Errorf("Expected only the first Pod with an image volume to be rejected, got %d", rejected)
+	}
+	pods, err := testAssets.Clients.Kube.CoreV1().Pods("foo").List(testAssets.Ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list the Pods: %v", err)
+	}
+	if len(pods.Items) != len(taskRuns) {
+		t.Fatalf("Expected a Pod for each TaskRun, got %v", pods.Items)
+	}
+	for _, pod := range pods.Items {
+		if !slices.ContainsFunc(pod.Spec.InitContainers, func(c corev1.Container) bool { return c.Name == "extract-image-volume-toolchain" }) {
+			t.Errorf("Expected the image of the workspace to be extracted by an init container of Pod %s, got %v", pod.Name, pod.Spec.InitContainers)
+		}
+	}
+}
+
+func TestReconcile_PodLevelResources(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		supported bool
+	}{{
+		name:      "cluster with pod-level resources",
+		supported: true,
+	}, {
+		name: "cluster without pod-level resources",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			var taskRuns []*v1.TaskRun
+			for _, name := range []string{"test-taskrun-first", "test-taskrun-second"} {
+				taskRuns = append(taskRuns, parse.MustParseV1TaskRun(t, fmt.Sprintf(`
+metadata:
+  name: %s
+  namespace: foo
+spec:
+  computeResources:
+    requests:
+      cpu: "2"
+  taskSpec:
+    steps:
+    - name: build
+      image: foo
+      command: ["/mycmd"]
+    - name: test
+      image: foo
+      command: ["/mycmd"]
+`, name)))
+			}
+			d := test.Data{
+				TaskRuns: taskRuns,
+				ConfigMaps: []*corev1.ConfigMap{{
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetFeatureFlagsConfigName()},
+					Data:       map[string]string{"enable-api-fields": "alpha", "enable-pod-level-resources": "true"},
+				}},
+			}
+			testAssets, cancel := getTaskRunController(t, d)
+			defer cancel()
+			createServiceAccount(t, testAssets, "default", "foo")
+
+			// Clusters without the PodLevelResources feature drop the pod-level resources of the Pods.
+			dryRuns := 0
+			testAssets.Clients.Kube.PrependReactor("create", "pods", func(action ktesting.Action) (bool, runtime.Object, error) {
+				create := action.(ktesting.CreateActionImpl)
+				if !slices.Contains(create.CreateOptions.DryRun, metav1.DryRunAll) {
+					return false, nil, nil
+				}
+				dryRuns++
+				pod := create.GetObject().(*corev1.Pod).DeepCopy()
+				if !tc.supported {
+					pod.Spec.Resources = nil
+				}
+				return true, pod, nil
+			})
+
+			for _, tr := range taskRuns {
+				if err := testAssets.Controller.Reconciler.Reconcile(testAssets.Ctx, getRunName(tr)); err != nil {
+					if ok, _ := controller.IsRequeueKey(err); !ok {
+						t.Fatalf("Unexpected error when reconciling TaskRun %s: %v", tr.Name, err)
+					}
+				}
+			}
+			if dryRuns != 1 {
+				t.Errorf("Expected only the first Pod to be created in dry-run, got %d", dryRuns)
+			}
+			pods, err := testAssets.Clients.Kube.CoreV1().Pods("foo").List(testAssets.Ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Failed to list the Pods: %v", err)
+			}
+			if len(pods.Items) != len(taskRuns) {
+				t.Fatalf("Expected a Pod for each TaskRun, got %v", pods.Items)
+			}
+			for _, pod := range pods.Items {
+				if got := pod.Spec.Resources != nil; got != tc.supported {
+					t.Errorf("Expected Pod %s to have pod-level resources %t, got %v", pod.Name, tc.supported, pod.Spec.Resources)
+				}
+				for _, c := range pod.Spec.Containers {
+					cpu, divided := c.Resources.Requests[corev1.ResourceCPU]
+					if divided == tc.supported || (divided && cpu.Cmp(resource.MustParse("1")) != 0) {
+						t.Errorf("Unexpected CPU request of container %s of Pod %s: %v", c.Name, pod.Name, c.Resources.Requests)
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestReconcile_Single_SidecarState(t *testing.T) {
 	runningState := corev1.ContainerStateRunning{StartedAt: metav1.Time{Time: now}}
 	taskRun := parse.MustParseV1TaskRun(t, `
@@ -6308,6 +7043,37 @@ status:
 	}
 }
 
+// TestStopSidecars_QueueTimedOutTaskRun tests that the sidecars of a TaskRun that timed out in
+// the queue are not stopped, since its pod was already terminated when it failed.
+func TestStopSidecars_QueueTimedOutTaskRun(t *testing.T) {
+	tr := parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun
+  namespace: foo
+status:
+  conditions:
+  - status: "False"
+    type: Succeeded
+    reason: TaskRunQueueTimeout
+  podName: test-taskrun-pod
+  sidecars:
+  - running:
+      startedAt: "2000-01-01T01:01:01Z"
+  startTime: "2000-01-01T01:01:01Z"
+`)
+
+	testAssets, cancel := getTaskRunController(t, test.Data{TaskRuns: []*v1.TaskRun{tr}})
+	defer cancel()
+	if err := testAssets.Controller.Reconciler.Reconcile(testAssets.Ctx, getRunName(tr)); err != nil {
+		t.Errorf("Expected no error to be returned by reconciler: %v", err)
+	}
+	for _, action := range testAssets.Clients.Kube.Actions() {
+		if action.Matches("get", "pods") {
+			t.Errorf("expected the pod of a TaskRun that timed out in the queue not to be retrieved to stop its sidecars")
+		}
+	}
+}
+
 // TestStopSidecars_DeclaredSidecarTerminatedInStatusButInjectedStillRuns covers the case where
 // TaskRun.Status.Sidecars only reflects sidecar- prefixed containers; a non-prefixed injected
 // sidecar can still be running on the Pod and must be stopped via the live Pod (see #9760 review).
@@ -7279,6 +8045,125 @@ status:
 	}
 }
 
+func TestReconcile_validateStoredTaskRunResults(t *testing.T) {
+	server := objectstoragetest.NewServer(t)
+	upload := func(value string) string {
+		uri, err := server.Bucket().Upload(t.Context(), []byte(value))
+		if err != nil {
+			t.Fatalf("Upload: %v", err)
+		}
+		return uri
+	}
+	for _, tc := range []struct {
+		name          string
+		uri           string
+		wantErr       bool
+		wantCondition apis.Condition
+	}{{
+		name: "stored result valid",
+		uri:  upload("sha256:valid"),
+		wantCondition: apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionTrue,
+			Reason: v1.TaskRunReasonSuccessful.String(),
+		},
+	}, {
+		name:    "stored result invalid",
+		uri:     upload("md5:invalid"),
+		wantErr: true,
+		wantCondition: apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionFalse,
+			Reason: v1.TaskRunReasonFailedValidation.String(),
+		},
+	}, {
+		name:    "stored result unavailable",
+		uri:     objectstorageresults.URI(objectstoragetest.BucketName, "sha256/0000000000000000000000000000000000000000000000000000000000000000"),
+		wantErr: true,
+		wantCondition: apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionUnknown,
+			Reason: v1.TaskRunReasonRunning.String(),
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			taskRun := parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-stored-results
+  namespace: foo
+spec:
+  taskSpec:
+    results:
+    - name: digest
+      schema:
+        type: string
+        pattern: "^sha256:"
+    steps:
+    - name: digest
+      image: myimage
+      script: echo -n sha256:abc > $(results.digest.path)
+status:
+  podName: test-taskrun-stored-results-pod
+`)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-taskrun-stored-results-pod", Namespace: "foo"},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-digest"}}},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodSucceeded,
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name: "step-digest",
+						State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+							Message: fmt.Sprintf(`[{"key":"digest","value":%q,"type":1}]`, tc.uri),
+						}},
+					}},
+				},
+			}
+			d := test.Data{
+				TaskRuns: []*v1.TaskRun{taskRun},
+				Pods:     []*corev1.Pod{pod},
+				ConfigMaps: []*corev1.ConfigMap{{
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetFeatureFlagsConfigName()},
+					Data:       map[string]string{"results-from": config.ResultExtractionMethodObjectStorage},
+				}, {
+					ObjectMeta: metav1.ObjectMeta{Namespace: system.Namespace(), Name: config.GetDefaultsConfigName()},
+					Data: map[string]string{
+						"default-results-object-storage-endpoint": server.URL,
+						"default-results-object-storage-bucket":   objectstoragetest.BucketName,
+						"default-results-object-storage-secret":   "results-storage",
+					},
+				}},
+				Secrets: []*corev1.Secret{objectstoragetest.CredentialsSecret("foo", "results-storage")},
+			}
+			testAssets, cancel := getTaskRunController(t, d)
+			defer cancel()
+			createServiceAccount(t, testAssets, taskRun.Spec.ServiceAccountName, taskRun.Namespace)
+
+			err := testAssets.Controller.Reconciler.Reconcile(testAssets.Ctx, getRunName(taskRun))
+			if ok, _ := controller.IsRequeueKey(err); (err != nil && !ok) != tc.wantErr {
+				t.Errorf("Reconcile() = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantCondition.Status == corev1.ConditionUnknown && controller.IsPermanentError(err) {
+				t.Errorf("Reconcile() = %v, want the TaskRun to be reconciled again to fetch its results", err)
+			}
+			tr, err := testAssets.Clients.Pipeline.TektonV1().TaskRuns(taskRun.Namespace).Get(testAssets.Ctx, taskRun.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("getting updated taskrun: %v", err)
+			}
+			if d := cmp.Diff(&tc.wantCondition, tr.Status.GetCondition(apis.ConditionSucceeded), ignoreLastTransitionTime, cmpopts.IgnoreFields(apis.Condition{}, "Message")); d != "" {
+				t.Errorf("Unexpected condition %s", diff.PrintWantGot(d))
+			}
+			if tc.wantCondition.Status == corev1.ConditionUnknown {
+				if message := tr.Status.GetCondition(apis.ConditionSucceeded).Message; !strings.HasPrefix(message, "failed to fetch the results stored in object storage") {
+					t.Errorf("Expected the TaskRun to wait for its results to be fetched, got message %q", message)
+				}
+				if tr.Status.CompletionTime != nil {
+					t.Errorf("Expected the TaskRun whose results could not be fetched to be kept running, got completion time %v", tr.Status.CompletionTime)
+				}
+			}
+		})
+	}
+}
+
 func TestReconcile_ReplacementsInStatusTaskSpec(t *testing.T) {
 	task := parse.MustParseV1Task(t, `
 metadata:
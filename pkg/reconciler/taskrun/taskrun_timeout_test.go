@@ -215,6 +215,54 @@ status:
 				},
 			}}},
 		},
+		{
+			name: "taskrun-queue-timeout-keep-pod-on-cancel",
+			taskRun: parse.MustParseV1TaskRun(t, `
+metadata:
+  name: test-taskrun-run-queue-timeout
+  namespace: foo
+spec:
+  taskRef:
+    name: test-task
+  timeouts:
+    queue: 10s
+status:
+  startTime: "2000-01-01T01:01:01Z"
+  conditions:
+  - status: Unknown
+    type: Succeeded
+  podName: foo-is-bar
+  steps:
+  - waiting:
+      reason: PodInitializing
+`),
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "foo-is-bar",
+				Annotations: map[string]string{
+					"test": "test value",
+				},
+			}},
+			featureFlags: map[string]string{
+				config.KeepPodOnCancel: "true",
+			},
+			reason:  v1.TaskRunReasonQueueTimedOut,
+			message: "TaskRun test-taskrun-run-queue-timeout did not start executing within the queue timeout 10s",
+			expectedStatus: apis.Condition{
+				Type:    apis.ConditionSucceeded,
+				Status:  corev1.ConditionFalse,
+				Reason:  v1.TaskRunReasonQueueTimedOut.String(),
+				Message: "TaskRun test-taskrun-run-queue-timeout did not start executing within the queue timeout 10s",
+			},
+			expectedPods: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "foo-is-bar",
+				Annotations: map[string]string{
+					"test":              "test value",
+					"tekton.dev/cancel": "CANCEL",
+				},
+			}}},
+		},
 		{
 			name: "taskrun-timeout-keep-pod-on-cancel-false",
 			taskRun: parse.MustParseV1TaskRun(t, `
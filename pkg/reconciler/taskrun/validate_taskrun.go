@@ -204,6 +204,29 @@ func ValidateEnumParam(ctx context.Context, params []v1.Param, paramSpecs v1.Par
 	return nil
 }
 
+// ValidateParamSchema validates the param values match the JSON Schema in the corresponding paramSpecs if provided.
+// A validation error is returned otherwise.
+func ValidateParamSchema(ctx context.Context, params []v1.Param, paramSpecs v1.ParamSpecs) error {
+	paramSpecNameToSchema := map[string]*v1.JSONSchema{}
+	for _, ps := range paramSpecs {
+		if ps.Schema == nil {
+			continue
+		}
+		paramSpecNameToSchema[ps.Name] = ps.Schema
+	}
+
+	for _, p := range params {
+		schema, ok := paramSpecNameToSchema[p.Name]
+		if !ok {
+			continue
+		}
+		if err := schema.ValidateValue(p.Value); err != nil {
+			return pipelineErrors.WrapUserError(fmt.Errorf("param `%s` value does not match its schema: %w", p.Name, err))
+		}
+	}
+	return nil
+}
+
 func validateTaskSpecRequestResources(taskSpec *v1.TaskSpec) error {
 	if taskSpec != nil {
 		for _, step := range taskSpec.Steps {
@@ -263,8 +286,27 @@ func validateSidecarOverrides(ts *v1.TaskSpec, trs *v1.TaskRunSpec) error {
 	return errors.Join(errs...)
 }
 
+// storedResultsFetcher returns a copy of the results in which the values stored in object storage are fetched.
+type storedResultsFetcher func(results []v1.TaskRunResult) ([]v1.TaskRunResult, error)
+
+// storedResultsFetchError is returned by validateTaskRunResults when the values stored in object storage
+// could not be fetched, which does not mean that the results are invalid.
+type storedResultsFetchError struct {
+	err error
+}
+
+func (e *storedResultsFetchError) Error() string {
+	return "failed to fetch the results stored in object storage to validate them: " + e.err.Error()
+}
+
+func (e *storedResultsFetchError) Unwrap() error {
+	return e.err
+}
+
 // validateResults checks the emitted results type and object properties against the ones defined in spec.
-func validateTaskRunResults(tr *v1.TaskRun, resolvedTaskSpec *v1.TaskSpec) error {
+// The values of the results stored in object storage are fetched when their declaration has a schema or
+// required properties to check them against. They are left unchecked when fetchStoredResults is nil.
+func validateTaskRunResults(tr *v1.TaskRun, resolvedTaskSpec *v1.TaskSpec, fetchStoredResults storedResultsFetcher) error {
 	specResults := []v1.TaskResult{}
 	if tr.Spec.TaskSpec != nil {
 		specResults = append(specResults, tr.Spec.TaskSpec.Results...)
@@ -284,13 +326,83 @@ func validateTaskRunResults(tr *v1.TaskRun, resolvedTaskSpec *v1.TaskSpec) error
 		return pipelineErrors.WrapUserError(fmt.Errorf("Provided results don't match declared results; may be invalid JSON or missing result declaration: %v", strings.Join(s, ",")))
 	}
 
+	results := tr.Status.Results
+	if storedResultsNeedValidation(results, specResults) {
+		if fetchStoredResults == nil {
+			results = inlineResults(results)
+		} else {
+			fetched, err := fetchStoredResults(results)
+			if err != nil {
+				return &storedResultsFetchError{err: err}
+			}
+			results = fetched
+		}
+	}
+
 	// When get the results, for object value need to check if they have missing keys.
-	if missingKeysObjectNames := missingKeysofObjectResults(tr, specResults); len(missingKeysObjectNames) != 0 {
+	if missingKeysObjectNames := missingKeysofObjectResults(results, specResults); len(missingKeysObjectNames) != 0 {
 		return pipelineErrors.WrapUserError(fmt.Errorf("missing keys for these results which are required in TaskResult's properties %v", missingKeysObjectNames))
 	}
+
+	// When get the results, check their values against the schema of the results declaring one.
+	if invalidValues := invalidSchemaResults(results, specResults); len(invalidValues) != 0 {
+		var s []string
+		for k, v := range invalidValues {
+			s = append(s, fmt.Sprintf(" \"%v\": %v", k, v))
+		}
+		sort.Strings(s)
+		return pipelineErrors.WrapUserError(fmt.Errorf("Provided results don't match the schema of their declaration: %v", strings.Join(s, ",")))
+	}
 	return nil
 }
 
+// storedResultsNeedValidation returns true if a result stored in object storage has a schema or required properties.
+func storedResultsNeedValidation(results []v1.TaskRunResult, specResults []v1.TaskResult) bool {
+	constrained := sets.NewString()
+	for _, r := range specResults {
+		if r.Schema != nil || (r.Type == v1.ResultsTypeObject && len(r.Properties) > 0) {
+			constrained.Insert(r.Name)
+		}
+	}
+	for _, trr := range results {
+		if trr.URI != "" && constrained.Has(trr.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// inlineResults returns the results whose value is not stored in object storage.
+func inlineResults(results []v1.TaskRunResult) []v1.TaskRunResult {
+	var inline []v1.TaskRunResult
+	for _, trr := range results {
+		if trr.URI == "" {
+			inline = append(inline, trr)
+		}
+	}
+	return inline
+}
+
+// invalidSchemaResults checks and returns the emitted results whose value does not match the schema of their specified results.
+func invalidSchemaResults(results []v1.TaskRunResult, specResults []v1.TaskResult) map[string]string {
+	schemas := make(map[string]*v1.JSONSchema)
+	for _, r := range specResults {
+		if r.Schema != nil {
+			schemas[r.Name] = r.Schema
+		}
+	}
+
+	invalidValues := make(map[string]string)
+	for _, trr := range results {
+		if schema, ok := schemas[trr.Name]; ok {
+			if err := schema.ValidateValue(trr.Value); err != nil {
+				invalidValues[trr.Name] = err.Error()
+			}
+		}
+	}
+	return invalidValues
+}
+
 // mismatchedTypesResults checks and returns all the mismatched types of emitted results against specified results.
 func mismatchedTypesResults(tr *v1.TaskRun, specResults []v1.TaskResult) map[string]string {
 	neededTypes := make(map[string]string)
@@ -316,7 +428,7 @@ func mismatchedTypesResults(tr *v1.TaskRun, specResults []v1.TaskResult) map[str
 }
 
 // missingKeysofObjectResults checks and returns the missing keys of object results.
-func missingKeysofObjectResults(tr *v1.TaskRun, specResults []v1.TaskResult) map[string][]string {
+func missingKeysofObjectResults(results []v1.TaskRunResult, specResults []v1.TaskResult) map[string][]string {
 	neededKeys := make(map[string][]string)
 	providedKeys := make(map[string][]string)
 	// collect needed keys for object results
@@ -329,7 +441,7 @@ func missingKeysofObjectResults(tr *v1.TaskRun, specResults []v1.TaskResult) map
 	}
 
 	// collect provided keys for object results
-	for _, trr := range tr.Status.Results {
+	for _, trr := range results {
 		if trr.Value.Type == v1.ParamTypeObject {
 			for key := range trr.Value.ObjectVal {
 				providedKeys[trr.Name] = append(providedKeys[trr.Name], key)
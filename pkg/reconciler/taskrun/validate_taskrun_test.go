@@ -18,6 +18,7 @@ package taskrun
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -511,6 +512,7 @@ func TestValidateResult(t *testing.T) {
 		name    string
 		tr      *v1.TaskRun
 		rtr     *v1.TaskSpec
+		stored  map[string]v1.ResultValue
 		wantErr bool
 	}{{
 		name: "valid taskrun spec results",
@@ -820,10 +822,234 @@ func TestValidateResult(t *testing.T) {
 			Results: []v1.TaskResult{},
 		},
 		wantErr: true,
+	}, {
+		name: "valid taskrun results matching their schema",
+		tr: &v1.TaskRun{
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{
+						{
+							Name:  "digest",
+							Type:  v1.ResultsTypeString,
+							Value: *v1.NewStructuredValues("sha256:abc"),
+						},
+					},
+				},
+			},
+		},
+		rtr: &v1.TaskSpec{
+			Results: []v1.TaskResult{
+				{
+					Name:   "digest",
+					Type:   v1.ResultsTypeString,
+					Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+				},
+			},
+		},
+		wantErr: false,
+	}, {
+		name: "invalid taskrun results not matching their schema",
+		tr: &v1.TaskRun{
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{
+						{
+							Name:  "digest",
+							Type:  v1.ResultsTypeString,
+							Value: *v1.NewStructuredValues("md5:abc"),
+						},
+					},
+				},
+			},
+		},
+		rtr: &v1.TaskSpec{
+			Results: []v1.TaskResult{
+				{
+					Name:   "digest",
+					Type:   v1.ResultsTypeString,
+					Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+				},
+			},
+		},
+		wantErr: true,
+	}, {
+		name: "valid taskrun results stored in object storage",
+		tr: &v1.TaskRun{
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{
+						{
+							Name:  "digest",
+							Type:  v1.ResultsTypeString,
+							Value: *v1.NewStructuredValues(""),
+							URI:   "s3://results/sha256/abc",
+						},
+						{
+							Name:  "object-result",
+							Type:  v1.ResultsTypeObject,
+							Value: *v1.NewObject(map[string]string{}),
+							URI:   "s3://results/sha256/def",
+						},
+					},
+				},
+			},
+		},
+		rtr: &v1.TaskSpec{
+			Results: []v1.TaskResult{
+				{
+					Name:   "digest",
+					Type:   v1.ResultsTypeString,
+					Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+				},
+				{
+					Name:       "object-result",
+					Type:       v1.ResultsTypeObject,
+					Properties: map[string]v1.PropertySpec{"hello": {Type: "string"}},
+				},
+			},
+		},
+		stored: map[string]v1.ResultValue{
+			"s3://results/sha256/abc": *v1.NewStructuredValues("sha256:abc"),
+			"s3://results/sha256/def": *v1.NewObject(map[string]string{"hello": "world"}),
+		},
+		wantErr: false,
+	}, {
+		name: "invalid schema of taskrun results stored in object storage",
+		tr: &v1.TaskRun{
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{
+						{
+							Name:  "digest",
+							Type:  v1.ResultsTypeString,
+							Value: *v1.NewStructuredValues(""),
+							URI:   "s3://results/sha256/abc",
+						},
+						{
+							Name:  "object-result",
+							Type:  v1.ResultsTypeObject,
+							Value: *v1.NewObject(map[string]string{}),
+							URI:   "s3://results/sha256/def",
+						},
+					},
+				},
+			},
+		},
+		rtr: &v1.TaskSpec{
+			Results: []v1.TaskResult{
+				{
+					Name:   "digest",
+					Type:   v1.ResultsTypeString,
+					Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+				},
+				{
+					Name:       "object-result",
+					Type:       v1.ResultsTypeObject,
+					Properties: map[string]v1.PropertySpec{"hello": {Type: "string"}},
+				},
+			},
+		},
+		stored: map[string]v1.ResultValue{
+			"s3://results/sha256/abc": *v1.NewStructuredValues("md5:abc"),
+			"s3://results/sha256/def": *v1.NewObject(map[string]string{"hello": "world"}),
+		},
+		wantErr: true,
+	}, {
+		name: "missing object keys of taskrun results stored in object storage",
+		tr: &v1.TaskRun{
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{
+						{
+							Name:  "digest",
+							Type:  v1.ResultsTypeString,
+							Value: *v1.NewStructuredValues(""),
+							URI:   "s3://results/sha256/abc",
+						},
+						{
+							Name:  "object-result",
+							Type:  v1.ResultsTypeObject,
+							Value: *v1.NewObject(map[string]string{}),
+							URI:   "s3://results/sha256/def",
+						},
+					},
+				},
+			},
+		},
+		rtr: &v1.TaskSpec{
+			Results: []v1.TaskResult{
+				{
+					Name:   "digest",
+					Type:   v1.ResultsTypeString,
+					Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+				},
+				{
+					Name:       "object-result",
+					Type:       v1.ResultsTypeObject,
+					Properties: map[string]v1.PropertySpec{"hello": {Type: "string"}},
+				},
+			},
+		},
+		stored: map[string]v1.ResultValue{
+			"s3://results/sha256/abc": *v1.NewStructuredValues("sha256:abc"),
+			"s3://results/sha256/def": *v1.NewObject(map[string]string{"goodbye": "world"}),
+		},
+		wantErr: true,
+	}, {
+		name: "taskrun results stored in object storage can't be fetched",
+		tr: &v1.TaskRun{
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					Results: []v1.TaskRunResult{
+						{
+							Name:  "digest",
+							Type:  v1.ResultsTypeString,
+							Value: *v1.NewStructuredValues(""),
+							URI:   "s3://results/sha256/abc",
+						},
+						{
+							Name:  "object-result",
+							Type:  v1.ResultsTypeObject,
+							Value: *v1.NewObject(map[string]string{}),
+							URI:   "s3://results/sha256/def",
+						},
+					},
+				},
+			},
+		},
+		rtr: &v1.TaskSpec{
+			Results: []v1.TaskResult{
+				{
+					Name:   "digest",
+					Type:   v1.ResultsTypeString,
+					Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+				},
+				{
+					Name:       "object-result",
+					Type:       v1.ResultsTypeObject,
+					Properties: map[string]v1.PropertySpec{"hello": {Type: "string"}},
+				},
+			},
+		},
+		wantErr: true,
 	}}
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
-			err := validateTaskRunResults(tc.tr, tc.rtr)
+			fetch := func(results []v1.TaskRunResult) ([]v1.TaskRunResult, error) {
+				fetched := make([]v1.TaskRunResult, 0, len(results))
+				for _, r := range results {
+					if r.URI != "" {
+						value, ok := tc.stored[r.URI]
+						if !ok {
+							return nil, fmt.Errorf("object %s not found", r.URI)
+						}
+						r.Value = value
+					}
+					fetched = append(fetched, r)
+				}
+				return fetched, nil
+			}
+			err := validateTaskRunResults(tc.tr, tc.rtr, fetch)
 			if err == nil && tc.wantErr {
 				t.Errorf("expected err: %t, but got different err: %s", tc.wantErr, err)
 			} else if err != nil && !tc.wantErr {
@@ -833,6 +1059,38 @@ func TestValidateResult(t *testing.T) {
 	}
 }
 
+func TestValidateResult_StoredResultsNotFetched(t *testing.T) {
+	tr := &v1.TaskRun{
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				Results: []v1.TaskRunResult{{
+					Name:  "digest",
+					Type:  v1.ResultsTypeString,
+					Value: *v1.NewStructuredValues(""),
+					URI:   "s3://results/sha256/abc",
+				}},
+			},
+		},
+	}
+	rtr := &v1.TaskSpec{
+		Results: []v1.TaskResult{{
+			Name:   "digest",
+			Type:   v1.ResultsTypeString,
+			Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeString, Pattern: "^sha256:"},
+		}},
+	}
+	if err := validateTaskRunResults(tr, rtr, nil); err != nil {
+		t.Errorf("expected the stored results not to be validated without fetcher, got err: %s", err)
+	}
+	err := validateTaskRunResults(tr, rtr, func([]v1.TaskRunResult) ([]v1.TaskRunResult, error) {
+		return nil, errors.New("connection refused")
+	})
+	var fetchErr *storedResultsFetchError
+	if !errors.As(err, &fetchErr) {
+		t.Errorf("expected a storedResultsFetchError, got err: %v", err)
+	}
+}
+
 func TestEnumValidation_Success(t *testing.T) {
 	tcs := []struct {
 		name       string
@@ -958,3 +1216,61 @@ func TestEnumValidation_Failure(t *testing.T) {
 		}
 	}
 }
+
+func TestParamSchemaValidation(t *testing.T) {
+	tcs := []struct {
+		name        string
+		params      []v1.Param
+		paramSpecs  v1.ParamSpecs
+		expectedErr error
+	}{{
+		name: "no schema - success",
+		params: []v1.Param{{
+			Name:  "p1",
+			Value: *v1.NewStructuredValues("v1"),
+		}},
+		paramSpecs: v1.ParamSpecs{{
+			Name: "p1",
+		}},
+	}, {
+		name: "value matching the schema - success",
+		params: []v1.Param{{
+			Name:  "p1",
+			Value: *v1.NewStructuredValues("3"),
+		}},
+		paramSpecs: v1.ParamSpecs{{
+			Name:   "p1",
+			Schema: &v1.JSONSchema{Type: v1.JSONSchemaTypeInteger},
+		}},
+	}, {
+		name: "value not matching the schema - failure",
+		params: []v1.Param{{
+			Name:  "p1",
+			Value: *v1.NewObject(map[string]string{"replicas": "three"}),
+		}},
+		paramSpecs: v1.ParamSpecs{{
+			Name: "p1",
+			Type: v1.ParamTypeObject,
+			Schema: &v1.JSONSchema{
+				Type:       v1.JSONSchemaTypeObject,
+				Properties: map[string]v1.JSONSchema{"replicas": {Type: v1.JSONSchemaTypeInteger}},
+			},
+		}},
+		expectedErr: errors.New("param `p1` value does not match its schema: replicas: \"three\" is not a valid integer"),
+	}}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateParamSchema(t.Context(), tc.params, tc.paramSpecs)
+			if tc.expectedErr == nil {
+				if err != nil {
+					t.Errorf("expected err is nil, but got %v", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error from ValidateParamSchema() = %v, but got none", tc.expectedErr)
+			} else if d := cmp.Diff(tc.expectedErr.Error(), err.Error()); d != "" {
+				t.Errorf("expected error does not match: %s", diff.PrintWantGot(d))
+			}
+		})
+	}
+}
@@ -23,8 +23,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"go.uber.org/zap"
 	"gomodules.xyz/jsonpatch/v2"
@@ -33,12 +37,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
 )
 
 const (
 	// ReasonCouldntCreateWorkspacePVC indicates that a Pipeline expects a workspace from a
 	// volumeClaimTemplate but couldn't create a claim.
 	ReasonCouldntCreateWorkspacePVC = "CouldntCreateWorkspacePVC"
+
+	// RetainedWorkspaceLabelKey labels the PVCs retained once their run completes with the name of their workspace.
+	RetainedWorkspaceLabelKey = pipeline.GroupName + "/retainedWorkspace"
+	// RetainedUntilLabelKey labels the retained PVCs with a TTL with the time they are deleted after, in Unix seconds.
+	RetainedUntilLabelKey = pipeline.GroupName + "/retainedUntil"
+
+	// ExpiredRetainedPVCsCollectionInterval is how often the retained PVCs whose TTL expired are deleted.
+	ExpiredRetainedPVCsCollectionInterval = time.Minute
 )
 
 var (
@@ -50,6 +63,8 @@ var (
 type PvcHandler interface {
 	CreatePVCFromVolumeClaimTemplate(ctx context.Context, wb v1.WorkspaceBinding, ownerReference metav1.OwnerReference, namespace string) error
 	PurgeFinalizerAndDeletePVCForWorkspace(ctx context.Context, pvcName, namespace string) error
+	RetainPVCForWorkspace(ctx context.Context, pvcName, namespace string, labels map[string]string, retainedUntil *time.Time) error
+	DeleteExpiredRetainedPVCs(ctx context.Context, now time.Time) error
 }
 
 type defaultPVCHandler struct {
@@ -144,6 +159,104 @@ func (c *defaultPVCHandler) PurgeFinalizerAndDeletePVCForWorkspace(ctx context.C
 	return nil
 }
 
+// RetainPVCForWorkspace removes the owner references of a PVC so that it is not deleted with its run, and labels it
+// so that other runs can bind it. When retainedUntil is set, the PVC is labeled with the time it can be deleted
+// after by DeleteExpiredRetainedPVCs. PVCs that are already retained are left as they are.
+func (c *defaultPVCHandler) RetainPVCForWorkspace(ctx context.Context, pvcName, namespace string, labels map[string]string, retainedUntil *time.Time) error {
+	p, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, pvcName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			c.logger.Debugf("PVC %s no longer exists, skipping its retention", pvcName)
+			return nil
+		}
+		return fmt.Errorf("failed to get the PVC %s: %w", pvcName, err)
+	}
+	if _, ok := p.Labels[RetainedWorkspaceLabelKey]; ok {
+		return nil
+	}
+
+	retainedLabels := make(map[string]string, len(labels)+1)
+	maps.Copy(retainedLabels, labels)
+	if retainedUntil != nil {
+		retainedLabels[RetainedUntilLabelKey] = strconv.FormatInt(retainedUntil.Unix(), 10)
+	}
+	retainBytes, err := json.Marshal(map[string]any{"metadata": map[string]any{
+		"ownerReferences": nil,
+		"labels":          retainedLabels,
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal merge patch: %w", err)
+	}
+	if _, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Patch(ctx, pvcName, types.MergePatchType, retainBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch the PVC %s: %w", pvcName, err)
+	}
+	c.logger.Infof("Retained PersistentVolumeClaim %s in namespace %s", pvcName, namespace)
+	return nil
+}
+
+// DeleteExpiredRetainedPVCs deletes the retained PVCs of all the namespaces whose TTL expired at the given time.
+// Only the expired PVCs are listed, by selecting the ones whose retainedUntil label is not after the given time.
+func (c *defaultPVCHandler) DeleteExpiredRetainedPVCs(ctx context.Context, now time.Time) error {
+	selector := fmt.Sprintf("%s<%d", RetainedUntilLabelKey, now.Unix()+1)
+	pvcs, err := c.clientset.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("failed to list the expired retained PVCs: %w", err)
+	}
+	var errs []error
+	for _, p := range pvcs.Items {
+		if err := c.clientset.CoreV1().PersistentVolumeClaims(p.Namespace).Delete(ctx, p.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete the PVC %s in namespace %s: %w", p.Name, p.Namespace, err))
+			continue
+		}
+		c.logger.Infof("Deleted retained PersistentVolumeClaim %s in namespace %s", p.Name, p.Namespace)
+	}
+	return errors.Join(errs...)
+}
+
+// CollectExpiredRetainedPVCs deletes the retained PVCs whose TTL expired every
+// ExpiredRetainedPVCsCollectionInterval until the context is done. The PVCs are
+// collected independently of their runs, which may have been deleted already.
+func CollectExpiredRetainedPVCs(ctx context.Context, pvcHandler PvcHandler, clock clock.PassiveClock, logger *zap.SugaredLogger) {
+	ticker := time.NewTicker(ExpiredRetainedPVCsCollectionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := pvcHandler.DeleteExpiredRetainedPVCs(ctx, clock.Now()); err != nil {
+			logger.Errorf("Failed to delete the expired retained PersistentVolumeClaims: %v", err)
+		}
+	}
+}
+
+// IsRetained returns whether the PVC created from the volumeClaimTemplate of a workspace with the given retention
+// policy is retained once its run completes, depending on whether the run succeeded.
+func IsRetained(policy *v1.VolumeClaimRetentionPolicy, succeeded bool) bool {
+	if policy == nil {
+		return false
+	}
+	switch policy.Policy {
+	case v1.VolumeClaimRetentionRetain:
+		return true
+	case v1.VolumeClaimRetentionRetainOnFailure:
+		return !succeeded
+	default:
+		return false
+	}
+}
+
+// RetainedUntil returns the time the PVC retained at the given time with the given retention policy is deleted
+// after, or nil if it is retained until deleted by hand.
+func RetainedUntil(policy *v1.VolumeClaimRetentionPolicy, now time.Time) *time.Time {
+	if policy == nil || policy.TTL == nil {
+		return nil
+	}
+	retainedUntil := now.Add(policy.TTL.Duration)
+	return &retainedUntil
+}
+
 // getPVCFromVolumeClaimTemplate returns a PersistentVolumeClaim based on given workspaceBinding (using VolumeClaimTemplate), ownerReference and namespace
 func (c *defaultPVCHandler) getPVCFromVolumeClaimTemplate(workspaceBinding v1.WorkspaceBinding, ownerReference metav1.OwnerReference, namespace string) *corev1.PersistentVolumeClaim {
 	if workspaceBinding.VolumeClaimTemplate == nil {
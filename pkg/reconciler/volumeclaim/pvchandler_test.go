@@ -22,8 +22,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/test/diff"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -298,6 +301,132 @@ func TestPurgeFinalizerAndDeletePVCForWorkspace(t *testing.T) {
 	}
 }
 
+// TestRetainPVCForWorkspace tests that a retained PVC no longer has an owner, and is labeled with its
+// workspace and the time it expires at, and that retaining it again does not extend its TTL.
+func TestRetainPVCForWorkspace(t *testing.T) {
+	ctx := t.Context()
+	namespace := "my-ns"
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-pvc",
+			Namespace:       namespace,
+			Labels:          map[string]string{"app": "build"},
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "tekton.dev/v1", Kind: "PipelineRun", Name: "pipelinerun"}},
+		},
+	}
+	kubeClientSet := fakek8s.NewSimpleClientset(pvc)
+	pvcHandler := defaultPVCHandler{kubeClientSet, zap.NewExample().Sugar()}
+
+	retainedUntil := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	labels := map[string]string{"tekton.dev/pipelineRun": "pipelinerun", RetainedWorkspaceLabelKey: "source"}
+	if err := pvcHandler.RetainPVCForWorkspace(ctx, pvc.Name, namespace, labels, &retainedUntil); err != nil {
+		t.Fatalf("unexpected error when calling RetainPVCForWorkspace: %v", err)
+	}
+	later := retainedUntil.Add(time.Hour)
+	if err := pvcHandler.RetainPVCForWorkspace(ctx, pvc.Name, namespace, labels, &later); err != nil {
+		t.Fatalf("unexpected error when calling RetainPVCForWorkspace again: %v", err)
+	}
+	if err := pvcHandler.RetainPVCForWorkspace(ctx, "non-existing-pvc", namespace, labels, nil); err != nil {
+		t.Fatalf("the retention of non existing pvc was not skipped; an unexpected error occurred: %v", err)
+	}
+
+	got, err := kubeClientSet.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, pvc.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.OwnerReferences) > 0 {
+		t.Errorf("expected the owner references of the retained PVC to be removed, got %v", got.OwnerReferences)
+	}
+	wantLabels := map[string]string{
+		"app":                     "build",
+		"tekton.dev/pipelineRun":  "pipelinerun",
+		RetainedWorkspaceLabelKey: "source",
+		RetainedUntilLabelKey:     "1792238400",
+	}
+	if d := cmp.Diff(wantLabels, got.Labels); d != "" {
+		t.Errorf("unexpected labels of the retained PVC %s", diff.PrintWantGot(d))
+	}
+}
+
+// TestDeleteExpiredRetainedPVCs tests that only the retained PVCs whose TTL expired are deleted,
+// in every namespace.
+func TestDeleteExpiredRetainedPVCs(t *testing.T) {
+	ctx := t.Context()
+	retainedPVC := func(namespace, name, retainedUntil string) *corev1.PersistentVolumeClaim {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{RetainedWorkspaceLabelKey: "source"},
+			},
+		}
+		if retainedUntil != "" {
+			pvc.Labels[RetainedUntilLabelKey] = retainedUntil
+		}
+		return pvc
+	}
+	kubeClientSet := fakek8s.NewSimpleClientset(
+		retainedPVC("my-ns", "expired", "1792234800"),
+		retainedPVC("other-ns", "expired", "1792234800"),
+		retainedPVC("my-ns", "expires-now", "1792238400"),
+		retainedPVC("my-ns", "expires-soon", "1792240200"),
+		retainedPVC("my-ns", "kept", ""),
+	)
+	pvcHandler := defaultPVCHandler{kubeClientSet, zap.NewExample().Sugar()}
+
+	if err := pvcHandler.DeleteExpiredRetainedPVCs(ctx, time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("unexpected error when calling DeleteExpiredRetainedPVCs: %v", err)
+	}
+	pvcs, err := kubeClientSet.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, p := range pvcs.Items {
+		names = append(names, p.Namespace+"/"+p.Name)
+	}
+	if d := cmp.Diff([]string{"my-ns/expires-soon", "my-ns/kept"}, names); d != "" {
+		t.Errorf("unexpected PVCs left %s", diff.PrintWantGot(d))
+	}
+}
+
+func TestIsRetained(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		policy    *v1.VolumeClaimRetentionPolicy
+		succeeded bool
+		want      bool
+	}{{
+		name:      "no policy",
+		succeeded: false,
+		want:      false,
+	}, {
+		name:   "delete",
+		policy: &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionDelete},
+		want:   false,
+	}, {
+		name:      "retain on failure when succeeded",
+		policy:    &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionRetainOnFailure},
+		succeeded: true,
+		want:      false,
+	}, {
+		name:   "retain on failure when failed",
+		policy: &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionRetainOnFailure},
+		want:   true,
+	}, {
+		name:      "retain",
+		policy:    &v1.VolumeClaimRetentionPolicy{Policy: v1.VolumeClaimRetentionRetain},
+		succeeded: true,
+		want:      true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsRetained(tc.policy, tc.succeeded); got != tc.want {
+				t.Errorf("IsRetained() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
 // TestCreatePVCFromVolumeClaimTemplate_GetError tests error handling when getting existing PVC fails
 func TestCreatePVCFromVolumeClaimTemplate_GetError(t *testing.T) {
 	ownerRef := metav1.OwnerReference{UID: types.UID("test-owner")}
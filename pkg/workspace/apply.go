@@ -93,6 +93,19 @@ func CreateVolumes(wb []v1.WorkspaceBinding) map[string]corev1.Volume {
 		case w.CSI != nil:
 			csi := *w.CSI
 			v.setVolumeSource(w.Name, name, corev1.VolumeSource{CSI: &csi})
+		case w.Ephemeral != nil:
+			e := *w.Ephemeral
+			v.setVolumeSource(w.Name, name, corev1.VolumeSource{Ephemeral: &e})
+		case w.Image != nil:
+			img := *w.Image
+			v.setVolumeSource(w.Name, name, corev1.VolumeSource{Image: &img})
+		case w.Transfer != nil:
+			// The content of the workspace is moved between TaskRuns through object storage,
+			// so each TaskRun only needs a local volume.
+			v.setVolumeSource(w.Name, name, corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}})
+		case w.Cache != nil:
+			// Cache workspaces are restored from object storage into a local volume too.
+			v.setVolumeSource(w.Name, name, corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}})
 		}
 	}
 	return v
@@ -160,7 +173,8 @@ func Apply(ctx context.Context, ts v1.TaskSpec, wb []v1.WorkspaceBinding, v map[
 			Name:      vv.Name,
 			MountPath: w.GetMountPath(),
 			SubPath:   wb[i].SubPath,
-			ReadOnly:  w.ReadOnly,
+			// The content of images cannot be modified.
+			ReadOnly: w.ReadOnly || wb[i].Image != nil,
 		}
 
 		if isolatedWorkspaces.Has(w.Name) {
@@ -349,6 +363,9 @@ func replaceWorkspaceBindingVars(wb *v1.WorkspaceBinding, replacements map[strin
 	if wb.CSI != nil {
 		wb.CSI = applyCSIVolumeSource(wb.CSI, replacements)
 	}
+	if wb.Image != nil {
+		wb.Image.Reference = substitution.ApplyReplacements(wb.Image.Reference, replacements)
+	}
 	return wb
 }
 
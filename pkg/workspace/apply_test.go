@@ -73,6 +73,78 @@ func TestCreateVolumes(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "binding a single workspace with transfer",
+		workspaces: []v1.WorkspaceBinding{{
+			Name: "custom",
+			Transfer: &v1.TransferWorkspace{
+				From: []string{"s3://results/workspaces/foo/pr-build/source.tar.gz"},
+			},
+		}},
+		expectedVolumes: map[string]corev1.Volume{
+			"custom": {
+				Name: "ws-20573",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			},
+		},
+	}, {
+		name: "binding a single workspace with ephemeral",
+		workspaces: []v1.WorkspaceBinding{{
+			Name: "custom",
+			Ephemeral: &corev1.EphemeralVolumeSource{
+				VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+					Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+				},
+			},
+		}},
+		expectedVolumes: map[string]corev1.Volume{
+			"custom": {
+				Name: "ws-20573",
+				VolumeSource: corev1.VolumeSource{
+					Ephemeral: &corev1.EphemeralVolumeSource{
+						VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+							Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
+						},
+					},
+				},
+			},
+		},
+	}, {
+		name: "binding a single workspace with image",
+		workspaces: []v1.WorkspaceBinding{{
+			Name: "custom",
+			Image: &corev1.ImageVolumeSource{
+				Reference:  "ghcr.io/tektoncd/toolchain:v1",
+				PullPolicy: corev1.PullIfNotPresent,
+			},
+		}},
+		expectedVolumes: map[string]corev1.Volume{
+			"custom": {
+				Name: "ws-20573",
+				VolumeSource: corev1.VolumeSource{
+					Image: &corev1.ImageVolumeSource{
+						Reference:  "ghcr.io/tektoncd/toolchain:v1",
+						PullPolicy: corev1.PullIfNotPresent,
+					},
+				},
+			},
+		},
+	}, {
+		name: "binding a single cache workspace",
+		workspaces: []v1.WorkspaceBinding{{
+			Name:  "custom",
+			Cache: &v1.WorkspaceCache{Key: "go-deps"},
+		}},
+		expectedVolumes: map[string]corev1.Volume{
+			"custom": {
+				Name: "ws-20573",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			},
+		},
 	}, {
 		name: "binding a single workspace with configMap",
 		workspaces: []v1.WorkspaceBinding{{
@@ -738,6 +810,41 @@ func TestApply(t *testing.T) {
 				ReadOnly:  true,
 			}},
 		},
+	}, {
+		name: "binding a workspace to an image marks volume mount readOnly",
+		ts: v1.TaskSpec{
+			Workspaces: []v1.WorkspaceDeclaration{{
+				Name:      "custom",
+				MountPath: "/workspace/toolchain",
+			}},
+		},
+		workspaces: []v1.WorkspaceBinding{{
+			Name: "custom",
+			Image: &corev1.ImageVolumeSource{
+				Reference: "ghcr.io/tektoncd/toolchain:v1",
+			},
+		}},
+		expectedTaskSpec: v1.TaskSpec{
+			StepTemplate: &v1.StepTemplate{
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "ws-20573",
+					MountPath: "/workspace/toolchain",
+					ReadOnly:  true,
+				}},
+			},
+			Volumes: []corev1.Volume{{
+				Name: "ws-20573",
+				VolumeSource: corev1.VolumeSource{
+					Image: &corev1.ImageVolumeSource{
+						Reference: "ghcr.io/tektoncd/toolchain:v1",
+					},
+				},
+			}},
+			Workspaces: []v1.WorkspaceDeclaration{{
+				Name:      "custom",
+				MountPath: "/workspace/toolchain",
+			}},
+		},
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			vols := workspace.CreateVolumes(tc.workspaces)
@@ -1406,6 +1513,26 @@ func TestReplaceWorkspaceBindingsVars(t *testing.T) {
 				{SubPath: "replaced"},
 			},
 		},
+		{
+			name: "Replace Image",
+			replacements: map[string]string{
+				"params.to-replace": "replaced",
+			},
+			workspaceBindings: []v1.WorkspaceBinding{
+				{
+					Image: &corev1.ImageVolumeSource{
+						Reference: "ghcr.io/tektoncd/$(params.to-replace):v1",
+					},
+				},
+			},
+			expected: []v1.WorkspaceBinding{
+				{
+					Image: &corev1.ImageVolumeSource{
+						Reference: "ghcr.io/tektoncd/replaced:v1",
+					},
+				},
+			},
+		},
 		{
 			name: "Replace PersistentVolumeClaim",
 			replacements: map[string]string{